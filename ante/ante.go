@@ -18,6 +18,8 @@ type HandlerOptions struct {
 	BankKeeper        types.BankKeeper
 	FeegrantKeeper    cosmosante.FeegrantKeeper
 	OracleKeeper      OracleKeeper
+	UGovKeeper        UGovKeeper
+	LeverageKeeper    LeverageKeeper
 	IBCKeeper         *ibckeeper.Keeper
 	SignModeHandler   signing.SignModeHandler
 	SigGasConsumer    cosmosante.SignatureVerificationGasConsumer
@@ -38,6 +40,12 @@ func NewAnteHandler(options HandlerOptions, experimental bool) (sdk.AnteHandler,
 	if options.OracleKeeper == nil {
 		return nil, sdkerrors.ErrLogic.Wrap("oracle keeper is required for ante builder")
 	}
+	if options.UGovKeeper == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("ugov keeper is required for ante builder")
+	}
+	if options.LeverageKeeper == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("leverage keeper is required for ante builder")
+	}
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.ErrLogic.Wrap("sign mode handler is required for ante builder")
 	}
@@ -57,7 +65,7 @@ func NewAnteHandler(options HandlerOptions, experimental bool) (sdk.AnteHandler,
 			cosmosante.NewValidateMemoDecorator(options.AccountKeeper),
 			cosmosante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 			cosmosante.NewDeductFeeDecorator(options.AccountKeeper,
-				options.BankKeeper, options.FeegrantKeeper, FeeAndPriority,
+				options.BankKeeper, options.FeegrantKeeper, FeeAndPriority(options.UGovKeeper, options.LeverageKeeper),
 			),
 			// SetPubKeyDecorator must be called before all signature verification decorators
 			cosmosante.NewSetPubKeyDecorator(options.AccountKeeper),
@@ -77,7 +85,8 @@ func NewAnteHandler(options HandlerOptions, experimental bool) (sdk.AnteHandler,
 		cosmosante.NewTxTimeoutHeightDecorator(),
 		cosmosante.NewValidateMemoDecorator(options.AccountKeeper),
 		cosmosante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, FeeAndPriority),
+		cosmosante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper,
+			FeeAndPriority(options.UGovKeeper, options.LeverageKeeper)),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		cosmosante.NewSetPubKeyDecorator(options.AccountKeeper),
 		cosmosante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -2,9 +2,25 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ugovtypes "github.com/umee-network/umee/v3/x/ugov/types"
 )
 
-// OracleKeeper for feeder validation
+// OracleKeeper for feeder validation and oracle spam prevention
 type OracleKeeper interface {
 	ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error
+	ValidateFeederDelegationChange(ctx sdk.Context, operator sdk.ValAddress) error
+	MarkSubmittedMsg(ctx sdk.Context, operator sdk.ValAddress, typeURL string) error
+}
+
+// UGovKeeper for the fee and priority policies of messages
+type UGovKeeper interface {
+	MsgPolicies(ctx sdk.Context) map[string]ugovtypes.MsgPolicy
+	FeeTokenPremium(ctx sdk.Context) (sdk.Dec, bool)
+}
+
+// LeverageKeeper for pricing the fees paid in registered tokens and uTokens
+type LeverageKeeper interface {
+	MedianPriceRatio(ctx sdk.Context, fromDenom, toDenom string) (sdk.Dec, error)
+	ExchangeToken(ctx sdk.Context, token sdk.Coin) (sdk.Coin, error)
 }
@@ -1,48 +1,58 @@
 package ante
 
 import (
-	gbtypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	appparams "github.com/umee-network/umee/v3/app/params"
 	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
-	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+	ugovtypes "github.com/umee-network/umee/v3/x/ugov/types"
 )
 
-// MaxMsgGasUsage defines the maximum gas allowed for an oracle transaction.
-const MaxMsgGasUsage = uint64(140_000)
-
-// FeeAndPriority ensures tx has enough fee coins to pay for the gas at the CheckTx time
-// to early remove transactions from the mempool without enough attached fee.
-// The validator min fee check is ignored if the tx contains only oracle messages and
-// tx gas limit is <= MaxOracleMsgGasUsage. Essentially, validators can provide price
-// transactison for free as long as the gas per message is in the MaxOracleMsgGasUsage limit.
-func FeeAndPriority(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
-	feeTx, ok := tx.(sdk.FeeTx)
-	if !ok {
-		return nil, 0, sdkerrors.ErrTxDecode.Wrap("Tx must be a FeeTx")
-	}
+// FeeAndPriority returns a TxFeeChecker ensuring the tx has enough fee coins to pay for
+// the gas at the CheckTx time to early remove transactions from the mempool without
+// enough attached fee. The validator min fee check is ignored if the tx contains only
+// fee free messages and the tx gas limit doesn't exceed the sum of their max gas.
+// Essentially, validators can provide price transactions for free as long as the gas
+// per message is in the limit. The fee free messages, priorities and gas limits are
+// x/ugov governance parameters.
+// When x/ugov allows it, the fees can also be paid in any x/leverage registered token
+// or uToken, worth the required fees at the x/oracle historic medians plus a premium.
+func FeeAndPriority(ugov UGovKeeper, leverage LeverageKeeper) cosmosante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkerrors.ErrTxDecode.Wrap("Tx must be a FeeTx")
+		}
 
-	providedFees := feeTx.GetFee()
-	gasLimit := feeTx.GetGas()
-	msgs := feeTx.GetMsgs()
-	isOracleOrGravity := IsOracleOrGravityTx(msgs)
-	priority := getTxPriority(isOracleOrGravity, msgs)
-	chargeFees := !isOracleOrGravity || gasLimit > uint64(len(msgs))*MaxMsgGasUsage
-	// we also don't charge transaction fees for the first block, for the genesis transactions.
-	if !chargeFees || ctx.BlockHeight() == 0 {
-		return sdk.Coins{}, priority, nil
-	}
+		providedFees := feeTx.GetFee()
+		gasLimit := feeTx.GetGas()
+		msgs := feeTx.GetMsgs()
+		policies := ugov.MsgPolicies(ctx)
+		isFeeFree, maxGas := IsFeeFreeTx(msgs, policies)
+		priority := getTxPriority(msgs, policies)
+		chargeFees := !isFeeFree || gasLimit > maxGas
+		// we also don't charge transaction fees for the first block, for the genesis transactions.
+		if !chargeFees || ctx.BlockHeight() == 0 {
+			return sdk.Coins{}, priority, nil
+		}
 
-	if ctx.IsCheckTx() {
-		return providedFees, priority, checkFees(ctx.MinGasPrices(), providedFees, gasLimit)
+		var pricer *feeTokenPricer
+		if premium, ok := ugov.FeeTokenPremium(ctx); ok {
+			pricer = &feeTokenPricer{ctx: ctx, leverage: leverage, premium: premium}
+		}
+		if ctx.IsCheckTx() {
+			return providedFees, priority, checkFees(ctx.MinGasPrices(), providedFees, gasLimit, pricer)
+		}
+		return providedFees, priority, checkFees(nil, providedFees, gasLimit, pricer)
 	}
-	return providedFees, priority, checkFees(nil, providedFees, gasLimit)
 }
 
-func checkFees(minGasPrices sdk.DecCoins, fees sdk.Coins, gasLimit uint64) error {
+// checkFees verifies the fees cover the gas limit at the min gas prices. When pricer
+// isn't nil, the fees can also be paid in leverage tokens and uTokens.
+func checkFees(minGasPrices sdk.DecCoins, fees sdk.Coins, gasLimit uint64, pricer *feeTokenPricer) error {
 	if minGasPrices != nil {
 		// check minGasPrices set by validator
 		if err := AssertMinProtocolGasPrice(minGasPrices); err != nil {
@@ -67,43 +77,88 @@ func checkFees(minGasPrices sdk.DecCoins, fees sdk.Coins, gasLimit uint64) error
 		requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt()))
 	}
 
-	if !requiredFees.Empty() && !fees.IsAnyGTE(requiredFees) {
+	if !requiredFees.Empty() && !fees.IsAnyGTE(requiredFees) && !pricer.coversAny(requiredFees, fees) {
 		return sdkerrors.ErrInsufficientFee.Wrapf(
 			"insufficient fees; got: %s required: %s", fees, requiredFees)
 	}
 	return nil
 }
 
-// IsOracleOrGravityTx checks if all messages are oracle messages
-func IsOracleOrGravityTx(msgs []sdk.Msg) bool {
-	if len(msgs) == 0 {
+// feeTokenPricer prices the required fees in x/leverage registered tokens and uTokens.
+type feeTokenPricer struct {
+	ctx      sdk.Context
+	leverage LeverageKeeper
+	premium  sdk.Dec
+}
+
+// coversAny returns true if a fee coin, in a denom other than the required fee
+// denoms, is worth any of the required fees plus the premium. It returns false on
+// a nil pricer.
+func (p *feeTokenPricer) coversAny(requiredFees, fees sdk.Coins) bool {
+	if p == nil {
 		return false
 	}
-	for _, msg := range msgs {
-		switch msg.(type) {
-		case *oracletypes.MsgAggregateExchangeRatePrevote,
-			*oracletypes.MsgAggregateExchangeRateVote:
-			continue
 
-		// TODO: revisit free gravity msg set
-		case *gbtypes.MsgValsetConfirm,
-			*gbtypes.MsgConfirmBatch,
-			*gbtypes.MsgERC20DeployedClaim,
-			*gbtypes.MsgConfirmLogicCall,
-			*gbtypes.MsgLogicCallExecutedClaim,
-			*gbtypes.MsgSendToCosmosClaim,
-			*gbtypes.MsgExecuteIbcAutoForwards,
-			*gbtypes.MsgBatchSendToEthClaim,
-			*gbtypes.MsgValsetUpdatedClaim,
-			*gbtypes.MsgSubmitBadSignatureEvidence:
+	for _, fee := range fees {
+		if requiredFees.AmountOf(fee.Denom).IsPositive() {
+			// already checked against the min gas prices
 			continue
+		}
+		for _, required := range requiredFees {
+			amount, err := p.price(required, fee.Denom)
+			if err == nil && fee.Amount.GTE(amount) {
+				return true
+			}
+		}
+	}
 
-		default:
-			return false
+	return false
+}
+
+// price returns the amount of denom, a registered token or uToken, worth the
+// required fee plus the premium at the latest x/oracle historic medians, so the
+// fee tokens are not priced at a spot rate which can be moved within a block.
+func (p *feeTokenPricer) price(required sdk.Coin, denom string) (sdkmath.Int, error) {
+	baseDenom := denom
+	if leveragetypes.HasUTokenPrefix(denom) {
+		baseDenom = leveragetypes.ToTokenDenom(denom)
+	}
+
+	ratio, err := p.leverage.MedianPriceRatio(p.ctx, required.Denom, baseDenom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	value := ratio.MulInt(required.Amount).Mul(sdk.OneDec().Add(p.premium))
+	token := sdk.NewCoin(baseDenom, value.Ceil().TruncateInt())
+	if baseDenom == denom {
+		return token.Amount, nil
+	}
+
+	uToken, err := p.leverage.ExchangeToken(p.ctx, token)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	// the uToken exchange truncates, never require less than the token value
+	return uToken.Amount.AddRaw(1), nil
+}
+
+// IsFeeFreeTx checks if all messages are fee free messages, and returns the
+// gas the tx can use for free.
+func IsFeeFreeTx(msgs []sdk.Msg, policies map[string]ugovtypes.MsgPolicy) (bool, uint64) {
+	if len(msgs) == 0 {
+		return false, 0
+	}
+
+	var maxGas uint64
+	for _, msg := range msgs {
+		policy, ok := policies[sdk.MsgTypeURL(msg)]
+		if !ok || !policy.FeeFree {
+			return false, 0
 		}
+		maxGas += policy.MaxGas
 	}
 
-	return true
+	return true, maxGas
 }
 
 // AssertMinProtocolGasPrice returns an error if the provided gasPrices are lower then
@@ -117,11 +172,9 @@ func AssertMinProtocolGasPrice(gasPrices sdk.DecCoins) error {
 	return nil
 }
 
-// getTxPriority returns naive tx priority based on the lowest fee amount (regardless of the
-// denom) and oracle tx check.
-// Dirty optimization: since we already check if msgs are oracle or gravity messages, then we
-// don't recomupte it again: isOracleOrGravity flag takes a precedence over msgs check.
-func getTxPriority( /*fees, gasAmount*/ isOracleOrGravity bool, msgs []sdk.Msg) int64 {
+// getTxPriority returns naive tx priority based on the lowest priority of the
+// messages: a tx containing a message without priority has no priority.
+func getTxPriority( /*fees, gasAmount*/ msgs []sdk.Msg, policies map[string]ugovtypes.MsgPolicy) int64 {
 	var priority int64
 	/* TODO: IBC tx prioritization is not stable and we will implement a more general
 	 * tx prioritization once that will be resolved
@@ -137,17 +190,9 @@ func getTxPriority( /*fees, gasAmount*/ isOracleOrGravity bool, msgs []sdk.Msg)
 		}
 	}
 	*/
-	if isOracleOrGravity {
-		return 100
-	}
 	for _, msg := range msgs {
-		var p int64
-		switch msg.(type) {
-		case *evidencetypes.MsgSubmitEvidence:
-			p = 90
-		case *leveragetypes.MsgLiquidate:
-			p = 80
-		default:
+		p := policies[sdk.MsgTypeURL(msg)].Priority
+		if p == 0 {
 			// in case there is a non-prioritized mixed message, we return 0
 			return 0
 		}
@@ -11,6 +11,7 @@ import (
 	appparams "github.com/umee-network/umee/v3/app/params"
 	"github.com/umee-network/umee/v3/util/coin"
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+	ugovtypes "github.com/umee-network/umee/v3/x/ugov/types"
 )
 
 func (suite *IntegrationTestSuite) TestFeeAndPriority() {
@@ -101,20 +102,36 @@ func (suite *IntegrationTestSuite) TestFeeAndPriority() {
 	require.NoError(err)
 	require.True(oracleTx.GetFee().IsZero(), "got: %s", oracleTx.GetFee())
 	require.Equal(uint64(0), oracleTx.GetGas())
-	require.True(ante.IsOracleOrGravityTx(oracleTx.GetMsgs()))
+	isFeeFree, maxGas := ante.IsFeeFreeTx(oracleTx.GetMsgs(), suite.app.UGovKeeper.MsgPolicies(suite.ctx))
+	require.True(isFeeFree)
+	require.Equal(2*ugovtypes.DefaultMaxFeeFreeMsgGas, maxGas)
 
 	suite.checkFeeAnte(oracleTx, sdk.Coins{}, suite.ctx.WithIsCheckTx(true))
 	suite.checkFeeAnte(oracleTx, sdk.Coins{}, suite.ctx.WithIsCheckTx(false))
+
+	_, priority, err := ante.FeeAndPriority(suite.app.UGovKeeper, suite.app.LeverageKeeper)(suite.ctx, oracleTx)
+	require.NoError(err)
+	require.Equal(int64(ugovtypes.DefaultFeeFreePriority), priority)
+
+	// Test5: the policies are governance parameters
+	params := suite.app.UGovKeeper.GetParams(suite.ctx)
+	params.MsgPolicies = []ugovtypes.MsgPolicy{}
+	suite.app.UGovKeeper.SetParams(suite.ctx, params)
+	isFeeFree, _ = ante.IsFeeFreeTx(oracleTx.GetMsgs(), suite.app.UGovKeeper.MsgPolicies(suite.ctx))
+	require.False(isFeeFree)
+	_, priority, err = ante.FeeAndPriority(suite.app.UGovKeeper, suite.app.LeverageKeeper)(suite.ctx, oracleTx)
+	require.NoError(err)
+	require.Zero(priority)
 }
 
 func (suite *IntegrationTestSuite) checkFeeFailed(tx sdk.Tx, ctx sdk.Context) {
-	_, _, err := ante.FeeAndPriority(ctx, tx)
+	_, _, err := ante.FeeAndPriority(suite.app.UGovKeeper, suite.app.LeverageKeeper)(ctx, tx)
 	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
 }
 
 func (suite *IntegrationTestSuite) checkFeeAnte(tx sdk.Tx, feeExpected sdk.Coins, ctx sdk.Context) {
 	require := suite.Require()
-	fee, _, err := ante.FeeAndPriority(ctx, tx)
+	fee, _, err := ante.FeeAndPriority(suite.app.UGovKeeper, suite.app.LeverageKeeper)(ctx, tx)
 	require.NoError(err)
 	if len(feeExpected) == 0 {
 		require.True(fee.IsZero(), "fee should be zero, got: %s", fee)
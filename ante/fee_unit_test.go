@@ -4,34 +4,118 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	evidence "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/stretchr/testify/assert"
 
 	leverage "github.com/umee-network/umee/v3/x/leverage/types"
+	oracle "github.com/umee-network/umee/v3/x/oracle/types"
+	ugov "github.com/umee-network/umee/v3/x/ugov/types"
 )
 
 func TestPriority(t *testing.T) {
 	tcs := []struct {
 		name     string
-		oracle   bool
 		msgs     []sdk.Msg
 		priority int64
 	}{
-		{"empty priority 0", false, []sdk.Msg{}, 0},
-		{"when oracleOrGravity is set, then tx is max", true, []sdk.Msg{}, 100},
-		{"evidence1", true, []sdk.Msg{&evidence.MsgSubmitEvidence{}}, 100},
-		{"evidence2", false, []sdk.Msg{&evidence.MsgSubmitEvidence{}}, 90},
-		{"evidence3", false, []sdk.Msg{&evidence.MsgSubmitEvidence{}, &evidence.MsgSubmitEvidence{}}, 90},
-		{"leverage1", false, []sdk.Msg{&leverage.MsgLiquidate{}}, 80},
-		{"leverage-evidence1", false, []sdk.Msg{&leverage.MsgLiquidate{}, &evidence.MsgSubmitEvidence{}}, 80},
-		{"leverage-evidence2", false, []sdk.Msg{&evidence.MsgSubmitEvidence{}, &leverage.MsgLiquidate{}}, 80},
-		{"mixed1", false, []sdk.Msg{&evidence.MsgSubmitEvidence{}, &leverage.MsgLiquidate{}, &bank.MsgSend{}}, 0},
-		{"mixed2", false, []sdk.Msg{&bank.MsgSend{}, &evidence.MsgSubmitEvidence{}, &leverage.MsgLiquidate{}}, 0},
+		{"empty priority 0", []sdk.Msg{}, 0},
+		{"oracle is max", []sdk.Msg{&oracle.MsgAggregateExchangeRateVote{}}, 100},
+		{"oracle-evidence", []sdk.Msg{&oracle.MsgAggregateExchangeRateVote{}, &evidence.MsgSubmitEvidence{}}, 90},
+		{"evidence2", []sdk.Msg{&evidence.MsgSubmitEvidence{}}, 90},
+		{"evidence3", []sdk.Msg{&evidence.MsgSubmitEvidence{}, &evidence.MsgSubmitEvidence{}}, 90},
+		{"leverage1", []sdk.Msg{&leverage.MsgLiquidate{}}, 80},
+		{"leverage-evidence1", []sdk.Msg{&leverage.MsgLiquidate{}, &evidence.MsgSubmitEvidence{}}, 80},
+		{"leverage-evidence2", []sdk.Msg{&evidence.MsgSubmitEvidence{}, &leverage.MsgLiquidate{}}, 80},
+		{"mixed1", []sdk.Msg{&evidence.MsgSubmitEvidence{}, &leverage.MsgLiquidate{}, &bank.MsgSend{}}, 0},
+		{"mixed2", []sdk.Msg{&bank.MsgSend{}, &evidence.MsgSubmitEvidence{}, &leverage.MsgLiquidate{}}, 0},
 	}
 
+	policies := ugov.DefaultParams().MsgPoliciesByType()
 	for _, tc := range tcs {
-		p := getTxPriority(tc.oracle, tc.msgs)
+		p := getTxPriority(tc.msgs, policies)
 		assert.Equal(t, tc.priority, p, tc.name)
 	}
+
+	// the priorities are governance parameters
+	policies[sdk.MsgTypeURL(&bank.MsgSend{})] = ugov.MsgPolicy{Priority: 10}
+	assert.Equal(t, int64(10), getTxPriority(tcs[8].msgs, policies))
+}
+
+func TestIsFeeFreeTx(t *testing.T) {
+	policies := ugov.DefaultParams().MsgPoliciesByType()
+
+	isFeeFree, maxGas := IsFeeFreeTx([]sdk.Msg{
+		&oracle.MsgAggregateExchangeRatePrevote{},
+		&oracle.MsgAggregateExchangeRateVote{},
+	}, policies)
+	assert.True(t, isFeeFree)
+	assert.Equal(t, 2*ugov.DefaultMaxFeeFreeMsgGas, maxGas)
+
+	isFeeFree, _ = IsFeeFreeTx([]sdk.Msg{&oracle.MsgAggregateExchangeRateVote{}, &leverage.MsgLiquidate{}}, policies)
+	assert.False(t, isFeeFree)
+
+	isFeeFree, _ = IsFeeFreeTx([]sdk.Msg{}, policies)
+	assert.False(t, isFeeFree)
+}
+
+type mockLeverageKeeper struct {
+	// USD median prices of the base tokens
+	prices map[string]sdk.Dec
+	// uToken exchange rates of the base tokens
+	exchangeRates map[string]sdk.Dec
+}
+
+func (k mockLeverageKeeper) MedianPriceRatio(_ sdk.Context, fromDenom, toDenom string) (sdk.Dec, error) {
+	from, ok := k.prices[fromDenom]
+	if !ok {
+		return sdk.Dec{}, leverage.ErrNotRegisteredToken
+	}
+	to, ok := k.prices[toDenom]
+	if !ok {
+		return sdk.Dec{}, leverage.ErrNotRegisteredToken
+	}
+	return from.Quo(to), nil
+}
+
+func (k mockLeverageKeeper) ExchangeToken(_ sdk.Context, token sdk.Coin) (sdk.Coin, error) {
+	rate, ok := k.exchangeRates[token.Denom]
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrap(leverage.ErrUToken, token.Denom)
+	}
+	return sdk.NewCoin(leverage.ToUTokenDenom(token.Denom), sdk.NewDecFromInt(token.Amount).Quo(rate).TruncateInt()), nil
+}
+
+func TestCheckFeesInFeeTokens(t *testing.T) {
+	pricer := &feeTokenPricer{
+		leverage: mockLeverageKeeper{
+			prices: map[string]sdk.Dec{
+				"uumee": sdk.MustNewDecFromStr("0.01"),
+				"uatom": sdk.MustNewDecFromStr("0.02"),
+			},
+			exchangeRates: map[string]sdk.Dec{"uatom": sdk.MustNewDecFromStr("1.1")},
+		},
+		premium: sdk.MustNewDecFromStr("0.1"),
+	}
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uumee", sdk.MustNewDecFromStr("0.005")))
+	gasLimit := uint64(200_000)
+	fees := func(amount int64, denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	// the required fee is 1000uumee, worth 500uatom, plus 10%
+	assert.NoError(t, checkFees(minGasPrices, fees(1000, "uumee"), gasLimit, pricer))
+	assert.NoError(t, checkFees(minGasPrices, fees(550, "uatom"), gasLimit, pricer))
+	assert.ErrorIs(t, checkFees(minGasPrices, fees(549, "uatom"), gasLimit, pricer), sdkerrors.ErrInsufficientFee)
+
+	// 550uatom are worth 500u/uatom, the exchange is rounded up
+	assert.NoError(t, checkFees(minGasPrices, fees(501, "u/uatom"), gasLimit, pricer))
+	assert.ErrorIs(t, checkFees(minGasPrices, fees(500, "u/uatom"), gasLimit, pricer), sdkerrors.ErrInsufficientFee)
+
+	// the unregistered tokens can't pay fees
+	assert.ErrorIs(t, checkFees(minGasPrices, fees(1_000_000, "uosmo"), gasLimit, pricer), sdkerrors.ErrInsufficientFee)
+
+	// the fee tokens are disabled without pricer
+	assert.ErrorIs(t, checkFees(minGasPrices, fees(550, "uatom"), gasLimit, nil), sdkerrors.ErrInsufficientFee)
 }
@@ -1,30 +1,24 @@
 package ante
 
 import (
-	"sync"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
 )
 
 // SpamPreventionDecorator defines a custom Umee AnteHandler decorator that is
 // responsible for preventing oracle message spam. Specifically, it prohibits
-// oracle feeders from submitting multiple oracle messages in a single block.
+// oracle feeders from submitting multiple oracle messages of the same type in a
+// single block, and validators from changing their feeder more than once per
+// vote period. The submitted messages are recorded in the x/oracle transient
+// store, so the check is deterministic and enforced in DeliverTx too.
 type SpamPreventionDecorator struct {
-	oracleKeeper     OracleKeeper
-	oraclePrevoteMap map[string]int64
-	oracleVoteMap    map[string]int64
-	mu               sync.Mutex
+	oracleKeeper OracleKeeper
 }
 
 func NewSpamPreventionDecorator(oracleKeeper OracleKeeper) *SpamPreventionDecorator {
 	return &SpamPreventionDecorator{
-		oracleKeeper:     oracleKeeper,
-		oraclePrevoteMap: make(map[string]int64),
-		oracleVoteMap:    make(map[string]int64),
-		mu:               sync.Mutex{},
+		oracleKeeper: oracleKeeper,
 	}
 }
 
@@ -34,38 +28,33 @@ func (spd *SpamPreventionDecorator) AnteHandle(
 	simulate bool,
 	next sdk.AnteHandler,
 ) (newCtx sdk.Context, err error) {
+	// the mempool txs were already checked, the transient store is cleared
+	// after each block
 	if ctx.IsReCheckTx() {
 		return next(ctx, tx, simulate)
 	}
 
-	if ctx.IsCheckTx() && !simulate {
-		if err := spd.CheckOracleSpam(ctx, tx.GetMsgs()); err != nil {
-			return ctx, err
-		}
+	if err := spd.CheckOracleSpam(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
 	}
 
 	return next(ctx, tx, simulate)
 }
 
 // CheckOracleSpam performs the check of whether or not we've seen an oracle
-// message from an oracle feeder in the current block or not. If we have, we
-// return an error which prohibits the transaction from being processed.
+// message of the same type from an oracle feeder in the current block or not.
+// If we have, we return an error which prohibits the transaction from being
+// processed. Feeder delegations are limited to one per vote period.
 func (spd *SpamPreventionDecorator) CheckOracleSpam(ctx sdk.Context, msgs []sdk.Msg) error {
-	spd.mu.Lock()
-	defer spd.mu.Unlock()
-
-	curHeight := ctx.BlockHeight()
 	for _, msg := range msgs {
 		var err error
 		switch msg := msg.(type) {
 		case *oracletypes.MsgAggregateExchangeRatePrevote:
-			err = spd.validate(ctx, msg.Feeder, msg.Validator, spd.oraclePrevoteMap, curHeight, "pre-vote")
+			err = spd.validate(ctx, msg.Feeder, msg.Validator, sdk.MsgTypeURL(msg))
 		case *oracletypes.MsgAggregateExchangeRateVote:
-			err = spd.validate(ctx, msg.Feeder, msg.Validator, spd.oracleVoteMap, curHeight, "vote")
-		default:
-			// non oracle msg: stop validation!
-			// NOTE: only tx which contains only oracle Msgs are considered oracle-prioritized
-			return nil
+			err = spd.validate(ctx, msg.Feeder, msg.Validator, sdk.MsgTypeURL(msg))
+		case *oracletypes.MsgDelegateFeedConsent:
+			err = spd.validateFeederChange(ctx, msg.Operator)
 		}
 		if err != nil {
 			return err
@@ -75,14 +64,7 @@ func (spd *SpamPreventionDecorator) CheckOracleSpam(ctx sdk.Context, msgs []sdk.
 	return nil
 }
 
-func (spd *SpamPreventionDecorator) validate(
-	ctx sdk.Context,
-	feeder,
-	validator string,
-	cache map[string]int64,
-	curHeight int64,
-	txType string,
-) error {
+func (spd *SpamPreventionDecorator) validate(ctx sdk.Context, feeder, validator, typeURL string) error {
 	feederAddr, err := sdk.AccAddressFromBech32(feeder)
 	if err != nil {
 		return err
@@ -94,10 +76,16 @@ func (spd *SpamPreventionDecorator) validate(
 	if err = spd.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
 		return err
 	}
-	if lastSubmitted, ok := cache[validator]; ok && lastSubmitted == curHeight {
-		return sdkerrors.ErrInvalidRequest.Wrapf(
-			"validator has already submitted a %s message at the current height", txType)
+	return spd.oracleKeeper.MarkSubmittedMsg(ctx, valAddr, typeURL)
+}
+
+func (spd *SpamPreventionDecorator) validateFeederChange(ctx sdk.Context, operator string) error {
+	valAddr, err := sdk.ValAddressFromBech32(operator)
+	if err != nil {
+		return err
 	}
-	cache[validator] = curHeight
-	return nil
+	if err = spd.oracleKeeper.ValidateFeederDelegationChange(ctx, valAddr); err != nil {
+		return err
+	}
+	return spd.oracleKeeper.MarkSubmittedMsg(ctx, valAddr, sdk.MsgTypeURL(&oracletypes.MsgDelegateFeedConsent{}))
 }
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/umee-network/umee/v3/ante"
+	oraclekeeper "github.com/umee-network/umee/v3/x/oracle/keeper"
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
 )
 
@@ -17,6 +18,7 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 	priv2, _, addr2 := testdata.KeyTestPubAddr()
 
 	spd := ante.NewSpamPreventionDecorator(dummyOracleKeeper{
+		Keeper: suite.app.OracleKeeper,
 		feeders: map[string]string{
 			sdk.ValAddress(addr1).String(): addr1.String(),
 			sdk.ValAddress(addr2).String(): addr2.String(),
@@ -24,10 +26,14 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 	})
 	antehandler := sdk.ChainAnteDecorators(spd)
 
-	suite.ctx = suite.ctx.WithIsCheckTx(true)
+	// each block starts with an empty transient store
+	newBlock := func(height int64) sdk.Context {
+		ctx, _ := suite.ctx.CacheContext()
+		return ctx.WithBlockHeight(height).WithIsCheckTx(true)
+	}
 
 	// normal so ok
-	suite.ctx = suite.ctx.WithBlockHeight(100)
+	ctx := newBlock(100)
 	suite.Require().NoError(suite.txBuilder.SetMsgs(
 		oracletypes.NewMsgAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{}, addr1, sdk.ValAddress(addr1)),
 		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
@@ -36,17 +42,48 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
-	_, err = antehandler(suite.ctx, tx, false)
+	_, err = antehandler(ctx, tx, false)
 	suite.Require().NoError(err)
 
 	// do it again, gets blocked
-	_, err = antehandler(suite.ctx, tx, false)
-	suite.Require().Error(err)
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, oracletypes.ErrExistingMsg)
+
+	// also blocked in DeliverTx
+	_, err = antehandler(ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().ErrorIs(err, oracletypes.ErrExistingMsg)
 
 	// next block
-	suite.ctx = suite.ctx.WithBlockHeight(101)
-	_, err = antehandler(suite.ctx, tx, false)
+	ctx = newBlock(101)
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the duplicates mixed with other messages are blocked
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		testdata.NewTestMsg(addr1),
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, oracletypes.ErrExistingMsg)
+
+	// one feeder change per block
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgDelegateFeedConsent(sdk.ValAddress(addr2), addr1),
+	))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, oracletypes.ErrExistingMsg)
+
+	// and one per vote period
+	ctx = newBlock(102)
+	suite.app.OracleKeeper.SetFeederDelegationHeight(ctx, sdk.ValAddress(addr2), 101)
+	_, err = antehandler(ctx, tx, false)
+	suite.Require().ErrorIs(err, oracletypes.ErrFeederChangeTooSoon)
 
 	// catch wrong feeder
 	suite.Require().NoError(suite.txBuilder.SetMsgs(
@@ -56,12 +93,13 @@ func (suite *IntegrationTestSuite) TestOracleSpam() {
 	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
-	suite.ctx = suite.ctx.WithBlockHeight(102)
-	_, err = antehandler(suite.ctx, tx, false)
+	ctx = newBlock(103)
+	_, err = antehandler(ctx, tx, false)
 	suite.Require().Error(err)
 }
 
 type dummyOracleKeeper struct {
+	oraclekeeper.Keeper
 	feeders map[string]string
 }
 
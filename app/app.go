@@ -120,12 +120,19 @@ import (
 	"github.com/umee-network/umee/v3/util/genmap"
 	uibctransfer "github.com/umee-network/umee/v3/x/ibctransfer"
 	uibctransferkeeper "github.com/umee-network/umee/v3/x/ibctransfer/keeper"
+	uibctransfertypes "github.com/umee-network/umee/v3/x/ibctransfer/types"
 	"github.com/umee-network/umee/v3/x/leverage"
 	leveragekeeper "github.com/umee-network/umee/v3/x/leverage/keeper"
 	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
 	"github.com/umee-network/umee/v3/x/oracle"
 	oraclekeeper "github.com/umee-network/umee/v3/x/oracle/keeper"
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+	"github.com/umee-network/umee/v3/x/pricerelay"
+	pricerelaykeeper "github.com/umee-network/umee/v3/x/pricerelay/keeper"
+	pricerelaytypes "github.com/umee-network/umee/v3/x/pricerelay/types"
+	"github.com/umee-network/umee/v3/x/ugov"
+	ugovkeeper "github.com/umee-network/umee/v3/x/ugov/keeper"
+	ugovtypes "github.com/umee-network/umee/v3/x/ugov/types"
 )
 
 var (
@@ -167,10 +174,13 @@ func init() {
 		nftmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		ibctransfer.AppModuleBasic{},
+		uibctransfer.AppModuleBasic{},
 		gravity.AppModuleBasic{},
 		leverage.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		bech32ibc.AppModuleBasic{},
+		pricerelay.AppModuleBasic{},
+		ugov.AppModuleBasic{},
 	}
 
 	if Experimental {
@@ -236,16 +246,21 @@ type UmeeApp struct {
 	WasmKeeper       wasm.Keeper
 
 	UIBCTransferKeeper uibctransferkeeper.Keeper
+	UIBCQuotaKeeper    uibctransferkeeper.QuotaKeeper
+	UIBCAssetKeeper    uibctransferkeeper.AssetKeeper
 	IBCKeeper          *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	GravityKeeper      gravitykeeper.Keeper
 	LeverageKeeper     leveragekeeper.Keeper
 	OracleKeeper       oraclekeeper.Keeper
+	PriceRelayKeeper   pricerelaykeeper.Keeper
+	UGovKeeper         ugovkeeper.Keeper
 	bech32IbcKeeper    bech32ibckeeper.Keeper
 
 	// make scoped keepers public for testing purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedWasmKeeper     capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper        capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper   capabilitykeeper.ScopedKeeper
+	ScopedPriceRelayKeeper capabilitykeeper.ScopedKeeper
+	ScopedWasmKeeper       capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -303,13 +318,14 @@ func New(
 		ibchost.StoreKey, ibctransfertypes.StoreKey,
 		gravitytypes.StoreKey,
 		leveragetypes.StoreKey, oracletypes.StoreKey, bech32ibctypes.StoreKey,
+		pricerelaytypes.StoreKey, uibctransfertypes.StoreKey,
 	}
 	if Experimental {
 		storeKeys = append(storeKeys, wasm.StoreKey)
 	}
 
 	keys := sdk.NewKVStoreKeys(storeKeys...)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, oracletypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// configure state listening capabilities using AppOptions
@@ -346,9 +362,10 @@ func New(
 		memKeys[capabilitytypes.MemStoreKey],
 	)
 
-	// grant capabilities for the ibc and ibc-transfer modules
+	// grant capabilities for the ibc, ibc-transfer and price relay modules
 	app.ScopedIBCKeeper = app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	app.ScopedPriceRelayKeeper = app.CapabilityKeeper.ScopeToModule(pricerelaytypes.ModuleName)
 
 	app.initializeCustomScopedKeepers()
 
@@ -434,6 +451,7 @@ func New(
 	app.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		keys[oracletypes.ModuleName],
+		tkeys[oracletypes.TStoreKey],
 		app.GetSubspace(oracletypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -441,6 +459,13 @@ func New(
 		app.StakingKeeper,
 		distrtypes.ModuleName,
 	)
+	app.UIBCAssetKeeper = uibctransferkeeper.NewAssetKeeper(
+		appCodec,
+		keys[uibctransfertypes.StoreKey],
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	var err error
 	app.LeverageKeeper, err = leveragekeeper.NewKeeper(
 		appCodec,
@@ -448,6 +473,7 @@ func New(
 		app.GetSubspace(leveragetypes.ModuleName),
 		app.BankKeeper,
 		app.OracleKeeper,
+		app.UIBCAssetKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		cast.ToBool(appOpts.Get(leveragetypes.FlagEnableLiquidatorQuery)),
 	)
@@ -459,6 +485,9 @@ func New(
 			app.OracleKeeper.Hooks(),
 		),
 	)
+	app.UIBCAssetKeeper = *app.UIBCAssetKeeper.SetLeverageKeeper(app.LeverageKeeper)
+
+	app.UGovKeeper = ugovkeeper.NewKeeper(app.GetSubspace(ugovtypes.ModuleName))
 
 	app.GravityKeeper = gravitykeeper.NewKeeper(
 		keys[gravitytypes.StoreKey],
@@ -494,13 +523,23 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	// The quota keeper wraps the IBC channel keeper to limit the outflows of the
+	// ICS-20 transfers.
+	app.UIBCQuotaKeeper = uibctransferkeeper.NewQuotaKeeper(
+		keys[uibctransfertypes.StoreKey],
+		app.GetSubspace(uibctransfertypes.ModuleName),
+		app.OracleKeeper,
+		app.LeverageKeeper,
+		app.IBCKeeper.ChannelKeeper,
+	)
+
 	// Create an original ICS-20 transfer keeper and AppModule and then use it to
 	// created an Umee wrapped ICS-20 transfer keeper and AppModule.
 	ibcTransferKeeper := ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.UIBCQuotaKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -508,15 +547,29 @@ func New(
 		app.ScopedTransferKeeper,
 	)
 
-	app.UIBCTransferKeeper = uibctransferkeeper.New(ibcTransferKeeper, app.BankKeeper)
+	app.UIBCTransferKeeper = uibctransferkeeper.New(
+		ibcTransferKeeper, app.BankKeeper, leveragekeeper.NewMsgServerImpl(app.LeverageKeeper),
+	)
 	ibcTransferModule := ibctransfer.NewAppModule(ibcTransferKeeper)
 	uibcTransferIBCModule := uibctransfer.NewIBCModule(
-		ibctransfer.NewIBCModule(ibcTransferKeeper), app.UIBCTransferKeeper,
+		ibctransfer.NewIBCModule(ibcTransferKeeper), app.UIBCTransferKeeper, app.UIBCQuotaKeeper,
+	)
+
+	app.PriceRelayKeeper = pricerelaykeeper.NewKeeper(
+		appCodec,
+		keys[pricerelaytypes.StoreKey],
+		app.GetSubspace(pricerelaytypes.ModuleName),
+		app.OracleKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.ScopedPriceRelayKeeper,
 	)
 
-	// create static IBC router, add transfer route, then set and seal it
+	// create static IBC router, add transfer and price relay routes, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, uibcTransferIBCModule)
+	ibcRouter.AddRoute(pricerelaytypes.ModuleName, pricerelay.NewIBCModule(app.PriceRelayKeeper))
 
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -594,10 +647,13 @@ func New(
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 
 		ibcTransferModule,
+		uibctransfer.NewAppModule(app.UIBCQuotaKeeper, app.UIBCAssetKeeper),
 		gravity.NewAppModule(app.GravityKeeper, app.BankKeeper),
 		leverage.NewAppModule(appCodec, app.LeverageKeeper, app.AccountKeeper, app.BankKeeper),
-		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper, Experimental),
+		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		bech32ibc.NewAppModule(appCodec, app.bech32IbcKeeper),
+		pricerelay.NewAppModule(app.PriceRelayKeeper),
+		ugov.NewAppModule(app.UGovKeeper),
 	}
 	if Experimental {
 		appModules = append(appModules,
@@ -624,10 +680,14 @@ func New(
 		oracletypes.ModuleName,
 		gravitytypes.ModuleName,
 		bech32ibctypes.ModuleName,
+		pricerelaytypes.ModuleName,
+		ugovtypes.ModuleName,
+		uibctransfertypes.ModuleName,
 	}
 	endBlockers := []string{
 		crisistypes.ModuleName,
-		oracletypes.ModuleName, // must be before gov and staking
+		oracletypes.ModuleName,     // must be before gov and staking
+		pricerelaytypes.ModuleName, // must be after oracle, relays the tallied exchange rates
 		govtypes.ModuleName, stakingtypes.ModuleName,
 		ibchost.ModuleName, ibctransfertypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
@@ -639,6 +699,8 @@ func New(
 		leveragetypes.ModuleName,
 		gravitytypes.ModuleName,
 		bech32ibctypes.ModuleName,
+		ugovtypes.ModuleName,
+		uibctransfertypes.ModuleName,
 	}
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -650,7 +712,9 @@ func New(
 	initGenesis := []string{
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
 		stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
-		crisistypes.ModuleName, ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName,
+		// the ante handler reads the ugov params when genutil delivers the gentxs
+		ugovtypes.ModuleName,
+		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName,
 		authz.ModuleName, ibctransfertypes.ModuleName, // icatypes.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName,
 		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
@@ -659,6 +723,8 @@ func New(
 		leveragetypes.ModuleName,
 		gravitytypes.ModuleName,
 		bech32ibctypes.ModuleName,
+		pricerelaytypes.ModuleName,
+		uibctransfertypes.ModuleName,
 	}
 	orderMigrations := []string{
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
@@ -672,6 +738,9 @@ func New(
 		leveragetypes.ModuleName,
 		gravitytypes.ModuleName,
 		bech32ibctypes.ModuleName,
+		pricerelaytypes.ModuleName,
+		ugovtypes.ModuleName,
+		uibctransfertypes.ModuleName,
 	}
 
 	if Experimental {
@@ -680,6 +749,9 @@ func New(
 		initGenesis = append(initGenesis, wasm.ModuleName)
 		orderMigrations = append(orderMigrations, wasm.ModuleName)
 	}
+	// crisis must be initialized last, as it asserts the invariants of all
+	// the other modules against the imported genesis state
+	initGenesis = append(initGenesis, crisistypes.ModuleName)
 
 	app.mm.SetOrderBeginBlockers(beginBlockers...)
 	app.mm.SetOrderEndBlockers(endBlockers...)
@@ -757,6 +829,8 @@ func (app *UmeeApp) setAnteHandler(txConfig client.TxConfig,
 			AccountKeeper:     app.AccountKeeper,
 			BankKeeper:        app.BankKeeper,
 			OracleKeeper:      app.OracleKeeper,
+			UGovKeeper:        app.UGovKeeper,
+			LeverageKeeper:    app.LeverageKeeper,
 			IBCKeeper:         app.IBCKeeper,
 			SignModeHandler:   txConfig.SignModeHandler(),
 			FeegrantKeeper:    app.FeeGrantKeeper,
@@ -976,6 +1050,9 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(gravitytypes.ModuleName)
 	paramsKeeper.Subspace(leveragetypes.ModuleName)
 	paramsKeeper.Subspace(oracletypes.ModuleName)
+	paramsKeeper.Subspace(ugovtypes.ModuleName)
+	paramsKeeper.Subspace(pricerelaytypes.ModuleName)
+	paramsKeeper.Subspace(uibctransfertypes.ModuleName)
 	if Experimental {
 		paramsKeeper.Subspace(wasm.ModuleName)
 	}
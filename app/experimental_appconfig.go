@@ -15,6 +15,8 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	uwasm "github.com/umee-network/umee/v3/app/wasm"
 )
 
 const (
@@ -83,9 +85,10 @@ func (app *UmeeApp) customKeepers(
 
 	app.wasmCfg = wasmConfig
 
-	// The last arguments can contain custom message handlers, and custom query handlers,
-	// if we want to allow any custom callbacks
-	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1"
+	// The custom bindings let contracts query exchange rates and lending markets
+	// and send x/leverage messages.
+	wasmOpts = append(wasmOpts, uwasm.RegisterCustomPlugins(app.LeverageKeeper, app.OracleKeeper)...)
+	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1," + uwasm.Capability
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
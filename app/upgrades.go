@@ -13,8 +13,10 @@ import (
 
 	"github.com/umee-network/umee/v3/app/upgradev3"
 	"github.com/umee-network/umee/v3/app/upgradev3x3"
+	uibctransfertypes "github.com/umee-network/umee/v3/x/ibctransfer/types"
 	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+	pricerelaytypes "github.com/umee-network/umee/v3/x/pricerelay/types"
 )
 
 // RegisterUpgradeHandlersregisters upgrade handlers.
@@ -30,6 +32,20 @@ func (app UmeeApp) RegisterUpgradeHandlers(bool) {
 
 	app.registerUpgrade3_1to3_3(upgradeInfo)
 	app.registerUpgrade3_2to3_3(upgradeInfo)
+	app.registerUpgrade3_4(upgradeInfo)
+}
+
+// performs upgrade from v3.3 -> v3.4
+func (app *UmeeApp) registerUpgrade3_4(upgradeInfo upgradetypes.Plan) {
+	const planName = "v3.4"
+	app.UpgradeKeeper.SetUpgradeHandler(planName, onlyModuleMigrations(app, planName))
+
+	app.storeUpgrade(planName, upgradeInfo, storetypes.StoreUpgrades{
+		Added: []string{
+			pricerelaytypes.ModuleName,
+			uibctransfertypes.ModuleName,
+		},
+	})
 }
 
 // performs upgrade from v3.1 -> v3.3 (including the v3.2 chanages)
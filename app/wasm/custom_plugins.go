@@ -0,0 +1,30 @@
+package wasm
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/umee-network/umee/v3/app/wasm/msg"
+	"github.com/umee-network/umee/v3/app/wasm/query"
+	lvkeeper "github.com/umee-network/umee/v3/x/leverage/keeper"
+	ockeeper "github.com/umee-network/umee/v3/x/oracle/keeper"
+)
+
+// Capability defines the capability of the chains supporting the umee custom
+// bindings, which contracts using them must require.
+const Capability = "umee"
+
+// RegisterCustomPlugins returns the wasm options registering the custom query
+// plugin and message encoder of the x/leverage and x/oracle modules.
+func RegisterCustomPlugins(leverageKeeper lvkeeper.Keeper, oracleKeeper ockeeper.Keeper) []wasm.Option {
+	queryPlugin := query.NewQueryPlugin(leverageKeeper, oracleKeeper)
+
+	return []wasm.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: queryPlugin.CustomQuerier(),
+		}),
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+			Custom: msg.CustomEncoder,
+		}),
+	}
+}
@@ -0,0 +1,29 @@
+package msg
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CustomEncoder encodes the custom messages of contracts into x/leverage
+// messages signed by the contract. The messages are then routed to the
+// x/leverage message server like any other message, with the same validation
+// and events.
+func CustomEncoder(contract sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	m, err := ParseUmeeMsg(msg)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: msg}
+	}
+
+	sdkMsg, err := m.ToSDKMsg(contract)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: msg}
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return []sdk.Msg{sdkMsg}, nil
+}
@@ -0,0 +1,39 @@
+package msg_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/v3/app/wasm/msg"
+	lvtypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+func TestCustomEncoder(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	borrower := sdk.AccAddress([]byte("borrower____________"))
+	asset := sdk.NewInt64Coin("uumee", 1000)
+
+	msgs, err := msg.CustomEncoder(contract, []byte(`{"supply":{"asset":{"denom":"uumee","amount":"1000"}}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{lvtypes.NewMsgSupply(contract, asset)}, msgs)
+
+	msgs, err = msg.CustomEncoder(contract, []byte(`{"liquidate":{"borrower":"`+borrower.String()+
+		`","repayment":{"denom":"uumee","amount":"1000"},"reward_denom":"u/uumee"}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{lvtypes.NewMsgLiquidate(contract, borrower, asset, "u/uumee")}, msgs)
+
+	// exactly one message must be set
+	_, err = msg.CustomEncoder(contract, []byte(`{}`))
+	require.ErrorContains(t, err, msg.ErrInvalidMsg.Error())
+	_, err = msg.CustomEncoder(contract, []byte(`{"supply":{"asset":{"denom":"uumee","amount":"1"}},`+
+		`"borrow":{"asset":{"denom":"uumee","amount":"1"}}}`))
+	require.ErrorContains(t, err, msg.ErrInvalidMsg.Error())
+
+	// the message is validated
+	_, err = msg.CustomEncoder(contract, []byte(`{"borrow":{"asset":{"denom":"1","amount":"1"}}}`))
+	require.Error(t, err)
+	_, err = msg.CustomEncoder(contract, []byte(`{"liquidate":{"borrower":"invalid"}}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package msg
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lvtypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+// ErrInvalidMsg defines the error of a custom message that doesn't set exactly
+// one message.
+var ErrInvalidMsg = errors.New("custom message must set exactly one message")
+
+type (
+	// UmeeMsg defines the custom messages contracts can send to the x/leverage
+	// module. Exactly one message must be set, and it is sent on behalf of the
+	// contract, e.g.:
+	//
+	//	{"supply": {"asset": {"denom": "uumee", "amount": "1000"}}}
+	UmeeMsg struct {
+		Supply        *AssetMsg     `json:"supply,omitempty"`
+		Withdraw      *AssetMsg     `json:"withdraw,omitempty"`
+		Collateralize *AssetMsg     `json:"collateralize,omitempty"`
+		Borrow        *AssetMsg     `json:"borrow,omitempty"`
+		Repay         *AssetMsg     `json:"repay,omitempty"`
+		Liquidate     *LiquidateMsg `json:"liquidate,omitempty"`
+	}
+
+	// AssetMsg defines a message moving an asset of the contract.
+	AssetMsg struct {
+		Asset sdk.Coin `json:"asset"`
+	}
+
+	// LiquidateMsg defines the liquidation of a borrower by the contract.
+	LiquidateMsg struct {
+		Borrower    string   `json:"borrower"`
+		Repayment   sdk.Coin `json:"repayment"`
+		RewardDenom string   `json:"reward_denom"`
+	}
+)
+
+// ParseUmeeMsg decodes a custom message, checking that exactly one message is
+// set.
+func ParseUmeeMsg(msg json.RawMessage) (UmeeMsg, error) {
+	var m UmeeMsg
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return UmeeMsg{}, err
+	}
+
+	set := 0
+	for _, isSet := range []bool{
+		m.Supply != nil,
+		m.Withdraw != nil,
+		m.Collateralize != nil,
+		m.Borrow != nil,
+		m.Repay != nil,
+		m.Liquidate != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return UmeeMsg{}, ErrInvalidMsg
+	}
+
+	return m, nil
+}
+
+// ToSDKMsg returns the x/leverage message sent by the contract.
+func (m UmeeMsg) ToSDKMsg(contract sdk.AccAddress) (sdk.Msg, error) {
+	switch {
+	case m.Supply != nil:
+		return lvtypes.NewMsgSupply(contract, m.Supply.Asset), nil
+	case m.Withdraw != nil:
+		return lvtypes.NewMsgWithdraw(contract, m.Withdraw.Asset), nil
+	case m.Collateralize != nil:
+		return lvtypes.NewMsgCollateralize(contract, m.Collateralize.Asset), nil
+	case m.Borrow != nil:
+		return lvtypes.NewMsgBorrow(contract, m.Borrow.Asset), nil
+	case m.Repay != nil:
+		return lvtypes.NewMsgRepay(contract, m.Repay.Asset), nil
+	case m.Liquidate != nil:
+		borrower, err := sdk.AccAddressFromBech32(m.Liquidate.Borrower)
+		if err != nil {
+			return nil, err
+		}
+		return lvtypes.NewMsgLiquidate(contract, borrower, m.Liquidate.Repayment, m.Liquidate.RewardDenom), nil
+	}
+
+	return nil, ErrInvalidMsg
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lvkeeper "github.com/umee-network/umee/v3/x/leverage/keeper"
+	lvtypes "github.com/umee-network/umee/v3/x/leverage/types"
+	ockeeper "github.com/umee-network/umee/v3/x/oracle/keeper"
+	octypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// Plugin answers the custom queries of contracts using the gRPC query servers
+// of the x/leverage and x/oracle modules.
+type Plugin struct {
+	lvQueryServer lvtypes.QueryServer
+	ocQueryServer octypes.QueryServer
+}
+
+// NewQueryPlugin returns a Plugin querying the given keepers.
+func NewQueryPlugin(leverageKeeper lvkeeper.Keeper, oracleKeeper ockeeper.Keeper) *Plugin {
+	return &Plugin{
+		lvQueryServer: lvkeeper.NewQuerier(leverageKeeper),
+		ocQueryServer: ockeeper.NewQuerier(oracleKeeper),
+	}
+}
+
+// CustomQuerier returns the custom querier of the wasm keeper, answering an
+// UmeeQuery with the JSON encoded response of the matching gRPC query.
+func (qp *Plugin) CustomQuerier() func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		q, err := ParseUmeeQuery(request)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: request}
+		}
+
+		goCtx := sdk.WrapSDKContext(ctx)
+		var resp interface{}
+		switch {
+		case q.ExchangeRates != nil:
+			resp, err = qp.ocQueryServer.ExchangeRates(goCtx, q.ExchangeRates)
+		case q.Medians != nil:
+			resp, err = qp.ocQueryServer.Medians(goCtx, q.Medians)
+		case q.RegisteredTokens != nil:
+			resp, err = qp.lvQueryServer.RegisteredTokens(goCtx, q.RegisteredTokens)
+		case q.MarketSummary != nil:
+			resp, err = qp.lvQueryServer.MarketSummary(goCtx, q.MarketSummary)
+		case q.AccountSummary != nil:
+			resp, err = qp.lvQueryServer.AccountSummary(goCtx, q.AccountSummary)
+		case q.MaxWithdraw != nil:
+			resp, err = qp.lvQueryServer.MaxWithdraw(goCtx, q.MaxWithdraw)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(resp)
+	}
+}
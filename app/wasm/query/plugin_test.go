@@ -0,0 +1,47 @@
+package query_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/app/wasm/query"
+	lvtypes "github.com/umee-network/umee/v3/x/leverage/types"
+	octypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+func TestCustomQuerier(t *testing.T) {
+	app := umeeapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	app.OracleKeeper.SetExchangeRate(ctx, octypes.UmeeSymbol, sdk.MustNewDecFromStr("0.5"))
+
+	querier := query.NewQueryPlugin(app.LeverageKeeper, app.OracleKeeper).CustomQuerier()
+
+	bz, err := querier(ctx, []byte(`{"exchange_rates":{"denom":"UMEE"}}`))
+	require.NoError(t, err)
+	var rates octypes.QueryExchangeRatesResponse
+	require.NoError(t, json.Unmarshal(bz, &rates))
+	require.Len(t, rates.ExchangeRates, 1)
+	require.Equal(t, "UMEE", rates.ExchangeRates[0].Denom)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), rates.ExchangeRates[0].Amount)
+
+	bz, err = querier(ctx, []byte(`{"registered_tokens":{}}`))
+	require.NoError(t, err)
+	var tokens lvtypes.QueryRegisteredTokensResponse
+	require.NoError(t, json.Unmarshal(bz, &tokens))
+	require.Equal(t, app.LeverageKeeper.GetAllRegisteredTokens(ctx), tokens.Registry)
+
+	// the errors of the gRPC queries are returned
+	_, err = querier(ctx, []byte(`{"account_summary":{}}`))
+	require.Error(t, err)
+
+	// exactly one query must be set
+	_, err = querier(ctx, []byte(`{}`))
+	require.ErrorContains(t, err, query.ErrInvalidQuery.Error())
+	_, err = querier(ctx, []byte(`{"medians":{},"registered_tokens":{}}`))
+	require.ErrorContains(t, err, query.ErrInvalidQuery.Error())
+}
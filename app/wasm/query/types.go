@@ -0,0 +1,62 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+
+	lvtypes "github.com/umee-network/umee/v3/x/leverage/types"
+	octypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// ErrInvalidQuery defines the error of a custom query that doesn't set exactly
+// one query.
+var ErrInvalidQuery = errors.New("custom query must set exactly one query")
+
+// UmeeQuery defines the custom queries contracts can make to the x/leverage
+// and x/oracle modules. Exactly one query must be set, e.g.:
+//
+//	{"exchange_rates": {"denom": "UMEE"}}
+type UmeeQuery struct {
+	// ExchangeRates returns the exchange rates of every denom, or of the
+	// given symbol denom.
+	ExchangeRates *octypes.QueryExchangeRates `json:"exchange_rates,omitempty"`
+	// Medians returns the medians of every denom, or of the given symbol
+	// denom.
+	Medians *octypes.QueryMedians `json:"medians,omitempty"`
+	// RegisteredTokens returns the tokens registered in the leverage module.
+	RegisteredTokens *lvtypes.QueryRegisteredTokens `json:"registered_tokens,omitempty"`
+	// MarketSummary returns the market summary of a registered token.
+	MarketSummary *lvtypes.QueryMarketSummary `json:"market_summary,omitempty"`
+	// AccountSummary returns the USD values of the position of an account.
+	AccountSummary *lvtypes.QueryAccountSummary `json:"account_summary,omitempty"`
+	// MaxWithdraw returns the maximum amount an account can withdraw.
+	MaxWithdraw *lvtypes.QueryMaxWithdraw `json:"max_withdraw,omitempty"`
+}
+
+// ParseUmeeQuery decodes a custom query, checking that exactly one query is
+// set.
+func ParseUmeeQuery(request json.RawMessage) (UmeeQuery, error) {
+	var q UmeeQuery
+	if err := json.Unmarshal(request, &q); err != nil {
+		return UmeeQuery{}, err
+	}
+
+	set := 0
+	for _, isSet := range []bool{
+		q.ExchangeRates != nil,
+		q.Medians != nil,
+		q.RegisteredTokens != nil,
+		q.MarketSummary != nil,
+		q.AccountSummary != nil,
+		q.MaxWithdraw != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return UmeeQuery{}, ErrInvalidQuery
+	}
+
+	return q, nil
+}
@@ -0,0 +1,394 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+const (
+	flagAccounts             = "accounts"
+	flagRescale              = "rescale"
+	flagResetInterestScalars = "reset-interest-scalars"
+	flagPruneBadDebts        = "prune-bad-debts"
+	flagSkipInvariants       = "skip-invariants"
+)
+
+// genesisTransform is a set of transformations of an exported genesis state.
+type genesisTransform struct {
+	// accounts to keep, along with the module accounts. All the accounts are
+	// kept when nil.
+	accounts             map[string]bool
+	rescale              map[string]sdk.Dec
+	resetInterestScalars bool
+	pruneBadDebts        bool
+}
+
+// genesisTransformCmd returns a command which transforms an exported genesis
+// for the leverage and oracle testing scenarios.
+func genesisTransformCmd(a appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-transform [genesis-file] [output-file]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Transform an exported genesis for leverage and oracle testing scenarios",
+		Long: fmt.Sprintf(`Transform a genesis exported with "umeed export --for-zero-height", and
+validate the result. The output is written to output-file, or to stdout.
+
+--%[1]s keeps only the given accounts, along with the module accounts, in the
+  auth and bank state, and drops the leverage positions of the other accounts.
+  The collateral of the dropped positions is removed from the leverage module
+  account. Delegations are not modified.
+--%[2]s multiplies the bank balances of the given denoms by a factor, e.g.
+  uumee=0.001. The leverage reserves, borrows and collateral of these denoms
+  are rescaled too. Rescale a token and its uToken together to keep their
+  exchange rate. The staking and distribution amounts are not rescaled, so
+  rescaling the bond denom breaks their invariants.
+--%[3]s sets the x/leverage interest scalars to one, and adjusts the
+  borrows so the borrowed amounts are unchanged.
+--%[4]s removes the x/leverage bad debts.
+
+The bank supply and the x/leverage uToken supply are recomputed from the
+balances. The result is validated by the ValidateGenesis of all the modules,
+including x/leverage and x/oracle, and by the registered invariants, once
+initialized in an in-memory app, unless --%[5]s is set.
+`, flagAccounts, flagRescale, flagResetInterestScalars, flagPruneBadDebts, flagSkipInvariants),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var t genesisTransform
+
+			accounts, err := cmd.Flags().GetStringSlice(flagAccounts)
+			if err != nil {
+				return err
+			}
+			if len(accounts) > 0 {
+				t.accounts = make(map[string]bool, len(accounts))
+				for _, addr := range accounts {
+					if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+						return fmt.Errorf("invalid account %s: %w", addr, err)
+					}
+					t.accounts[addr] = true
+				}
+			}
+			rescale, err := cmd.Flags().GetStringSlice(flagRescale)
+			if err != nil {
+				return err
+			}
+			if t.rescale, err = parseRescale(rescale); err != nil {
+				return err
+			}
+			if t.resetInterestScalars, err = cmd.Flags().GetBool(flagResetInterestScalars); err != nil {
+				return err
+			}
+			if t.pruneBadDebts, err = cmd.Flags().GetBool(flagPruneBadDebts); err != nil {
+				return err
+			}
+			skipInvariants, err := cmd.Flags().GetBool(flagSkipInvariants)
+			if err != nil {
+				return err
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file: %w", err)
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(doc.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			cdc := a.encCfg.Codec
+			if err := t.apply(cdc, appState); err != nil {
+				return err
+			}
+			if err := a.moduleManager.ValidateGenesis(cdc, a.encCfg.TxConfig, appState); err != nil {
+				return fmt.Errorf("invalid transformed genesis: %w", err)
+			}
+			if doc.AppState, err = json.MarshalIndent(appState, "", "  "); err != nil {
+				return err
+			}
+			if !skipInvariants {
+				if err := a.assertGenesisInvariants(doc); err != nil {
+					return err
+				}
+			}
+
+			if len(args) == 2 {
+				return doc.SaveAs(args[1])
+			}
+			bz, err := tmjson.MarshalIndent(doc, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAccounts, nil, "Comma separated accounts to keep")
+	cmd.Flags().StringSlice(flagRescale, nil, "Comma separated denom=factor balance rescalings")
+	cmd.Flags().Bool(flagResetInterestScalars, false, "Reset the x/leverage interest scalars to one")
+	cmd.Flags().Bool(flagPruneBadDebts, false, "Remove the x/leverage bad debts")
+	cmd.Flags().Bool(flagSkipInvariants, false, "Skip the invariants check of the transformed genesis")
+
+	return cmd
+}
+
+// parseRescale parses a list of denom=factor rescalings.
+func parseRescale(rescale []string) (map[string]sdk.Dec, error) {
+	factors := make(map[string]sdk.Dec, len(rescale))
+	for _, r := range rescale {
+		denom, factorStr, ok := strings.Cut(r, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s %q: expected denom=factor", flagRescale, r)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", flagRescale, r, err)
+		}
+		factor, err := sdk.NewDecFromStr(factorStr)
+		if err != nil || !factor.IsPositive() {
+			return nil, fmt.Errorf("invalid %s %q: factor must be a positive decimal", flagRescale, r)
+		}
+		factors[denom] = factor
+	}
+	return factors, nil
+}
+
+// apply transforms the auth, bank and leverage genesis states of appState.
+func (t genesisTransform) apply(cdc codec.Codec, appState map[string]json.RawMessage) error {
+	authGen := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGen := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	levGen := leveragetypes.GetGenesisStateFromAppState(cdc, appState)
+
+	leverageAddr := authtypes.NewModuleAddress(leveragetypes.ModuleName).String()
+	balancesChanged := false
+
+	if t.accounts != nil {
+		accounts, err := authtypes.UnpackAccounts(authGen.Accounts)
+		if err != nil {
+			return err
+		}
+		kept := make(authtypes.GenesisAccounts, 0, len(t.accounts))
+		keep := make(map[string]bool, len(t.accounts))
+		// module accounts are created lazily, so their balances may be exported
+		// without an account
+		for name := range umeeapp.GetMaccPerms() {
+			keep[authtypes.NewModuleAddress(name).String()] = true
+		}
+		for _, acc := range accounts {
+			addr := acc.GetAddress().String()
+			if _, ok := acc.(authtypes.ModuleAccountI); ok || t.accounts[addr] {
+				kept = append(kept, acc)
+				keep[addr] = true
+			}
+		}
+		if authGen.Accounts, err = authtypes.PackAccounts(kept); err != nil {
+			return err
+		}
+
+		balances := make([]banktypes.Balance, 0, len(keep))
+		for _, b := range bankGen.Balances {
+			if keep[b.Address] {
+				balances = append(balances, b)
+			}
+		}
+		bankGen.Balances = balances
+
+		// the collateral of the dropped positions is held by the leverage module
+		droppedCollateral := sdk.NewCoins()
+		collateral := make([]leveragetypes.Collateral, 0, len(levGen.Collateral))
+		for _, c := range levGen.Collateral {
+			if keep[c.Address] {
+				collateral = append(collateral, c)
+			} else {
+				droppedCollateral = droppedCollateral.Add(c.Amount)
+			}
+		}
+		levGen.Collateral = collateral
+		for i, b := range bankGen.Balances {
+			if b.Address == leverageAddr {
+				coins, hasNeg := b.Coins.SafeSub(droppedCollateral...)
+				if hasNeg {
+					return fmt.Errorf("leverage module balance %s is lower than the collateral %s", b.Coins, droppedCollateral)
+				}
+				bankGen.Balances[i].Coins = coins
+			}
+		}
+
+		borrows := make([]leveragetypes.AdjustedBorrow, 0, len(levGen.AdjustedBorrows))
+		for _, b := range levGen.AdjustedBorrows {
+			if keep[b.Address] {
+				borrows = append(borrows, b)
+			}
+		}
+		levGen.AdjustedBorrows = borrows
+
+		badDebts := make([]leveragetypes.BadDebt, 0, len(levGen.BadDebts))
+		for _, d := range levGen.BadDebts {
+			if keep[d.Address] {
+				badDebts = append(badDebts, d)
+			}
+		}
+		levGen.BadDebts = badDebts
+		balancesChanged = true
+	}
+
+	if len(t.rescale) > 0 {
+		for i, b := range bankGen.Balances {
+			bankGen.Balances[i].Coins = t.rescaleCoins(b.Coins)
+		}
+		levGen.Reserves = t.rescaleCoins(levGen.Reserves)
+
+		collateral := make([]leveragetypes.Collateral, 0, len(levGen.Collateral))
+		for _, c := range levGen.Collateral {
+			if factor, ok := t.rescale[c.Amount.Denom]; ok {
+				c.Amount.Amount = factor.MulInt(c.Amount.Amount).TruncateInt()
+			}
+			if c.Amount.IsPositive() {
+				collateral = append(collateral, c)
+			}
+		}
+		levGen.Collateral = collateral
+
+		borrows := make([]leveragetypes.AdjustedBorrow, 0, len(levGen.AdjustedBorrows))
+		for _, b := range levGen.AdjustedBorrows {
+			if factor, ok := t.rescale[b.Amount.Denom]; ok {
+				b.Amount.Amount = b.Amount.Amount.Mul(factor)
+			}
+			if b.Amount.IsPositive() {
+				borrows = append(borrows, b)
+			}
+		}
+		levGen.AdjustedBorrows = borrows
+		balancesChanged = true
+	}
+
+	if t.resetInterestScalars {
+		scalars := make(map[string]sdk.Dec, len(levGen.InterestScalars))
+		for i, s := range levGen.InterestScalars {
+			scalars[s.Denom] = s.Scalar
+			levGen.InterestScalars[i].Scalar = sdk.OneDec()
+		}
+		// borrowed amount = adjusted borrow * interest scalar
+		for i, b := range levGen.AdjustedBorrows {
+			if scalar, ok := scalars[b.Amount.Denom]; ok {
+				levGen.AdjustedBorrows[i].Amount.Amount = b.Amount.Amount.Mul(scalar)
+			}
+		}
+	}
+
+	if t.pruneBadDebts {
+		levGen.BadDebts = nil
+	}
+
+	if balancesChanged {
+		balances := make([]banktypes.Balance, 0, len(bankGen.Balances))
+		supply := sdk.NewCoins()
+		for _, b := range bankGen.Balances {
+			if !b.Coins.Empty() {
+				balances = append(balances, b)
+				supply = supply.Add(b.Coins...)
+			}
+		}
+		bankGen.Balances = balances
+		bankGen.Supply = supply
+
+		uTokenSupply := sdk.NewCoins()
+		for _, c := range supply {
+			if leveragetypes.HasUTokenPrefix(c.Denom) {
+				uTokenSupply = uTokenSupply.Add(c)
+			}
+		}
+		levGen.UtokenSupply = uTokenSupply
+	}
+
+	var err error
+	if appState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGen); err != nil {
+		return err
+	}
+	if appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGen); err != nil {
+		return err
+	}
+	appState[leveragetypes.ModuleName], err = cdc.MarshalJSON(levGen)
+	return err
+}
+
+// rescaleCoins multiplies the amounts of the rescaled denoms, dropping the
+// coins truncated to zero.
+func (t genesisTransform) rescaleCoins(coins sdk.Coins) sdk.Coins {
+	rescaled := make([]sdk.Coin, 0, len(coins))
+	for _, c := range coins {
+		if factor, ok := t.rescale[c.Denom]; ok {
+			c.Amount = factor.MulInt(c.Amount).TruncateInt()
+		}
+		rescaled = append(rescaled, c)
+	}
+	return sdk.NewCoins(rescaled...)
+}
+
+// invariantsAppOptions skips the crisis invariants assertion of InitChain, so
+// all the broken invariants can be reported instead of the first one.
+type invariantsAppOptions struct{}
+
+func (invariantsAppOptions) Get(key string) interface{} {
+	if key == crisis.FlagSkipGenesisInvariants {
+		return true
+	}
+	return nil
+}
+
+// assertGenesisInvariants initializes an in-memory app with a genesis and
+// returns an error listing the broken invariants.
+func (a appCreator) assertGenesisInvariants(doc *tmtypes.GenesisDoc) (err error) {
+	home, err := os.MkdirTemp("", "umeed-genesis-transform")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(home)
+
+	app := umeeapp.New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, home, 0, a.encCfg,
+		invariantsAppOptions{}, umeeapp.GetWasmEnabledProposals(), nil,
+	)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to initialize the transformed genesis: %v", r)
+		}
+	}()
+	app.InitChain(abci.RequestInitChain{
+		Time:            doc.GenesisTime,
+		ChainId:         doc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(doc.ConsensusParams),
+		AppStateBytes:   doc.AppState,
+		InitialHeight:   doc.InitialHeight,
+	})
+
+	ctx := app.NewContext(false, tmproto.Header{ChainID: doc.ChainID, Height: doc.InitialHeight})
+	var broken []string
+	for _, route := range app.CrisisKeeper.Routes() {
+		if msg, stop := route.Invar(ctx); stop {
+			broken = append(broken, msg)
+		}
+	}
+	if len(broken) > 0 {
+		return fmt.Errorf("broken invariants:\n%s", strings.Join(broken, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+const (
+	testUToken = leveragetypes.UTokenPrefix + "uumee"
+)
+
+var (
+	testKept    = sdk.AccAddress([]byte("kept________________")).String()
+	testDropped = sdk.AccAddress([]byte("dropped_____________")).String()
+	testLevAddr = authtypes.NewModuleAddress(leveragetypes.ModuleName).String()
+)
+
+func coins(amounts ...sdk.Coin) sdk.Coins {
+	return sdk.NewCoins(amounts...)
+}
+
+func coin(denom string, amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(denom, amount)
+}
+
+func borrow(addr, denom, amount string) leveragetypes.AdjustedBorrow {
+	return leveragetypes.NewAdjustedBorrow(addr, sdk.NewDecCoinFromDec(denom, sdk.MustNewDecFromStr(amount)))
+}
+
+// testAppState returns an app state with a kept and a dropped account both
+// supplying collateral and borrowing, the leverage module holding their
+// collateral and reserves.
+func testAppState(t *testing.T) map[string]json.RawMessage {
+	cdc := umeeapp.MakeEncodingConfig().Codec
+
+	authGen := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{
+		authtypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32(testKept)),
+		authtypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32(testDropped)),
+		authtypes.NewEmptyModuleAccount(leveragetypes.ModuleName),
+	})
+
+	bankGen := banktypes.DefaultGenesisState()
+	bankGen.Balances = []banktypes.Balance{
+		{Address: testKept, Coins: coins(coin("uumee", 1000), coin(testUToken, 100))},
+		{Address: testDropped, Coins: coins(coin("uatom", 50))},
+		{Address: testLevAddr, Coins: coins(coin("uumee", 500), coin(testUToken, 300), coin("uatom", 20))},
+	}
+	bankGen.Supply = coins(coin("uumee", 1500), coin(testUToken, 400), coin("uatom", 70))
+
+	levGen := leveragetypes.DefaultGenesis()
+	levGen.Collateral = []leveragetypes.Collateral{
+		leveragetypes.NewCollateral(testKept, coin(testUToken, 200)),
+		leveragetypes.NewCollateral(testDropped, coin(testUToken, 100)),
+	}
+	levGen.AdjustedBorrows = []leveragetypes.AdjustedBorrow{
+		borrow(testKept, "uumee", "10"),
+		borrow(testDropped, "uatom", "5"),
+	}
+	levGen.InterestScalars = []leveragetypes.InterestScalar{
+		leveragetypes.NewInterestScalar("uumee", sdk.MustNewDecFromStr("1.5")),
+		leveragetypes.NewInterestScalar("uatom", sdk.MustNewDecFromStr("2")),
+	}
+	levGen.Reserves = coins(coin("uumee", 40))
+	levGen.UtokenSupply = coins(coin(testUToken, 400))
+
+	appState := map[string]json.RawMessage{}
+	appState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGen)
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGen)
+	appState[leveragetypes.ModuleName] = cdc.MustMarshalJSON(levGen)
+	return appState
+}
+
+func TestParseRescale(t *testing.T) {
+	testCases := map[string]struct {
+		input     []string
+		expected  map[string]sdk.Dec
+		expectErr bool
+	}{
+		"empty": {
+			expected: map[string]sdk.Dec{},
+		},
+		"valid": {
+			input: []string{"uumee=0.001", "u/uumee=2"},
+			expected: map[string]sdk.Dec{
+				"uumee":   sdk.MustNewDecFromStr("0.001"),
+				"u/uumee": sdk.NewDec(2),
+			},
+		},
+		"missing factor": {
+			input:     []string{"uumee"},
+			expectErr: true,
+		},
+		"invalid denom": {
+			input:     []string{"1umee=2"},
+			expectErr: true,
+		},
+		"invalid factor": {
+			input:     []string{"uumee=abc"},
+			expectErr: true,
+		},
+		"zero factor": {
+			input:     []string{"uumee=0"},
+			expectErr: true,
+		},
+		"negative factor": {
+			input:     []string{"uumee=-1"},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			factors, err := parseRescale(tc.input)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, factors)
+		})
+	}
+}
+
+func TestRescaleCoins(t *testing.T) {
+	tr := genesisTransform{rescale: map[string]sdk.Dec{
+		"uumee": sdk.MustNewDecFromStr("0.001"),
+		"uatom": sdk.NewDec(3),
+	}}
+
+	// the unscaled denoms are kept, and the coins truncated to zero dropped
+	require.Equal(t,
+		coins(coin("uumee", 2), coin("uatom", 30), coin(testUToken, 7)),
+		tr.rescaleCoins(coins(coin("uumee", 2999), coin("uatom", 10), coin(testUToken, 7))),
+	)
+	require.Equal(t, coins(coin(testUToken, 7)), tr.rescaleCoins(coins(coin("uumee", 999), coin(testUToken, 7))))
+	require.Empty(t, tr.rescaleCoins(sdk.NewCoins()))
+}
+
+func TestGenesisTransformApply(t *testing.T) {
+	testCases := map[string]struct {
+		transform       genesisTransform
+		accounts        []string
+		balances        []banktypes.Balance
+		supply          sdk.Coins
+		collateral      []leveragetypes.Collateral
+		borrows         []leveragetypes.AdjustedBorrow
+		interestScalars []leveragetypes.InterestScalar
+		reserves        sdk.Coins
+		uTokenSupply    sdk.Coins
+	}{
+		"drop accounts holding collateral": {
+			transform: genesisTransform{accounts: map[string]bool{testKept: true}},
+			accounts:  []string{testKept, testLevAddr},
+			// the collateral of the dropped account leaves the leverage module
+			balances: []banktypes.Balance{
+				{Address: testKept, Coins: coins(coin("uumee", 1000), coin(testUToken, 100))},
+				{Address: testLevAddr, Coins: coins(coin("uumee", 500), coin(testUToken, 200), coin("uatom", 20))},
+			},
+			supply: coins(coin("uumee", 1500), coin(testUToken, 300), coin("uatom", 20)),
+			collateral: []leveragetypes.Collateral{
+				leveragetypes.NewCollateral(testKept, coin(testUToken, 200)),
+			},
+			borrows:      []leveragetypes.AdjustedBorrow{borrow(testKept, "uumee", "10")},
+			uTokenSupply: coins(coin(testUToken, 300)),
+		},
+		"rescale a base denom without its uToken": {
+			transform: genesisTransform{rescale: map[string]sdk.Dec{"uumee": sdk.MustNewDecFromStr("0.01")}},
+			balances: []banktypes.Balance{
+				{Address: testKept, Coins: coins(coin("uumee", 10), coin(testUToken, 100))},
+				{Address: testDropped, Coins: coins(coin("uatom", 50))},
+				{Address: testLevAddr, Coins: coins(coin("uumee", 5), coin(testUToken, 300), coin("uatom", 20))},
+			},
+			supply: coins(coin("uumee", 15), coin(testUToken, 400), coin("uatom", 70)),
+			// the uToken collateral is unchanged, while the borrows are rescaled
+			// without truncation
+			borrows: []leveragetypes.AdjustedBorrow{
+				borrow(testKept, "uumee", "0.1"),
+				borrow(testDropped, "uatom", "5"),
+			},
+			// the reserves truncated to zero are dropped
+			reserves:     sdk.NewCoins(),
+			uTokenSupply: coins(coin(testUToken, 400)),
+		},
+		"reset the interest scalars": {
+			transform: genesisTransform{resetInterestScalars: true},
+			// the borrowed amounts are unchanged
+			borrows: []leveragetypes.AdjustedBorrow{
+				borrow(testKept, "uumee", "15"),
+				borrow(testDropped, "uatom", "10"),
+			},
+			interestScalars: []leveragetypes.InterestScalar{
+				leveragetypes.NewInterestScalar("uumee", sdk.OneDec()),
+				leveragetypes.NewInterestScalar("uatom", sdk.OneDec()),
+			},
+		},
+		"recompute the supplies with the uToken rescaled": {
+			transform: genesisTransform{rescale: map[string]sdk.Dec{testUToken: sdk.MustNewDecFromStr("0.5")}},
+			balances: []banktypes.Balance{
+				{Address: testKept, Coins: coins(coin("uumee", 1000), coin(testUToken, 50))},
+				{Address: testDropped, Coins: coins(coin("uatom", 50))},
+				{Address: testLevAddr, Coins: coins(coin("uumee", 500), coin(testUToken, 150), coin("uatom", 20))},
+			},
+			supply: coins(coin("uumee", 1500), coin(testUToken, 200), coin("uatom", 70)),
+			collateral: []leveragetypes.Collateral{
+				leveragetypes.NewCollateral(testKept, coin(testUToken, 100)),
+				leveragetypes.NewCollateral(testDropped, coin(testUToken, 50)),
+			},
+			uTokenSupply: coins(coin(testUToken, 200)),
+		},
+	}
+
+	cdc := umeeapp.MakeEncodingConfig().Codec
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			appState := testAppState(t)
+			authBefore := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			bankBefore := banktypes.GetGenesisStateFromAppState(cdc, appState)
+			levBefore := leveragetypes.GetGenesisStateFromAppState(cdc, appState)
+
+			require.NoError(t, tc.transform.apply(cdc, appState))
+			authGen := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			bankGen := banktypes.GetGenesisStateFromAppState(cdc, appState)
+			levGen := leveragetypes.GetGenesisStateFromAppState(cdc, appState)
+
+			if tc.accounts == nil {
+				require.Equal(t, authBefore.Accounts, authGen.Accounts)
+			} else {
+				accounts, err := authtypes.UnpackAccounts(authGen.Accounts)
+				require.NoError(t, err)
+				addresses := make([]string, len(accounts))
+				for i, acc := range accounts {
+					addresses[i] = acc.GetAddress().String()
+				}
+				require.Equal(t, tc.accounts, addresses)
+			}
+
+			// the fields without expected value are unchanged
+			expect := func(expected, before, actual interface{}) {
+				if reflect.ValueOf(expected).IsNil() {
+					expected = before
+				}
+				require.Equal(t, expected, actual)
+			}
+			expect(tc.balances, bankBefore.Balances, bankGen.Balances)
+			expect(tc.supply, bankBefore.Supply, bankGen.Supply)
+			expect(tc.collateral, levBefore.Collateral, levGen.Collateral)
+			expect(tc.borrows, levBefore.AdjustedBorrows, levGen.AdjustedBorrows)
+			expect(tc.interestScalars, levBefore.InterestScalars, levGen.InterestScalars)
+			expect(tc.reserves, levBefore.Reserves, levGen.Reserves)
+			expect(tc.uTokenSupply, levBefore.UtokenSupply, levGen.UtokenSupply)
+		})
+	}
+}
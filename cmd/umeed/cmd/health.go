@@ -0,0 +1,310 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+const (
+	flagLiquidationTargets = "liquidation-targets"
+	flagWarnRatio          = "warn-ratio"
+	flagWebhook            = "webhook"
+
+	healthSubscriber     = "umeed-health-watcher"
+	healthWebhookTimeout = 10 * time.Second
+
+	healthStatusWarning      = "warning"
+	healthStatusLiquidatable = "liquidatable"
+)
+
+// accountHealth is the JSON report of an account whose borrowed value
+// approaches or exceeds its liquidation threshold.
+type accountHealth struct {
+	Height               int64   `json:"height"`
+	Address              string  `json:"address"`
+	Status               string  `json:"status"`
+	BorrowedValue        sdk.Dec `json:"borrowed_value"`
+	LiquidationThreshold sdk.Dec `json:"liquidation_threshold"`
+	// Ratio is nil when the liquidation threshold is zero: the ratio is
+	// unbounded.
+	Ratio *sdk.Dec `json:"ratio,omitempty"`
+}
+
+// newAccountHealth returns the health report of an account summary, and false
+// if the ratio of its borrowed value to its liquidation threshold is below
+// warnRatio. Accounts without borrows are always healthy.
+func newAccountHealth(
+	height int64, addr string, s *leveragetypes.QueryAccountSummaryResponse, warnRatio sdk.Dec,
+) (accountHealth, bool) {
+	if !s.BorrowedValue.IsPositive() {
+		return accountHealth{}, false
+	}
+
+	h := accountHealth{
+		Height:               height,
+		Address:              addr,
+		Status:               healthStatusWarning,
+		BorrowedValue:        s.BorrowedValue,
+		LiquidationThreshold: s.LiquidationThreshold,
+	}
+	// the same condition as x/leverage GetEligibleLiquidationTargets
+	if s.LiquidationThreshold.LT(s.BorrowedValue) {
+		h.Status = healthStatusLiquidatable
+	}
+	if !s.LiquidationThreshold.IsPositive() {
+		return h, true
+	}
+
+	ratio := s.BorrowedValue.Quo(s.LiquidationThreshold)
+	h.Ratio = &ratio
+	return h, h.Status == healthStatusLiquidatable || ratio.GTE(warnRatio)
+}
+
+// healthWatcherCmd returns a command which follows new blocks and reports the
+// accounts approaching liquidation.
+func healthWatcherCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-health [addresses...]",
+		Short: "Follow new blocks and report the accounts approaching liquidation",
+		Long: fmt.Sprintf(`Follow the new blocks of a node over RPC and report the tracked accounts
+whose borrowed value approaches their liquidation threshold, as computed by
+the x/leverage account summary query.
+
+A JSON line is written to stdout for each account whose ratio of borrowed value
+to liquidation threshold reaches --%[1]s, with the status "%[2]s", or
+"%[3]s" once the borrowed value exceeds the liquidation threshold.
+An account is only reported when its status changes: when it becomes unhealthy,
+or moves between the two statuses. The ratio is omitted when the liquidation
+threshold is zero.
+With --%[4]s, each report is also POSTed as JSON to the given URL.
+
+With --%[5]s, the eligible liquidation targets are also tracked at
+every block. They only include the already liquidatable borrowers, and the
+node must enable the liquidator query.
+
+Example:
+$ %[6]s watch-health umee1... umee1... --%[1]s 0.9 --node tcp://localhost:26657
+$ %[6]s watch-health --%[5]s --%[4]s https://example.com/hook
+`, flagWarnRatio, healthStatusWarning, healthStatusLiquidatable, flagWebhook,
+			flagLiquidationTargets, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			for _, addr := range args {
+				if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+					return fmt.Errorf("invalid address %s: %w", addr, err)
+				}
+			}
+			useTargets, err := cmd.Flags().GetBool(flagLiquidationTargets)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 && !useTargets {
+				return fmt.Errorf("no addresses to watch: provide addresses or --%s", flagLiquidationTargets)
+			}
+			warnRatioStr, err := cmd.Flags().GetString(flagWarnRatio)
+			if err != nil {
+				return err
+			}
+			warnRatio, err := sdk.NewDecFromStr(warnRatioStr)
+			if err != nil || !warnRatio.IsPositive() {
+				return fmt.Errorf("invalid %s: %s", flagWarnRatio, warnRatioStr)
+			}
+			webhook, err := cmd.Flags().GetString(flagWebhook)
+			if err != nil {
+				return err
+			}
+
+			w := healthWatcher{
+				clientCtx:  clientCtx,
+				addresses:  args,
+				useTargets: useTargets,
+				warnRatio:  warnRatio,
+				webhook:    webhook,
+				out:        cmd.OutOrStdout(),
+				errOut:     cmd.ErrOrStderr(),
+				statuses:   make(map[string]string),
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			return w.run(ctx)
+		},
+	}
+
+	cmd.Flags().Bool(flagLiquidationTargets, false, "Also watch the eligible liquidation targets")
+	cmd.Flags().String(flagWarnRatio, "0.9", "Borrowed value to liquidation threshold ratio to report at")
+	cmd.Flags().String(flagWebhook, "", "URL to POST the JSON reports to")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+type healthWatcher struct {
+	clientCtx  client.Context
+	addresses  []string
+	useTargets bool
+	warnRatio  sdk.Dec
+	webhook    string
+	out        io.Writer
+	errOut     io.Writer
+	// statuses holds the last reported status of the unhealthy accounts
+	statuses map[string]string
+}
+
+// run subscribes to the new blocks of the node and checks the watched
+// accounts at every block, until the context is done.
+func (w healthWatcher) run(ctx context.Context) error {
+	node, err := w.clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	if err := node.Start(); err != nil {
+		return fmt.Errorf("failed to connect to the node: %w", err)
+	}
+	defer node.Stop() //nolint: errcheck
+
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()
+	blocks, err := node.Subscribe(ctx, healthSubscriber, query)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+	defer node.UnsubscribeAll(context.Background(), healthSubscriber) //nolint: errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-blocks:
+			if !ok {
+				return fmt.Errorf("new block subscription closed")
+			}
+			block, ok := ev.Data.(tmtypes.EventDataNewBlock)
+			if !ok || block.Block == nil {
+				continue
+			}
+			// errors are transient (e.g. missing prices), so the watcher keeps going
+			if err := w.check(ctx, block.Block.Height); err != nil {
+				fmt.Fprintf(w.errOut, "height %d: %v\n", block.Block.Height, err)
+			}
+		}
+	}
+}
+
+// check reports the unhealthy watched accounts at a given height.
+func (w healthWatcher) check(ctx context.Context, height int64) error {
+	queryClient := leveragetypes.NewQueryClient(w.clientCtx.WithHeight(height))
+
+	addresses := w.addresses
+	if w.useTargets {
+		resp, err := queryClient.LiquidationTargets(ctx, &leveragetypes.QueryLiquidationTargets{})
+		if err != nil {
+			return fmt.Errorf("failed to query liquidation targets: %w", err)
+		}
+		addresses = mergeAddresses(addresses, resp.Targets)
+	}
+
+	for _, addr := range addresses {
+		summary, err := queryClient.AccountSummary(ctx, &leveragetypes.QueryAccountSummary{Address: addr})
+		if err != nil {
+			fmt.Fprintf(w.errOut, "height %d: failed to query account %s: %v\n", height, addr, err)
+			continue
+		}
+		h, unhealthy := newAccountHealth(height, addr, summary, w.warnRatio)
+		if w.statusChanged(addr, h, unhealthy) {
+			if err := w.report(ctx, h); err != nil {
+				fmt.Fprintf(w.errOut, "height %d: %v\n", height, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// statusChanged records the status of an account, and returns true if it is
+// unhealthy with a status other than the last reported one.
+func (w healthWatcher) statusChanged(addr string, h accountHealth, unhealthy bool) bool {
+	if !unhealthy {
+		delete(w.statuses, addr)
+		return false
+	}
+	if w.statuses[addr] == h.Status {
+		return false
+	}
+
+	w.statuses[addr] = h.Status
+	return true
+}
+
+// report writes a health report as a JSON line and posts it to the webhook.
+func (w healthWatcher) report(ctx context.Context, h accountHealth) error {
+	bz, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w.out, string(bz)); err != nil {
+		return err
+	}
+	if w.webhook == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthWebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.webhook, bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// mergeAddresses returns the addresses followed by the new targets, without
+// duplicates.
+func mergeAddresses(addresses, targets []string) []string {
+	seen := make(map[string]struct{}, len(addresses))
+	merged := make([]string, 0, len(addresses)+len(targets))
+	for _, addrs := range [][]string{addresses, targets} {
+		for _, a := range addrs {
+			if _, ok := seen[a]; !ok {
+				seen[a] = struct{}{}
+				merged = append(merged, a)
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+func TestNewAccountHealth(t *testing.T) {
+	summary := func(borrowed, threshold string) *leveragetypes.QueryAccountSummaryResponse {
+		return &leveragetypes.QueryAccountSummaryResponse{
+			BorrowedValue:        sdk.MustNewDecFromStr(borrowed),
+			LiquidationThreshold: sdk.MustNewDecFromStr(threshold),
+		}
+	}
+	warnRatio := sdk.MustNewDecFromStr("0.9")
+
+	testCases := map[string]struct {
+		summary        *leveragetypes.QueryAccountSummaryResponse
+		expectReported bool
+		expectStatus   string
+		expectRatio    string
+	}{
+		"no borrow": {
+			summary: summary("0", "100"),
+		},
+		"no borrow nor collateral": {
+			summary: summary("0", "0"),
+		},
+		"healthy": {
+			summary: summary("80", "100"),
+		},
+		"at the warn ratio": {
+			summary:        summary("90", "100"),
+			expectReported: true,
+			expectStatus:   healthStatusWarning,
+			expectRatio:    "0.9",
+		},
+		"at the liquidation threshold": {
+			summary:        summary("100", "100"),
+			expectReported: true,
+			expectStatus:   healthStatusWarning,
+			expectRatio:    "1",
+		},
+		"liquidatable": {
+			summary:        summary("150", "100"),
+			expectReported: true,
+			expectStatus:   healthStatusLiquidatable,
+			expectRatio:    "1.5",
+		},
+		"borrow without liquidation threshold": {
+			summary:        summary("10", "0"),
+			expectReported: true,
+			expectStatus:   healthStatusLiquidatable,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			h, ok := newAccountHealth(7, "umee1addr", tc.summary, warnRatio)
+			require.Equal(t, tc.expectReported, ok)
+			if !tc.expectReported {
+				return
+			}
+
+			expected := accountHealth{
+				Height:               7,
+				Address:              "umee1addr",
+				Status:               tc.expectStatus,
+				BorrowedValue:        tc.summary.BorrowedValue,
+				LiquidationThreshold: tc.summary.LiquidationThreshold,
+			}
+			if tc.expectRatio != "" {
+				ratio := sdk.MustNewDecFromStr(tc.expectRatio)
+				expected.Ratio = &ratio
+			}
+			require.Equal(t, expected, h)
+		})
+	}
+}
+
+func TestHealthStatusChanged(t *testing.T) {
+	w := healthWatcher{statuses: make(map[string]string)}
+	warning := accountHealth{Status: healthStatusWarning}
+	liquidatable := accountHealth{Status: healthStatusLiquidatable}
+
+	// an unhealthy account is reported once per status
+	require.True(t, w.statusChanged("a", warning, true))
+	require.False(t, w.statusChanged("a", warning, true))
+	require.True(t, w.statusChanged("a", liquidatable, true))
+	require.False(t, w.statusChanged("a", liquidatable, true))
+	require.True(t, w.statusChanged("a", warning, true))
+
+	// the accounts are tracked independently
+	require.True(t, w.statusChanged("b", warning, true))
+
+	// a recovered account is reported again when it becomes unhealthy
+	require.False(t, w.statusChanged("a", accountHealth{}, false))
+	require.True(t, w.statusChanged("a", warning, true))
+}
+
+func TestAccountHealthJSON(t *testing.T) {
+	h := accountHealth{
+		Height:               7,
+		Address:              "umee1addr",
+		Status:               healthStatusLiquidatable,
+		BorrowedValue:        sdk.NewDec(10),
+		LiquidationThreshold: sdk.ZeroDec(),
+	}
+
+	// the unbounded ratio is omitted
+	bz, err := json.Marshal(h)
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "ratio")
+
+	ratio := sdk.MustNewDecFromStr("0.95")
+	h.Ratio = &ratio
+	bz, err = json.Marshal(h)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"ratio":"0.950000000000000000"`)
+}
+
+func TestMergeAddresses(t *testing.T) {
+	testCases := map[string]struct {
+		addresses []string
+		targets   []string
+		expected  []string
+	}{
+		"empty": {
+			expected: []string{},
+		},
+		"addresses only": {
+			addresses: []string{"a", "b"},
+			expected:  []string{"a", "b"},
+		},
+		"targets only": {
+			targets:  []string{"c", "a"},
+			expected: []string{"c", "a"},
+		},
+		"targets follow the addresses without duplicates": {
+			addresses: []string{"a", "b"},
+			targets:   []string{"c", "b", "a", "d", "c"},
+			expected:  []string{"a", "b", "c", "d"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, mergeAddresses(tc.addresses, tc.targets))
+		})
+	}
+}
@@ -25,19 +25,32 @@ import (
 
 	umeeapp "github.com/umee-network/umee/v3/app"
 	appparams "github.com/umee-network/umee/v3/app/params"
+	"github.com/umee-network/umee/v3/x/incentive"
+	incentivecli "github.com/umee-network/umee/v3/x/incentive/client/cli"
 	"github.com/umee-network/umee/v3/x/leverage"
 )
 
+// makeClientEncodingConfig returns the encoding configuration of the client
+// commands. It extends the application's one with the x/incentive messages,
+// which aren't registered in the app since the module isn't wired yet.
+func makeClientEncodingConfig() appparams.EncodingConfig {
+	encodingConfig := umeeapp.MakeEncodingConfig()
+	incentive.RegisterLegacyAminoCodec(encodingConfig.Amino)
+	incentive.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return encodingConfig
+}
+
 // NewRootCmd returns the root command handler for the Umee daemon.
 func NewRootCmd() (*cobra.Command, appparams.EncodingConfig) {
 	encodingConfig := umeeapp.MakeEncodingConfig()
+	clientEncodingConfig := makeClientEncodingConfig()
 	moduleManager := umeeapp.ModuleBasics
 
 	initClientCtx := client.Context{}.
-		WithCodec(encodingConfig.Codec).
-		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
-		WithTxConfig(encodingConfig.TxConfig).
-		WithLegacyAmino(encodingConfig.Amino).
+		WithCodec(clientEncodingConfig.Codec).
+		WithInterfaceRegistry(clientEncodingConfig.InterfaceRegistry).
+		WithTxConfig(clientEncodingConfig.TxConfig).
+		WithLegacyAmino(clientEncodingConfig.Amino).
 		WithInput(os.Stdin).
 		WithAccountRetriever(types.AccountRetriever{}).
 		WithBroadcastMode(flags.BroadcastBlock).
@@ -167,8 +180,10 @@ func initRootCmd(rootCmd *cobra.Command, a appCreator) {
 		bridgeGenTxCmd,
 		genutilcli.ValidateGenesisCmd(a.moduleManager),
 		addGenesisAccountCmd(umeeapp.DefaultNodeHome),
+		genesisTransformCmd(a),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debugCmd(),
+		healthWatcherCmd(),
 		config.Cmd(),
 	)
 
@@ -210,6 +225,8 @@ func queryCommand(ac appCreator) *cobra.Command {
 	)
 
 	ac.moduleManager.AddQueryCommands(cmd)
+	// x/incentive isn't an app module yet, so its commands are added directly
+	cmd.AddCommand(incentivecli.GetQueryCmd())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -237,6 +254,7 @@ func txCommand(ac appCreator) *cobra.Command {
 	)
 
 	ac.moduleManager.AddTxCommands(cmd)
+	cmd.AddCommand(incentivecli.GetTxCmd())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
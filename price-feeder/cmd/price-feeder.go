@@ -21,11 +21,10 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/umee-network/umee/price-feeder/v2/config"
 	"github.com/umee-network/umee/price-feeder/v2/oracle"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/client"
-	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/history"
 	v1 "github.com/umee-network/umee/price-feeder/v2/router/v1"
 )
 
@@ -33,8 +32,9 @@ const (
 	logLevelJSON = "json"
 	logLevelText = "text"
 
-	flagLogLevel  = "log-level"
-	flagLogFormat = "log-format"
+	flagLogLevel    = "log-level"
+	flagLogFormat   = "log-format"
+	flagWatchConfig = "watch-config"
 
 	envVariablePass = "PRICE_FEEDER_PASS"
 )
@@ -55,6 +55,7 @@ vote and prevote messages following the oracle voting procedure.`,
 func init() {
 	rootCmd.PersistentFlags().String(flagLogLevel, zerolog.InfoLevel.String(), "logging level")
 	rootCmd.PersistentFlags().String(flagLogFormat, logLevelText, "logging format; must be either json or text")
+	rootCmd.Flags().Bool(flagWatchConfig, false, "reload the config when the config file changes, in addition to on SIGHUP")
 
 	rootCmd.AddCommand(getVersionCmd())
 }
@@ -98,6 +99,11 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 
 	logger := zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()
 
+	watchConfigFile, err := cmd.Flags().GetBool(flagWatchConfig)
+	if err != nil {
+		return err
+	}
+
 	cfg, err := config.ParseConfig(args[0])
 	if err != nil {
 		return err
@@ -119,10 +125,27 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse RPC timeout: %w", err)
 	}
 
-	// Gather pass via env variable || std input
-	keyringPass, err := getKeyringPassword()
-	if err != nil {
-		return err
+	// the transactions are signed either by a remote signer or by the keyring,
+	// in which case the pass is gathered via env variable || std input
+	var (
+		signer      client.Signer
+		keyringPass string
+	)
+	if len(cfg.RemoteSigner.Endpoint) > 0 {
+		signerTimeout, err := time.ParseDuration(cfg.RemoteSigner.Timeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse remote signer timeout: %w", err)
+		}
+
+		signer, err = client.NewRemoteSigner(ctx, cfg.RemoteSigner.Endpoint, cfg.RemoteSigner.TLS, signerTimeout)
+		if err != nil {
+			return err
+		}
+	} else {
+		keyringPass, err = getKeyringPassword()
+		if err != nil {
+			return err
+		}
 	}
 
 	oracleClient, err := client.NewOracleClient(
@@ -132,12 +155,13 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		cfg.Keyring.Backend,
 		cfg.Keyring.Dir,
 		keyringPass,
-		cfg.RPC.TMRPCEndpoint,
+		cfg.RPC.TMRPCEndpoints(),
 		rpcTimeout,
 		cfg.Account.Address,
 		cfg.Account.Validator,
-		cfg.RPC.GRPCEndpoint,
+		cfg.RPC.GRPCEndpoints(),
 		cfg.GasAdjustment,
+		signer,
 	)
 	if err != nil {
 		return err
@@ -148,28 +172,39 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse provider timeout: %w", err)
 	}
 
-	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
-	for _, deviation := range cfg.Deviations {
-		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
+	deviations, err := parseDeviations(cfg)
+	if err != nil {
+		return err
+	}
+
+	oracleOpts := oracle.NewOptions(cfg)
+	if cfg.History.Dir != "" {
+		historyRetention, err := time.ParseDuration(cfg.History.Retention)
+		if err != nil {
+			return fmt.Errorf("failed to parse history retention: %w", err)
+		}
+
+		historyStore, err := history.NewStore(cfg.History.Dir, historyRetention)
 		if err != nil {
 			return err
 		}
-		deviations[deviation.Base] = threshold
-	}
+		defer historyStore.Close()
 
-	endpoints := make(map[provider.Name]provider.Endpoint, len(cfg.ProviderEndpoints))
-	for _, endpoint := range cfg.ProviderEndpoints {
-		endpoints[endpoint.Name] = endpoint
+		oracleOpts.History = historyStore
 	}
 
-	oracle := oracle.New(
+	oracle, err := oracle.New(
 		logger,
 		oracleClient,
 		cfg.CurrencyPairs,
 		providerTimeout,
 		deviations,
-		endpoints,
+		providerEndpoints(cfg),
+		oracleOpts,
 	)
+	if err != nil {
+		return err
+	}
 
 	telemetryCfg := telemetry.Config{}
 	err = mapstructure.Decode(cfg.Telemetry, &telemetryCfg)
@@ -189,6 +224,10 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		// start the process that calculates oracle prices and votes
 		return startPriceOracle(ctx, logger, oracle)
 	})
+	g.Go(func() error {
+		// start the process that reloads the config on SIGHUP or file change
+		return watchConfig(ctx, logger, args[0], cfg, watchConfigFile, oracle)
+	})
 
 	// Block main process until all spawned goroutines have gracefully exited and
 	// signal has been captured in the main process or if an error occurs.
@@ -287,7 +326,8 @@ func startPriceOracle(ctx context.Context, logger zerolog.Logger, oracle *oracle
 		select {
 		case <-ctx.Done():
 			logger.Info().Msg("shutting down price-feeder oracle...")
-			return nil
+			// wait for the oracle to close its providers
+			return <-srvErrCh
 
 		case err := <-srvErrCh:
 			logger.Err(err).Msg("error starting the price-feeder oracle")
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+)
+
+// configChangeDelay defines the time to wait for a config file to settle after
+// a change, as editors usually write it in several operations.
+const configChangeDelay = 500 * time.Millisecond
+
+// parseDeviations returns the deviation thresholds of the config per base.
+func parseDeviations(cfg config.Config) (map[string]sdk.Dec, error) {
+	deviations := make(map[string]sdk.Dec, len(cfg.Deviations))
+	for _, deviation := range cfg.Deviations {
+		threshold, err := sdk.NewDecFromStr(deviation.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		deviations[deviation.Base] = threshold
+	}
+
+	return deviations, nil
+}
+
+// providerEndpoints returns the provider endpoints of the config per provider.
+func providerEndpoints(cfg config.Config) map[provider.Name]provider.Endpoint {
+	endpoints := make(map[provider.Name]provider.Endpoint, len(cfg.ProviderEndpoints))
+	for _, endpoint := range cfg.ProviderEndpoints {
+		endpoints[endpoint.Name] = endpoint
+	}
+
+	return endpoints
+}
+
+// watchConfig reloads the config file on SIGHUP and, when watchFile is set, on
+// every change of the file, until the context is done. Only the currency
+// pairs, deviation thresholds and provider endpoints can be reloaded, the other
+// settings require a restart.
+func watchConfig(
+	ctx context.Context,
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	watchFile bool,
+	oracle *oracle.Oracle,
+) error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	var (
+		fileEvents <-chan fsnotify.Event
+		fileErrors <-chan error
+	)
+	if watchFile {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+
+		// the directory is watched as editors may replace the file
+		if err := watcher.Add(filepath.Dir(configPath)); err != nil {
+			return err
+		}
+		fileEvents, fileErrors = watcher.Events, watcher.Errors
+	}
+
+	changeTimer := time.NewTimer(configChangeDelay)
+	changeTimer.Stop()
+	defer changeTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case sig := <-sigCh:
+			logger.Info().Str("signal", sig.String()).Msg("caught signal; reloading config...")
+			cfg = reloadConfig(ctx, logger, configPath, cfg, oracle)
+
+		case event := <-fileEvents:
+			if filepath.Clean(event.Name) == filepath.Clean(configPath) && !event.Has(fsnotify.Chmod) {
+				changeTimer.Reset(configChangeDelay)
+			}
+
+		case <-changeTimer.C:
+			logger.Info().Str("path", configPath).Msg("config file changed; reloading config...")
+			cfg = reloadConfig(ctx, logger, configPath, cfg, oracle)
+
+		case err := <-fileErrors:
+			logger.Err(err).Msg("failed to watch config file")
+		}
+	}
+}
+
+// reloadConfig parses the config file and reloads the oracle with it. It
+// returns the new config, or the current one if the new config is invalid or
+// changes settings that can't be reloaded.
+func reloadConfig(
+	ctx context.Context,
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	oracle *oracle.Oracle,
+) config.Config {
+	newCfg, err := config.ParseConfig(configPath)
+	if err != nil {
+		logger.Err(err).Msg("failed to reload config; keeping the current config")
+		return cfg
+	}
+
+	if err := config.CheckProviderMins(ctx, logger, newCfg); err != nil {
+		logger.Err(err).Msg("failed to reload config; keeping the current config")
+		return cfg
+	}
+
+	deviations, err := parseDeviations(newCfg)
+	if err != nil {
+		logger.Err(err).Msg("failed to reload config; keeping the current config")
+		return cfg
+	}
+
+	// other settings can't be reloaded and may be relied upon by the new
+	// pairs, ex. custom providers
+	unchanged, current := newCfg, cfg
+	for _, c := range []*config.Config{&unchanged, &current} {
+		c.CurrencyPairs, c.Deviations, c.ProviderEndpoints = nil, nil, nil
+	}
+	if !reflect.DeepEqual(unchanged, current) {
+		logger.Error().Msg("config changes other than currency pairs, deviation thresholds and " +
+			"provider endpoints require a restart; keeping the current config")
+		return cfg
+	}
+
+	oracle.Reload(newCfg.CurrencyPairs, deviations, providerEndpoints(newCfg))
+	return newCfg
+}
@@ -18,10 +18,26 @@ import (
 const (
 	DenomUSD = "USD"
 
-	defaultListenAddr      = "0.0.0.0:7171"
-	defaultSrvWriteTimeout = 15 * time.Second
-	defaultSrvReadTimeout  = 15 * time.Second
-	defaultProviderTimeout = 100 * time.Millisecond
+	// AggregationTVWAP defines the default aggregation strategy: the TVWAP of
+	// the candles, or the VWAP of the tickers, of the providers.
+	AggregationTVWAP = "tvwap"
+	// AggregationMedian defines the median of the prices of the providers.
+	AggregationMedian = "median"
+	// AggregationTrimmedMean defines the mean of the prices of the providers
+	// without the highest and lowest ones.
+	AggregationTrimmedMean = "trimmed_mean"
+	// AggregationLiquidityWeighted defines the mean of the prices of the
+	// providers weighted by their capped volume.
+	AggregationLiquidityWeighted = "liquidity_weighted"
+
+	defaultListenAddr          = "0.0.0.0:7171"
+	defaultSrvWriteTimeout     = 15 * time.Second
+	defaultSrvReadTimeout      = 15 * time.Second
+	defaultProviderTimeout     = 100 * time.Millisecond
+	defaultReplaySpeed         = 1
+	defaultHistoryRetention    = 24 * time.Hour
+	defaultRemoteSignerTimeout = 5 * time.Second
+	defaultProvidersPerBase    = 3
 )
 
 var (
@@ -52,6 +68,27 @@ var (
 	// deviations which validators are able to set for a given asset.
 	maxDeviationThreshold = sdk.MustNewDecFromStr("3.0")
 
+	// maxTrimRatio is the exclusive maximum ratio of the highest, and of the
+	// lowest, prices discarded by the trimmed mean aggregation strategy.
+	maxTrimRatio = sdk.MustNewDecFromStr("0.5")
+
+	// defaultAutoPairsProviders defines the providers auto pairs are
+	// discovered on, in order of preference, if none is configured
+	defaultAutoPairsProviders = []provider.Name{
+		provider.ProviderBinance,
+		provider.ProviderOkx,
+		provider.ProviderHuobi,
+		provider.ProviderCoinbase,
+		provider.ProviderKraken,
+		provider.ProviderCrypto,
+		provider.ProviderMexc,
+		provider.ProviderBitget,
+	}
+
+	// defaultAutoPairsQuotes defines the quotes of auto pairs, in order of
+	// preference, if none is configured
+	defaultAutoPairsQuotes = []string{"USDT", DenomUSD, "USDC"}
+
 	// SupportedQuotes defines a lookup table for which assets we support
 	// using as quotes.
 	SupportedQuotes = map[string]struct{}{
@@ -68,17 +105,24 @@ var (
 type (
 	// Config defines all necessary price-feeder configuration parameters.
 	Config struct {
-		Server              Server              `mapstructure:"server"`
-		CurrencyPairs       []CurrencyPair      `mapstructure:"currency_pairs" validate:"required,gt=0,dive,required"`
-		Deviations          []Deviation         `mapstructure:"deviation_thresholds"`
-		Account             Account             `mapstructure:"account" validate:"required,gt=0,dive,required"`
-		Keyring             Keyring             `mapstructure:"keyring" validate:"required,gt=0,dive,required"`
-		RPC                 RPC                 `mapstructure:"rpc" validate:"required,gt=0,dive,required"`
-		Telemetry           telemetry.Config    `mapstructure:"telemetry"`
-		GasAdjustment       float64             `mapstructure:"gas_adjustment" validate:"required"`
-		ProviderTimeout     string              `mapstructure:"provider_timeout"`
-		ProviderMinOverride bool                `mapstructure:"provider_min_override"`
-		ProviderEndpoints   []provider.Endpoint `mapstructure:"provider_endpoints" validate:"dive"`
+		Server              Server                          `mapstructure:"server"`
+		CurrencyPairs       []CurrencyPair                  `mapstructure:"currency_pairs" validate:"required,gt=0,dive,required"`
+		Deviations          []Deviation                     `mapstructure:"deviation_thresholds"`
+		Account             Account                         `mapstructure:"account" validate:"required,gt=0,dive,required"`
+		Keyring             Keyring                         `mapstructure:"keyring"`
+		RemoteSigner        RemoteSigner                    `mapstructure:"remote_signer"`
+		RPC                 RPC                             `mapstructure:"rpc" validate:"required,gt=0,dive,required"`
+		Telemetry           telemetry.Config                `mapstructure:"telemetry"`
+		GasAdjustment       float64                         `mapstructure:"gas_adjustment" validate:"required"`
+		ProviderTimeout     string                          `mapstructure:"provider_timeout"`
+		ProviderMinOverride bool                            `mapstructure:"provider_min_override"`
+		ProviderEndpoints   []provider.Endpoint             `mapstructure:"provider_endpoints" validate:"dive"`
+		Recording           Recording                       `mapstructure:"recording"`
+		CustomProviders     []provider.CustomProviderConfig `mapstructure:"custom_providers" validate:"dive"`
+		DexProviders        []provider.DexProviderConfig    `mapstructure:"dex_providers" validate:"dive"`
+		History             History                         `mapstructure:"history"`
+		AutoPairs           AutoPairs                       `mapstructure:"auto_pairs"`
+		Aggregations        []Aggregation                   `mapstructure:"aggregations" validate:"dive"`
 	}
 
 	// Server defines the API server configuration.
@@ -113,20 +157,84 @@ type (
 		Validator string `mapstructure:"validator" validate:"required"`
 	}
 
-	// Keyring defines the required Umee keyring configuration.
+	// Keyring defines the Umee keyring configuration, required unless the
+	// transactions are signed by a remote signer.
 	Keyring struct {
-		Backend string `mapstructure:"backend" validate:"required"`
-		Dir     string `mapstructure:"dir" validate:"required"`
+		Backend string `mapstructure:"backend"`
+		Dir     string `mapstructure:"dir"`
+	}
+
+	// RemoteSigner defines the remote signing service configuration. When
+	// Endpoint is set, the oracle transactions are signed by the service over
+	// gRPC instead of the keyring.
+	RemoteSigner struct {
+		Endpoint string `mapstructure:"endpoint"`
+		TLS      bool   `mapstructure:"tls"`
+		Timeout  string `mapstructure:"timeout"`
+	}
+
+	// Recording defines the provider record and replay configuration. When Dir
+	// is set, the responses of every provider are recorded to, or replayed from
+	// when Replay is set, a <provider>.jsonl file in Dir. Speed accelerates the
+	// replay, 1 replays the responses at their original pace.
+	Recording struct {
+		Dir    string  `mapstructure:"dir"`
+		Replay bool    `mapstructure:"replay"`
+		Speed  float64 `mapstructure:"speed" validate:"gte=0"`
+	}
+
+	// History defines the tick history configuration. When Dir is set, the
+	// outcome of every oracle tick is persisted in Dir for Retention and served
+	// by the API.
+	History struct {
+		Dir       string `mapstructure:"dir"`
+		Retention string `mapstructure:"retention"`
+	}
+
+	// AutoPairs defines the automatic discovery of the currency pairs of the
+	// denoms of the on-chain AcceptList that no currency pair is configured
+	// for. Providers and Quotes are in order of preference and every denom is
+	// quoted by up to ProvidersPerBase providers.
+	AutoPairs struct {
+		Enabled          bool            `mapstructure:"enabled"`
+		Providers        []provider.Name `mapstructure:"providers" validate:"dive,required"`
+		Quotes           []string        `mapstructure:"quotes" validate:"dive,required"`
+		ProvidersPerBase int             `mapstructure:"providers_per_base" validate:"gte=0"`
+	}
+
+	// Aggregation defines the strategy aggregating the prices of a base from
+	// the providers. TrimRatio defines the ratio of the highest and of the
+	// lowest prices discarded by the trimmed_mean strategy, MaxWeight the
+	// maximum weight of a provider in the liquidity_weighted strategy.
+	Aggregation struct {
+		Base      string `mapstructure:"base" validate:"required"`
+		Strategy  string `mapstructure:"strategy" validate:"required,oneof=tvwap median trimmed_mean liquidity_weighted"`
+		TrimRatio string `mapstructure:"trim_ratio"`
+		MaxWeight string `mapstructure:"max_weight"`
 	}
 
 	// RPC defines RPC configuration of both the Umee gRPC and Tendermint nodes.
+	// The fallback endpoints are failed over to, in order, when the primary
+	// endpoint is unhealthy.
 	RPC struct {
-		TMRPCEndpoint string `mapstructure:"tmrpc_endpoint" validate:"required"`
-		GRPCEndpoint  string `mapstructure:"grpc_endpoint" validate:"required"`
-		RPCTimeout    string `mapstructure:"rpc_timeout" validate:"required"`
+		TMRPCEndpoint          string   `mapstructure:"tmrpc_endpoint" validate:"required"`
+		GRPCEndpoint           string   `mapstructure:"grpc_endpoint" validate:"required"`
+		RPCTimeout             string   `mapstructure:"rpc_timeout" validate:"required"`
+		FallbackTMRPCEndpoints []string `mapstructure:"fallback_tmrpc_endpoints" validate:"dive,required"`
+		FallbackGRPCEndpoints  []string `mapstructure:"fallback_grpc_endpoints" validate:"dive,required"`
 	}
 )
 
+// TMRPCEndpoints returns the Tendermint RPC endpoints in order of preference.
+func (rpc RPC) TMRPCEndpoints() []string {
+	return append([]string{rpc.TMRPCEndpoint}, rpc.FallbackTMRPCEndpoints...)
+}
+
+// GRPCEndpoints returns the gRPC endpoints in order of preference.
+func (rpc RPC) GRPCEndpoints() []string {
+	return append([]string{rpc.GRPCEndpoint}, rpc.FallbackGRPCEndpoints...)
+}
+
 // telemetryValidation is custom validation for the Telemetry struct.
 func telemetryValidation(sl validator.StructLevel) {
 	tel := sl.Current().Interface().(telemetry.Config)
@@ -136,6 +244,22 @@ func telemetryValidation(sl validator.StructLevel) {
 	}
 }
 
+// keyringValidation is custom validation requiring the Keyring struct unless a
+// remote signer is configured.
+func keyringValidation(sl validator.StructLevel) {
+	cfg := sl.Current().Interface().(Config)
+
+	if len(cfg.RemoteSigner.Endpoint) > 0 {
+		return
+	}
+	if len(cfg.Keyring.Backend) == 0 {
+		sl.ReportError(cfg.Keyring.Backend, "backend", "Backend", "required", "")
+	}
+	if len(cfg.Keyring.Dir) == 0 {
+		sl.ReportError(cfg.Keyring.Dir, "dir", "Dir", "required", "")
+	}
+}
+
 // endpointValidation is custom validation for the ProviderEndpoint struct.
 func endpointValidation(sl validator.StructLevel) {
 	endpoint := sl.Current().Interface().(provider.Endpoint)
@@ -152,6 +276,7 @@ func endpointValidation(sl validator.StructLevel) {
 func (c Config) Validate() error {
 	validate.RegisterStructValidation(telemetryValidation, telemetry.Config{})
 	validate.RegisterStructValidation(endpointValidation, provider.Endpoint{})
+	validate.RegisterStructValidation(keyringValidation, Config{})
 	return validate.Struct(c)
 }
 
@@ -187,6 +312,71 @@ func ParseConfig(configPath string) (Config, error) {
 	if len(cfg.ProviderTimeout) == 0 {
 		cfg.ProviderTimeout = defaultProviderTimeout.String()
 	}
+	if cfg.Recording.Speed == 0 {
+		cfg.Recording.Speed = defaultReplaySpeed
+	}
+	if len(cfg.History.Retention) == 0 {
+		cfg.History.Retention = defaultHistoryRetention.String()
+	}
+	if len(cfg.RemoteSigner.Timeout) == 0 {
+		cfg.RemoteSigner.Timeout = defaultRemoteSignerTimeout.String()
+	}
+	if len(cfg.AutoPairs.Providers) == 0 {
+		cfg.AutoPairs.Providers = defaultAutoPairsProviders
+	}
+	if len(cfg.AutoPairs.Quotes) == 0 {
+		cfg.AutoPairs.Quotes = defaultAutoPairsQuotes
+	}
+	if cfg.AutoPairs.ProvidersPerBase == 0 {
+		cfg.AutoPairs.ProvidersPerBase = defaultProvidersPerBase
+	}
+
+	customPairs := make(map[provider.Name]map[string]struct{}, len(cfg.CustomProviders)+len(cfg.DexProviders))
+	addCustomProvider := func(name provider.Name, pairs map[string]struct{}) error {
+		if _, ok := SupportedProviders[name]; ok {
+			return fmt.Errorf("custom provider name conflicts with a supported provider: %s", name)
+		}
+		if _, ok := customPairs[name]; ok {
+			return fmt.Errorf("duplicate custom provider: %s", name)
+		}
+		customPairs[name] = pairs
+		return nil
+	}
+	for _, custom := range cfg.CustomProviders {
+		pairs := make(map[string]struct{}, len(custom.Pairs))
+		for _, pair := range custom.Pairs {
+			pairs[strings.ToUpper(pair.Base+pair.Quote)] = struct{}{}
+		}
+		if err := addCustomProvider(custom.Name, pairs); err != nil {
+			return cfg, err
+		}
+	}
+	for _, dex := range cfg.DexProviders {
+		pairs := make(map[string]struct{}, len(dex.Pairs))
+		for _, pair := range dex.Pairs {
+			pairs[strings.ToUpper(pair.Base+pair.Quote)] = struct{}{}
+		}
+		if err := addCustomProvider(dex.Name, pairs); err != nil {
+			return cfg, err
+		}
+	}
+
+	for _, name := range cfg.AutoPairs.Providers {
+		if name == provider.ProviderGate {
+			return cfg, fmt.Errorf("gate provider does not support auto pairs")
+		}
+		if _, ok := customPairs[name]; ok {
+			continue
+		}
+		if _, ok := SupportedProviders[name]; !ok {
+			return cfg, fmt.Errorf("unsupported auto pairs provider: %s", name)
+		}
+	}
+	for _, quote := range cfg.AutoPairs.Quotes {
+		if _, ok := SupportedQuotes[strings.ToUpper(quote)]; !ok {
+			return cfg, fmt.Errorf("unsupported auto pairs quote: %s", quote)
+		}
+	}
 
 	pairs := make(map[string]map[provider.Name]struct{})
 	coinQuotes := make(map[string]struct{})
@@ -202,7 +392,11 @@ func ParseConfig(configPath string) (Config, error) {
 		}
 
 		for _, provider := range cp.Providers {
-			if _, ok := SupportedProviders[provider]; !ok {
+			if custom, ok := customPairs[provider]; ok {
+				if _, ok := custom[strings.ToUpper(cp.Base+cp.Quote)]; !ok {
+					return cfg, fmt.Errorf("custom provider %s does not define %s%s", provider, cp.Base, cp.Quote)
+				}
+			} else if _, ok := SupportedProviders[provider]; !ok {
 				return cfg, fmt.Errorf("unsupported provider: %s", provider)
 			}
 			pairs[cp.Base][provider] = struct{}{}
@@ -242,6 +436,33 @@ func ParseConfig(configPath string) (Config, error) {
 		}
 	}
 
+	aggregationBases := make(map[string]struct{}, len(cfg.Aggregations))
+	for _, aggregation := range cfg.Aggregations {
+		if _, ok := aggregationBases[aggregation.Base]; ok {
+			return cfg, fmt.Errorf("duplicate aggregation strategy for %s", aggregation.Base)
+		}
+		aggregationBases[aggregation.Base] = struct{}{}
+
+		if len(aggregation.TrimRatio) > 0 {
+			trimRatio, err := sdk.NewDecFromStr(aggregation.TrimRatio)
+			if err != nil {
+				return cfg, fmt.Errorf("trim ratios must be numeric: %w", err)
+			}
+			if trimRatio.IsNegative() || trimRatio.GTE(maxTrimRatio) {
+				return cfg, fmt.Errorf("trim ratios must be at least 0 and less than 0.5")
+			}
+		}
+		if len(aggregation.MaxWeight) > 0 {
+			maxWeight, err := sdk.NewDecFromStr(aggregation.MaxWeight)
+			if err != nil {
+				return cfg, fmt.Errorf("max weights must be numeric: %w", err)
+			}
+			if !maxWeight.IsPositive() || maxWeight.GT(sdk.OneDec()) {
+				return cfg, fmt.Errorf("max weights must be greater than 0 and not exceed 1")
+			}
+		}
+	}
+
 	return cfg, cfg.Validate()
 }
 
@@ -84,6 +84,13 @@ func TestValidate(t *testing.T) {
 		},
 	}
 
+	noKeyring := validConfig()
+	noKeyring.Keyring = config.Keyring{}
+
+	remoteSigner := validConfig()
+	remoteSigner.Keyring = config.Keyring{}
+	remoteSigner.RemoteSigner = config.RemoteSigner{Endpoint: "localhost:9091"}
+
 	testCases := []struct {
 		name      string
 		cfg       config.Config
@@ -94,6 +101,16 @@ func TestValidate(t *testing.T) {
 			validConfig(),
 			false,
 		},
+		{
+			"no keyring",
+			noKeyring,
+			true,
+		},
+		{
+			"remote signer without keyring",
+			remoteSigner,
+			false,
+		},
 		{
 			"empty pairs",
 			emptyPairs,
@@ -325,6 +342,259 @@ providers = [
 	require.Error(t, err)
 }
 
+func TestParseConfig_CustomProvider(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder*.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "UMEE"
+quote = "USD"
+providers = [
+	"kraken",
+	"venue"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[[custom_providers]]
+name = "venue"
+url = "https://api.venue.com/tickers"
+
+[[custom_providers.pairs]]
+base = "UMEE"
+quote = "USD"
+price = "$.data[0].last"
+volume = "$.data[0].vol"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Len(t, cfg.CustomProviders, 1)
+	require.Equal(t, provider.Name("venue"), cfg.CustomProviders[0].Name)
+	require.Equal(t, "https://api.venue.com/tickers", cfg.CustomProviders[0].URL)
+	require.Len(t, cfg.CustomProviders[0].Pairs, 1)
+	require.Equal(t, "$.data[0].last", cfg.CustomProviders[0].Pairs[0].Price)
+	require.Equal(t, "$.data[0].vol", cfg.CustomProviders[0].Pairs[0].Volume)
+}
+
+func TestParseConfig_CustomProvider_UndefinedPair(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder*.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+listen_addr = ""
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USDT"
+providers = [
+	"venue"
+]
+
+[[custom_providers]]
+name = "venue"
+url = "https://api.venue.com/tickers"
+
+[[custom_providers.pairs]]
+base = "UMEE"
+quote = "USDT"
+price = "$.data[0].last"
+volume = "$.data[0].vol"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.EqualError(t, err, "custom provider venue does not define ATOMUSDT")
+}
+
+func TestParseConfig_DexProvider(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder*.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "OSMO"
+quote = "USD"
+providers = [
+	"osmosisv2",
+	"osmosisgrpc"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[[dex_providers]]
+name = "osmosisgrpc"
+chain = "osmosis"
+grpc_endpoint = "grpc.osmosis.zone:9090"
+tls = true
+twap_window = "5m"
+
+[[dex_providers.pairs]]
+base = "OSMO"
+quote = "USD"
+pool_id = 678
+base_denom = "uosmo"
+quote_denom = "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858"
+volume = "1000000"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Len(t, cfg.DexProviders, 1)
+	require.Equal(t, provider.Name("osmosisgrpc"), cfg.DexProviders[0].Name)
+	require.Equal(t, provider.DexChainOsmosis, cfg.DexProviders[0].Chain)
+	require.True(t, cfg.DexProviders[0].TLS)
+	require.Equal(t, "5m", cfg.DexProviders[0].TWAPWindow)
+	require.Len(t, cfg.DexProviders[0].Pairs, 1)
+	require.Equal(t, uint64(678), cfg.DexProviders[0].Pairs[0].PoolID)
+	require.Equal(t, "uosmo", cfg.DexProviders[0].Pairs[0].BaseDenom)
+	require.Equal(t, "1000000", cfg.DexProviders[0].Pairs[0].Volume)
+}
+
+func TestParseConfig_AutoPairs(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder*.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "USDT"
+quote = "USD"
+providers = [
+	"kraken"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[auto_pairs]
+enabled = true
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.True(t, cfg.AutoPairs.Enabled)
+	require.Equal(t, provider.ProviderBinance, cfg.AutoPairs.Providers[0])
+	require.Equal(t, []string{"USDT", "USD", "USDC"}, cfg.AutoPairs.Quotes)
+	require.Equal(t, 3, cfg.AutoPairs.ProvidersPerBase)
+
+	_, err = tmpFile.Write([]byte(`providers = ["okx", "gate"]
+`))
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.EqualError(t, err, "gate provider does not support auto pairs")
+}
+
+func TestParseConfig_Aggregations(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder*.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "UMEE"
+quote = "USD"
+providers = [
+	"kraken"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[[aggregations]]
+base = "UMEE"
+strategy = "trimmed_mean"
+trim_ratio = "0.25"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, []config.Aggregation{{
+		Base:      "UMEE",
+		Strategy:  config.AggregationTrimmedMean,
+		TrimRatio: "0.25",
+	}}, cfg.Aggregations)
+
+	_, err = tmpFile.Write([]byte(`
+[[aggregations]]
+base = "ATOM"
+strategy = "liquidity_weighted"
+max_weight = "1.5"
+`))
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.EqualError(t, err, "max weights must be greater than 0 and not exceed 1")
+}
+
 func TestParseConfig_NonUSDQuote(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "price-feeder*.toml")
 	require.NoError(t, err)
@@ -0,0 +1,372 @@
+package oracle
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+var (
+	defaultTrimRatio = sdk.MustNewDecFromStr("0.2")
+	defaultMaxWeight = sdk.MustNewDecFromStr("0.5")
+)
+
+type (
+	// ProviderPrice defines the USD price of a base reported by a provider,
+	// along with the volume it was traded with.
+	ProviderPrice struct {
+		Provider provider.Name
+		Price    sdk.Dec
+		Volume   sdk.Dec
+	}
+
+	// Aggregator defines a strategy aggregating the prices of a base reported
+	// by the providers into a single price. The prices of a base are either all
+	// the TVWAPs of the candles of the providers or, if none has candles, all
+	// the prices of their tickers.
+	//
+	// The bases without an Aggregator use the default strategy: the TVWAP of
+	// the candles of the providers, or the VWAP of their tickers, after
+	// filtering out the providers deviating from the others.
+	Aggregator interface {
+		Aggregate(prices []ProviderPrice) (sdk.Dec, error)
+	}
+
+	// MedianAggregator aggregates the prices into their median, so that a
+	// minority of outliers doesn't move the price regardless of their volume.
+	MedianAggregator struct{}
+
+	// TrimmedMeanAggregator aggregates the prices into their mean after
+	// discarding the TrimRatio of the lowest and of the highest prices.
+	TrimmedMeanAggregator struct {
+		TrimRatio sdk.Dec
+	}
+
+	// LiquidityWeightedAggregator aggregates the prices into their mean
+	// weighted by the volume of every provider, where no provider weighs more
+	// than MaxWeight of the total unless it is the only one.
+	LiquidityWeightedAggregator struct {
+		MaxWeight sdk.Dec
+	}
+)
+
+var (
+	_ Aggregator = MedianAggregator{}
+	_ Aggregator = TrimmedMeanAggregator{}
+	_ Aggregator = LiquidityWeightedAggregator{}
+)
+
+// NewAggregator returns the Aggregator of the configured strategy, or nil for
+// the default TVWAP strategy. The trim ratio and max weight ranges are checked
+// when the configuration is parsed.
+func NewAggregator(aggregation config.Aggregation) (Aggregator, error) {
+	switch aggregation.Strategy {
+	case config.AggregationTVWAP:
+		return nil, nil
+
+	case config.AggregationMedian:
+		return MedianAggregator{}, nil
+
+	case config.AggregationTrimmedMean:
+		trimRatio := defaultTrimRatio
+		if len(aggregation.TrimRatio) > 0 {
+			var err error
+			if trimRatio, err = sdk.NewDecFromStr(aggregation.TrimRatio); err != nil {
+				return nil, fmt.Errorf("invalid trim ratio of %s: %w", aggregation.Base, err)
+			}
+		}
+		return TrimmedMeanAggregator{TrimRatio: trimRatio}, nil
+
+	case config.AggregationLiquidityWeighted:
+		maxWeight := defaultMaxWeight
+		if len(aggregation.MaxWeight) > 0 {
+			var err error
+			if maxWeight, err = sdk.NewDecFromStr(aggregation.MaxWeight); err != nil {
+				return nil, fmt.Errorf("invalid max weight of %s: %w", aggregation.Base, err)
+			}
+		}
+		return LiquidityWeightedAggregator{MaxWeight: maxWeight}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported aggregation strategy: %s", aggregation.Strategy)
+}
+
+// Aggregate implements the Aggregator interface.
+func (MedianAggregator) Aggregate(prices []ProviderPrice) (sdk.Dec, error) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+
+	sorted := sortedPrices(prices)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid], nil
+	}
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2), nil
+}
+
+// Aggregate implements the Aggregator interface.
+func (a TrimmedMeanAggregator) Aggregate(prices []ProviderPrice) (sdk.Dec, error) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+
+	sorted := sortedPrices(prices)
+	trim := a.TrimRatio.MulInt64(int64(len(sorted))).TruncateInt64()
+	sorted = sorted[trim : int64(len(sorted))-trim]
+
+	sum := sdk.ZeroDec()
+	for _, price := range sorted {
+		sum = sum.Add(price)
+	}
+	return sum.QuoInt64(int64(len(sorted))), nil
+}
+
+// Aggregate implements the Aggregator interface. The weights exceeding
+// MaxWeight are capped and the excess is redistributed to the other providers
+// in proportion to their volume.
+func (a LiquidityWeightedAggregator) Aggregate(prices []ProviderPrice) (sdk.Dec, error) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, fmt.Errorf("no prices to aggregate")
+	}
+
+	// the cap can't be met with too few providers, in which case they weigh
+	// the same
+	maxWeight := a.MaxWeight
+	if minWeight := sdk.OneDec().QuoInt64(int64(len(prices))); maxWeight.LT(minWeight) {
+		maxWeight = minWeight
+	}
+
+	weights := make([]sdk.Dec, len(prices))
+	capped := make([]bool, len(prices))
+	for {
+		// distribute the weight left by the capped providers to the others
+		remainingWeight, remainingVolume := sdk.OneDec(), sdk.ZeroDec()
+		for i, price := range prices {
+			if capped[i] {
+				remainingWeight = remainingWeight.Sub(maxWeight)
+			} else {
+				remainingVolume = remainingVolume.Add(price.Volume)
+			}
+		}
+
+		uncapped := int64(0)
+		for i := range prices {
+			if !capped[i] {
+				uncapped++
+			}
+		}
+
+		newlyCapped := false
+		for i, price := range prices {
+			if capped[i] {
+				weights[i] = maxWeight
+				continue
+			}
+			if remainingVolume.IsPositive() {
+				weights[i] = remainingWeight.Mul(price.Volume).Quo(remainingVolume)
+			} else {
+				weights[i] = remainingWeight.QuoInt64(uncapped)
+			}
+			if weights[i].GT(maxWeight) {
+				capped[i] = true
+				newlyCapped = true
+			}
+		}
+		if !newlyCapped {
+			break
+		}
+	}
+
+	price := sdk.ZeroDec()
+	for i, p := range prices {
+		price = price.Add(p.Price.Mul(weights[i]))
+	}
+	return price, nil
+}
+
+// sortedPrices returns the prices sorted in ascending order.
+func sortedPrices(prices []ProviderPrice) []sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	for i, price := range prices {
+		sorted[i] = price.Price
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	return sorted
+}
+
+// newAggregators returns the aggregators of the bases configured with an
+// aggregation strategy other than the default one.
+func newAggregators(aggregations []config.Aggregation) (map[string]Aggregator, error) {
+	aggregators := make(map[string]Aggregator, len(aggregations))
+	for _, aggregation := range aggregations {
+		aggregator, err := NewAggregator(aggregation)
+		if err != nil {
+			return nil, err
+		}
+		if aggregator != nil {
+			aggregators[aggregation.Base] = aggregator
+		}
+	}
+
+	return aggregators, nil
+}
+
+// AggregateProviderPrices computes the price of every base with an Aggregator
+// from the USD denominated candles and tickers of the providers. The TVWAP of
+// the candles of every provider is aggregated, weighted by the volume of the
+// candles, or the prices of their tickers if no provider has candles of the
+// base. It also returns the aggregated prices of every provider.
+func AggregateProviderPrices(
+	aggregators map[string]Aggregator,
+	candles provider.AggregatedProviderCandles,
+	tickers provider.AggregatedProviderPrices,
+) (map[string]sdk.Dec, PricesByProvider, error) {
+	tvwaps, err := ComputeTvwapsByProvider(candles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerPrices := make(map[string][]ProviderPrice, len(aggregators))
+	for providerName, providerTvwaps := range tvwaps {
+		for base, tvwap := range providerTvwaps {
+			providerPrices[base] = append(providerPrices[base], ProviderPrice{
+				Provider: providerName,
+				Price:    tvwap,
+				Volume:   candlesVolume(candles[providerName][base]),
+			})
+		}
+	}
+	for providerName, providerTickers := range tickers {
+		for base, ticker := range providerTickers {
+			if _, ok := tvwaps[providerName][base]; ok {
+				continue
+			}
+			providerPrices[base] = append(providerPrices[base], ProviderPrice{
+				Provider: providerName,
+				Price:    ticker.Price,
+				Volume:   ticker.Volume,
+			})
+		}
+	}
+
+	prices := make(map[string]sdk.Dec, len(aggregators))
+	byProvider := make(PricesByProvider)
+	for base, aggregator := range aggregators {
+		basePrices := fromCandlesIfAny(providerPrices[base], tvwaps, base)
+		if len(basePrices) == 0 {
+			continue
+		}
+
+		// aggregate in a deterministic order
+		sort.Slice(basePrices, func(i, j int) bool { return basePrices[i].Provider < basePrices[j].Provider })
+		price, err := aggregator.Aggregate(basePrices)
+		if err != nil {
+			return nil, nil, err
+		}
+		prices[base] = price
+
+		for _, p := range basePrices {
+			if _, ok := byProvider[p.Provider]; !ok {
+				byProvider[p.Provider] = make(map[string]sdk.Dec)
+			}
+			byProvider[p.Provider][base] = p.Price
+		}
+	}
+
+	return prices, byProvider, nil
+}
+
+// fromCandlesIfAny returns the prices of the base computed from candles if any,
+// or all the prices otherwise.
+func fromCandlesIfAny(prices []ProviderPrice, tvwaps map[provider.Name]map[string]sdk.Dec, base string) []ProviderPrice {
+	fromCandles := make([]ProviderPrice, 0, len(prices))
+	for _, p := range prices {
+		if _, ok := tvwaps[p.Provider][base]; ok {
+			fromCandles = append(fromCandles, p)
+		}
+	}
+	if len(fromCandles) > 0 {
+		return fromCandles
+	}
+	return prices
+}
+
+// candlesVolume returns the volume of the candles within the TVWAP period.
+func candlesVolume(candles []types.CandlePrice) sdk.Dec {
+	timePeriod := provider.PastUnixTime(tvwapCandlePeriod)
+
+	volume := sdk.ZeroDec()
+	for _, candle := range candles {
+		if timePeriod < candle.TimeStamp {
+			volume = volume.Add(candle.Volume)
+		}
+	}
+	return volume
+}
+
+// splitCandlesByAggregator splits the candles of the bases without and with an
+// Aggregator.
+func splitCandlesByAggregator(
+	candles provider.AggregatedProviderCandles,
+	aggregators map[string]Aggregator,
+) (defaultCandles, aggregatedCandles provider.AggregatedProviderCandles) {
+	defaultCandles = make(provider.AggregatedProviderCandles, len(candles))
+	aggregatedCandles = make(provider.AggregatedProviderCandles)
+	for providerName, providerCandles := range candles {
+		for base, baseCandles := range providerCandles {
+			split := defaultCandles
+			if _, ok := aggregators[base]; ok {
+				split = aggregatedCandles
+			}
+			if _, ok := split[providerName]; !ok {
+				split[providerName] = make(map[string][]types.CandlePrice)
+			}
+			split[providerName][base] = baseCandles
+		}
+	}
+
+	return defaultCandles, aggregatedCandles
+}
+
+// splitTickersByAggregator splits the tickers of the bases without and with an
+// Aggregator.
+func splitTickersByAggregator(
+	tickers provider.AggregatedProviderPrices,
+	aggregators map[string]Aggregator,
+) (defaultTickers, aggregatedTickers provider.AggregatedProviderPrices) {
+	defaultTickers = make(provider.AggregatedProviderPrices, len(tickers))
+	aggregatedTickers = make(provider.AggregatedProviderPrices)
+	for providerName, providerTickers := range tickers {
+		for base, ticker := range providerTickers {
+			split := defaultTickers
+			if _, ok := aggregators[base]; ok {
+				split = aggregatedTickers
+			}
+			if _, ok := split[providerName]; !ok {
+				split[providerName] = make(map[string]types.TickerPrice)
+			}
+			split[providerName][base] = ticker
+		}
+	}
+
+	return defaultTickers, aggregatedTickers
+}
+
+// mergePricesByProvider adds the prices of every provider of src to dst.
+func mergePricesByProvider(dst, src PricesByProvider) {
+	for providerName, prices := range src {
+		if _, ok := dst[providerName]; !ok {
+			dst[providerName] = make(map[string]sdk.Dec, len(prices))
+		}
+		for base, price := range prices {
+			dst[providerName][base] = price
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/client"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+func providerPrices(pricesAndVolumes ...string) []ProviderPrice {
+	prices := make([]ProviderPrice, 0, len(pricesAndVolumes)/2)
+	for i := 0; i < len(pricesAndVolumes); i += 2 {
+		prices = append(prices, ProviderPrice{
+			Price:  sdk.MustNewDecFromStr(pricesAndVolumes[i]),
+			Volume: sdk.MustNewDecFromStr(pricesAndVolumes[i+1]),
+		})
+	}
+	return prices
+}
+
+func TestMedianAggregator(t *testing.T) {
+	aggregator := MedianAggregator{}
+
+	_, err := aggregator.Aggregate(nil)
+	require.Error(t, err)
+
+	price, err := aggregator.Aggregate(providerPrices("1.0", "1", "5.0", "1000000", "1.2", "1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), price)
+
+	price, err = aggregator.Aggregate(providerPrices("1.0", "1", "5.0", "1000000", "1.2", "1", "1.1", "1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.15"), price)
+}
+
+func TestTrimmedMeanAggregator(t *testing.T) {
+	aggregator := TrimmedMeanAggregator{TrimRatio: sdk.MustNewDecFromStr("0.2")}
+
+	_, err := aggregator.Aggregate(nil)
+	require.Error(t, err)
+
+	// the highest and lowest prices are discarded
+	price, err := aggregator.Aggregate(providerPrices("0.1", "1", "1.0", "1", "5.0", "1000000", "1.2", "1", "1.1", "1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), price)
+
+	// no price is discarded with too few prices
+	price, err = aggregator.Aggregate(providerPrices("1.0", "1", "2.0", "1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), price)
+}
+
+func TestLiquidityWeightedAggregator(t *testing.T) {
+	aggregator := LiquidityWeightedAggregator{MaxWeight: sdk.MustNewDecFromStr("0.5")}
+
+	_, err := aggregator.Aggregate(nil)
+	require.Error(t, err)
+
+	// the outlier weighs half of the price instead of almost all of it, the
+	// rest being distributed by volume
+	price, err := aggregator.Aggregate(providerPrices("5.0", "1000000", "1.0", "1", "2.0", "3"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("3.375"), price)
+
+	// the providers weigh the same when the cap can't be met
+	price, err = aggregator.Aggregate(providerPrices("5.0", "1000000", "1.0", "1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("3.0"), price)
+
+	price, err = aggregator.Aggregate(providerPrices("2.0", "0"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.0"), price)
+}
+
+func TestNewAggregator(t *testing.T) {
+	aggregator, err := NewAggregator(config.Aggregation{Base: "UMEE", Strategy: config.AggregationTVWAP})
+	require.NoError(t, err)
+	require.Nil(t, aggregator)
+
+	aggregator, err = NewAggregator(config.Aggregation{Base: "UMEE", Strategy: config.AggregationTrimmedMean})
+	require.NoError(t, err)
+	require.Equal(t, TrimmedMeanAggregator{TrimRatio: defaultTrimRatio}, aggregator)
+
+	aggregator, err = NewAggregator(config.Aggregation{
+		Base:      "UMEE",
+		Strategy:  config.AggregationLiquidityWeighted,
+		MaxWeight: "0.3",
+	})
+	require.NoError(t, err)
+	require.Equal(t, LiquidityWeightedAggregator{MaxWeight: sdk.MustNewDecFromStr("0.3")}, aggregator)
+
+	_, err = NewAggregator(config.Aggregation{Base: "UMEE", Strategy: config.AggregationTrimmedMean, TrimRatio: "abc"})
+	require.Error(t, err)
+
+	_, err = NewAggregator(config.Aggregation{Base: "UMEE", Strategy: "mean"})
+	require.Error(t, err)
+}
+
+func TestNew_InvalidAggregation(t *testing.T) {
+	_, err := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		make(map[provider.Name]provider.Endpoint),
+		Options{Aggregations: []config.Aggregation{{Base: "UMEE", Strategy: "mean"}}},
+	)
+	require.Error(t, err)
+}
+
+func TestOracle_GetComputedPricesAggregated(t *testing.T) {
+	o, err := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		make(map[provider.Name]provider.Endpoint),
+		Options{Aggregations: []config.Aggregation{{Base: "UMEE", Strategy: config.AggregationMedian}}},
+	)
+	require.NoError(t, err)
+
+	umee := types.CurrencyPair{Base: "UMEE", Quote: "USD"}
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	ticker := func(price, volume string) types.TickerPrice {
+		return types.TickerPrice{Price: sdk.MustNewDecFromStr(price), Volume: sdk.MustNewDecFromStr(volume)}
+	}
+
+	// a single high volume provider skews the VWAP of UMEE
+	tickers := provider.AggregatedProviderPrices{
+		provider.ProviderBinance: {"UMEE": ticker("1.0", "100"), "ATOM": ticker("10.0", "1")},
+		provider.ProviderKraken:  {"UMEE": ticker("1.02", "100"), "ATOM": ticker("12.0", "3")},
+		provider.ProviderOkx:     {"UMEE": ticker("5.0", "1000000")},
+	}
+	providerPairs := map[provider.Name][]types.CurrencyPair{
+		provider.ProviderBinance: {umee, atom},
+		provider.ProviderKraken:  {umee, atom},
+		provider.ProviderOkx:     {umee},
+	}
+
+	prices, err := o.GetComputedPrices(
+		make(provider.AggregatedProviderCandles),
+		tickers,
+		providerPairs,
+		make(map[string]sdk.Dec),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.02"), prices["UMEE"])
+	require.Equal(t, sdk.MustNewDecFromStr("11.5"), prices["ATOM"])
+
+	// the per-provider prices of the aggregated base are exposed
+	tvwaps := o.GetTvwapPrices()
+	require.Equal(t, sdk.MustNewDecFromStr("5.0"), tvwaps[provider.ProviderOkx]["UMEE"])
+
+	// the candles are preferred over the tickers
+	candles := provider.AggregatedProviderCandles{
+		provider.ProviderBinance: {"UMEE": {{
+			Price:     sdk.MustNewDecFromStr("0.9"),
+			Volume:    sdk.MustNewDecFromStr("1"),
+			TimeStamp: provider.PastUnixTime(time.Minute),
+		}}},
+	}
+	prices, err = o.GetComputedPrices(candles, tickers, providerPairs, make(map[string]sdk.Dec))
+	require.NoError(t, err)
+	require.True(t, prices["UMEE"].Sub(sdk.MustNewDecFromStr("0.9")).Abs().LT(sdk.MustNewDecFromStr("0.0001")))
+}
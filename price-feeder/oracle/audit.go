@@ -0,0 +1,153 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+type (
+	// voteAudit defines a submitted vote to audit against the on-chain tally
+	// once its vote period has ended.
+	voteAudit struct {
+		exchangeRates string
+		tallyHeight   int64
+	}
+
+	// VoteAuditResult defines the outcome of the audit of a voted exchange rate
+	// against the tallied one.
+	VoteAuditResult struct {
+		Denom   string
+		Voted   sdk.Dec
+		Tallied sdk.Dec
+
+		// Deviation defines the ratio of the difference between the voted and
+		// tallied rates to the tallied rate
+		Deviation sdk.Dec
+
+		// Missing is set if the denom has no tallied rate
+		Missing bool
+
+		// OutsideRewardBand is set if the voted rate is outside of the reward
+		// band around the tallied rate
+		OutsideRewardBand bool
+	}
+)
+
+// AuditVote compares the voted exchange rates to the tallied ones. A voted
+// rate is outside of the reward band if it deviates from the tallied rate by
+// more than half the reward band. The on-chain band may be wider, since it is
+// widened to the standard deviation of the ballot.
+func AuditVote(
+	voted oracletypes.ExchangeRateTuples,
+	tallied sdk.DecCoins,
+	rewardBand sdk.Dec,
+) []VoteAuditResult {
+	talliedRates := make(map[string]sdk.Dec, len(tallied))
+	for _, rate := range tallied {
+		talliedRates[strings.ToUpper(rate.Denom)] = rate.Amount
+	}
+
+	results := make([]VoteAuditResult, 0, len(voted))
+	for _, tuple := range voted {
+		result := VoteAuditResult{
+			Denom:     strings.ToUpper(tuple.Denom),
+			Voted:     tuple.ExchangeRate,
+			Tallied:   sdk.ZeroDec(),
+			Deviation: sdk.ZeroDec(),
+		}
+
+		tallied, ok := talliedRates[result.Denom]
+		if !ok || !tallied.IsPositive() {
+			result.Missing = true
+			results = append(results, result)
+			continue
+		}
+
+		result.Tallied = tallied
+		result.Deviation = tuple.ExchangeRate.Sub(tallied).Abs().Quo(tallied)
+		result.OutsideRewardBand = result.Deviation.GT(rewardBand.QuoInt64(2))
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// auditVote queries the tallied exchange rates and the miss counter of the
+// validator, compares the audited vote against them and reports the result
+// through metrics and warnings.
+func (o *Oracle) auditVote(ctx context.Context, audit voteAudit, params oracletypes.Params) error {
+	voted, err := oracletypes.ParseExchangeRateTuples(audit.exchangeRates)
+	if err != nil {
+		return err
+	}
+
+	var (
+		ratesResponse *oracletypes.QueryExchangeRatesResponse
+		missResponse  *oracletypes.QueryMissCounterResponse
+	)
+	err = o.queryOracle(ctx, func(ctx context.Context, queryClient oracletypes.QueryClient) error {
+		ratesResponse, err = queryClient.ExchangeRates(ctx, &oracletypes.QueryExchangeRates{})
+		if err != nil {
+			return fmt.Errorf("failed to get x/oracle exchange rates: %w", err)
+		}
+
+		missResponse, err = queryClient.MissCounter(ctx, &oracletypes.QueryMissCounter{
+			ValidatorAddr: o.oracleClient.ValidatorAddrString,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get x/oracle miss counter: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, result := range AuditVote(voted, ratesResponse.ExchangeRates, params.RewardBand) {
+		labels := []metrics.Label{telemetry.NewLabel("denom", result.Denom)}
+
+		switch {
+		case result.Missing:
+			telemetry.IncrCounterWithLabels([]string{"vote", "audit", "missing"}, 1, labels)
+			o.logger.Warn().
+				Str("denom", result.Denom).
+				Str("voted", result.Voted.String()).
+				Msg("voted denom has no tallied exchange rate")
+
+		case result.OutsideRewardBand:
+			telemetry.IncrCounterWithLabels([]string{"vote", "audit", "outside_band"}, 1, labels)
+			o.logger.Warn().
+				Str("denom", result.Denom).
+				Str("voted", result.Voted.String()).
+				Str("tallied", result.Tallied.String()).
+				Str("deviation", result.Deviation.String()).
+				Str("reward_band", params.RewardBand.String()).
+				Msg("voted exchange rate outside of the reward band")
+		}
+
+		deviation, err := result.Deviation.Float64()
+		if err == nil {
+			telemetry.SetGaugeWithLabels([]string{"vote", "audit", "deviation"}, float32(deviation), labels)
+		}
+	}
+
+	missCounter := missResponse.MissCounter
+	telemetry.SetGauge(float32(missCounter), "vote", "audit", "miss_counter")
+	if o.missCounter != nil && missCounter > *o.missCounter {
+		telemetry.IncrCounter(float32(missCounter-*o.missCounter), "vote", "audit", "missed")
+		o.logger.Warn().
+			Uint64("miss_counter", missCounter).
+			Uint64("previous_miss_counter", *o.missCounter).
+			Msg("validator miss counter increased")
+	}
+	o.missCounter = &missCounter
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package oracle
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+func TestAuditVote(t *testing.T) {
+	voted, err := oracletypes.ParseExchangeRateTuples("ATOM:12.0,UMEE:0.0053,JUNO:1.1")
+	require.NoError(t, err)
+
+	tallied := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ATOM", sdk.MustNewDecFromStr("12.06")),
+		sdk.NewDecCoinFromDec("UMEE", sdk.MustNewDecFromStr("0.005")),
+		sdk.NewDecCoinFromDec("OSMO", sdk.MustNewDecFromStr("0.8")),
+	)
+
+	results := AuditVote(voted, tallied, sdk.MustNewDecFromStr("0.02"))
+	require.Len(t, results, 3)
+
+	// 0.5% away from the tally, within the 1% half band
+	require.Equal(t, "ATOM", results[0].Denom)
+	require.Equal(t, sdk.MustNewDecFromStr("12.06"), results[0].Tallied)
+	require.Equal(t, sdk.MustNewDecFromStr("0.004975124378109453"), results[0].Deviation)
+	require.False(t, results[0].OutsideRewardBand)
+	require.False(t, results[0].Missing)
+
+	// 6% away from the tally
+	require.Equal(t, "UMEE", results[1].Denom)
+	require.Equal(t, sdk.MustNewDecFromStr("0.06"), results[1].Deviation)
+	require.True(t, results[1].OutsideRewardBand)
+	require.False(t, results[1].Missing)
+
+	require.Equal(t, "JUNO", results[2].Denom)
+	require.True(t, results[2].Missing)
+	require.False(t, results[2].OutsideRewardBand)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -12,8 +13,20 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+const (
+	// newBlockHeaderTimeout defines the time without a new block header after
+	// which the subscription is deemed stale and renewed.
+	newBlockHeaderTimeout = 30 * time.Second
+
+	// resubscribeDelay defines the time to wait before retrying a failed
+	// subscription.
+	resubscribeDelay = 1 * time.Second
+)
+
 var (
 	errParseEventDataNewBlockHeader = errors.New("error parsing EventDataNewBlockHeader")
+	errNewBlockHeaderTimeout        = errors.New("timed out waiting for a new block header")
+	errEndpointSwitched             = errors.New("active endpoint switched")
 	queryEventNewBlockHeader        = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader)
 )
 
@@ -21,6 +34,8 @@ var (
 // current node which is being updated each time the
 // node sends an event of EventNewBlockHeader.
 // It starts a goroutine to subscribe to blockchain new block event and update the cached height.
+// The subscription is renewed on the active endpoint of the Tendermint RPC
+// endpoint pool when the endpoint changes or stops sending new block headers.
 type ChainHeight struct {
 	Logger zerolog.Logger
 
@@ -33,7 +48,8 @@ type ChainHeight struct {
 // starts a new goroutine subscribed to EventNewBlockHeader.
 func NewChainHeight(
 	ctx context.Context,
-	rpcClient tmrpcclient.Client,
+	endpoints *EndpointPool,
+	newRPCClient func(endpoint string) (tmrpcclient.Client, error),
 	logger zerolog.Logger,
 	initialHeight int64,
 ) (*ChainHeight, error) {
@@ -41,27 +57,79 @@ func NewChainHeight(
 		return nil, fmt.Errorf("expected positive initial block height")
 	}
 
+	chainHeight := &ChainHeight{
+		Logger:            logger.With().Str("oracle_client", "chain_height").Logger(),
+		errGetChainHeight: nil,
+		lastChainHeight:   initialHeight,
+	}
+
+	endpoint := endpoints.Active()
+	rpcClient, newBlockHeaderSubscription, err := chainHeight.subscribe(ctx, newRPCClient, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			err := chainHeight.listen(ctx, endpoints, endpoint, rpcClient, newBlockHeaderSubscription)
+			if err == nil {
+				return
+			}
+			if !errors.Is(err, errEndpointSwitched) {
+				chainHeight.updateChainHeight(chainHeight.lastChainHeight, err)
+				endpoints.ReportFailure(ctx, endpoint)
+			}
+
+			for {
+				endpoint = endpoints.Active()
+				rpcClient, newBlockHeaderSubscription, err = chainHeight.subscribe(ctx, newRPCClient, endpoint)
+				if err == nil {
+					break
+				}
+
+				chainHeight.Logger.Err(err).Str("endpoint", endpoint).Msg("failed to subscribe to new block headers")
+				chainHeight.updateChainHeight(chainHeight.lastChainHeight, err)
+				endpoints.ReportFailure(ctx, endpoint)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(resubscribeDelay):
+				}
+			}
+		}
+	}()
+
+	return chainHeight, nil
+}
+
+// subscribe starts a client of the Tendermint RPC endpoint and subscribes to
+// new block headers.
+func (chainHeight *ChainHeight) subscribe(
+	ctx context.Context,
+	newRPCClient func(endpoint string) (tmrpcclient.Client, error),
+	endpoint string,
+) (tmrpcclient.Client, <-chan tmctypes.ResultEvent, error) {
+	rpcClient, err := newRPCClient(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if !rpcClient.IsRunning() {
 		if err := rpcClient.Start(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	newBlockHeaderSubscription, err := rpcClient.Subscribe(
 		ctx, tmtypes.EventNewBlockHeader, queryEventNewBlockHeader.String())
 	if err != nil {
-		return nil, err
-	}
-
-	chainHeight := &ChainHeight{
-		Logger:            logger.With().Str("oracle_client", "chain_height").Logger(),
-		errGetChainHeight: nil,
-		lastChainHeight:   initialHeight,
+		_ = rpcClient.Stop()
+		return nil, nil, err
 	}
 
-	go chainHeight.subscribe(ctx, rpcClient, newBlockHeaderSubscription)
-
-	return chainHeight, nil
+	chainHeight.Logger.Info().Str("endpoint", endpoint).Msg("subscribed to new block headers")
+	return rpcClient, newBlockHeaderSubscription, nil
 }
 
 // updateChainHeight receives the data to be updated thread safe.
@@ -73,23 +141,49 @@ func (chainHeight *ChainHeight) updateChainHeight(blockHeight int64, err error)
 	chainHeight.errGetChainHeight = err
 }
 
-// subscribe listens to new blocks being made
-// and updates the chain height.
-func (chainHeight *ChainHeight) subscribe(
+// listen listens to new blocks being made and updates the chain height until
+// the context is done, in which case it returns nil, or until the
+// subscription must be renewed, in which case it returns the reason.
+func (chainHeight *ChainHeight) listen(
 	ctx context.Context,
-	eventsClient tmrpcclient.EventsClient,
+	endpoints *EndpointPool,
+	endpoint string,
+	rpcClient tmrpcclient.Client,
 	newBlockHeaderSubscription <-chan tmctypes.ResultEvent,
-) {
+) error {
+	timeout := time.NewTimer(newBlockHeaderTimeout)
+	defer timeout.Stop()
+
+	// the subscription is renewed if the active endpoint changes
+	endpointCheck := time.NewTicker(healthCheckInterval)
+	defer endpointCheck.Stop()
+
+	defer func() {
+		// the context may be done already, so unsubscribe with a new one
+		unsubscribeCtx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		defer cancel()
+
+		err := rpcClient.Unsubscribe(unsubscribeCtx, tmtypes.EventNewBlockHeader, queryEventNewBlockHeader.String())
+		if err != nil {
+			chainHeight.Logger.Err(err).Msg("failed to unsubscribe from new block headers")
+		}
+		_ = rpcClient.Stop()
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
-			err := eventsClient.Unsubscribe(ctx, tmtypes.EventNewBlockHeader, queryEventNewBlockHeader.String())
-			if err != nil {
-				chainHeight.Logger.Err(err)
-				chainHeight.updateChainHeight(chainHeight.lastChainHeight, err)
-			}
 			chainHeight.Logger.Info().Msg("closing the ChainHeight subscription")
-			return
+			return nil
+
+		case <-endpointCheck.C:
+			if endpoints.Active() != endpoint {
+				return errEndpointSwitched
+			}
+
+		case <-timeout.C:
+			chainHeight.Logger.Err(errNewBlockHeaderTimeout).Str("endpoint", endpoint).Msg("renewing subscription")
+			return errNewBlockHeaderTimeout
 
 		case resultEvent := <-newBlockHeaderSubscription:
 			eventDataNewBlockHeader, ok := resultEvent.Data.(tmtypes.EventDataNewBlockHeader)
@@ -99,6 +193,11 @@ func (chainHeight *ChainHeight) subscribe(
 				continue
 			}
 			chainHeight.updateChainHeight(eventDataNewBlockHeader.Header.Height, nil)
+
+			if !timeout.Stop() {
+				<-timeout.C
+			}
+			timeout.Reset(newBlockHeaderTimeout)
 		}
 	}
 }
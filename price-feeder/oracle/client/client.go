@@ -17,8 +17,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/rs/zerolog"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	umeeapp "github.com/umee-network/umee/v3/app"
@@ -33,7 +35,7 @@ type (
 		KeyringBackend      string
 		KeyringDir          string
 		KeyringPass         string
-		TMRPC               string
+		TMRPCEndpoints      *EndpointPool
 		RPCTimeout          time.Duration
 		OracleAddr          sdk.AccAddress
 		OracleAddrString    string
@@ -42,9 +44,12 @@ type (
 		Encoding            umeeparams.EncodingConfig
 		GasPrices           string
 		GasAdjustment       float64
-		GRPCEndpoint        string
+		GRPCEndpoints       *EndpointPool
 		KeyringPassphrase   string
 		ChainHeight         *ChainHeight
+
+		// Signer signs the oracle transactions instead of the keyring when set
+		Signer Signer
 	}
 
 	passReader struct {
@@ -60,25 +65,50 @@ func NewOracleClient(
 	keyringBackend string,
 	keyringDir string,
 	keyringPass string,
-	tmRPC string,
+	tmRPCEndpoints []string,
 	rpcTimeout time.Duration,
 	oracleAddrString string,
 	validatorAddrString string,
-	grpcEndpoint string,
+	grpcEndpoints []string,
 	gasAdjustment float64,
+	signer Signer,
 ) (OracleClient, error) {
 	oracleAddr, err := sdk.AccAddressFromBech32(oracleAddrString)
 	if err != nil {
 		return OracleClient{}, err
 	}
 
+	if signer != nil {
+		pubKey, err := signer.PubKey(ctx)
+		if err != nil {
+			return OracleClient{}, err
+		}
+		if !oracleAddr.Equals(sdk.AccAddress(pubKey.Address())) {
+			return OracleClient{}, fmt.Errorf(
+				"signer address %s does not match the oracle address %s",
+				sdk.AccAddress(pubKey.Address()), oracleAddr,
+			)
+		}
+	}
+
+	logger = logger.With().Str("module", "oracle_client").Logger()
+
+	tmRPCPool, err := NewEndpointPool(logger, "tmrpc", tmRPCEndpoints, TMRPCHealthCheck)
+	if err != nil {
+		return OracleClient{}, err
+	}
+	grpcPool, err := NewEndpointPool(logger, "grpc", grpcEndpoints, GRPCHealthCheck)
+	if err != nil {
+		return OracleClient{}, err
+	}
+
 	oracleClient := OracleClient{
-		Logger:              logger.With().Str("module", "oracle_client").Logger(),
+		Logger:              logger,
 		ChainID:             chainID,
 		KeyringBackend:      keyringBackend,
 		KeyringDir:          keyringDir,
 		KeyringPass:         keyringPass,
-		TMRPC:               tmRPC,
+		TMRPCEndpoints:      tmRPCPool,
 		RPCTimeout:          rpcTimeout,
 		OracleAddr:          oracleAddr,
 		OracleAddrString:    oracleAddrString,
@@ -86,22 +116,28 @@ func NewOracleClient(
 		ValidatorAddrString: validatorAddrString,
 		Encoding:            umeeapp.MakeEncodingConfig(),
 		GasAdjustment:       gasAdjustment,
-		GRPCEndpoint:        grpcEndpoint,
+		GRPCEndpoints:       grpcPool,
+		Signer:              signer,
 	}
 
-	clientCtx, err := oracleClient.CreateClientContext()
-	if err != nil {
-		return OracleClient{}, err
-	}
+	var blockHeight int64
+	err = tmRPCPool.Do(ctx, func(string) error {
+		clientCtx, err := oracleClient.CreateClientContext()
+		if err != nil {
+			return err
+		}
 
-	blockHeight, err := rpc.GetChainHeight(clientCtx)
+		blockHeight, err = rpc.GetChainHeight(clientCtx)
+		return err
+	})
 	if err != nil {
 		return OracleClient{}, err
 	}
 
 	chainHeight, err := NewChainHeight(
 		ctx,
-		clientCtx.Client,
+		tmRPCPool,
+		oracleClient.newTMRPCClient,
 		oracleClient.Logger,
 		blockHeight,
 	)
@@ -110,6 +146,9 @@ func NewOracleClient(
 	}
 	oracleClient.ChainHeight = chainHeight
 
+	go tmRPCPool.Start(ctx)
+	go grpcPool.Start(ctx)
+
 	return oracleClient, nil
 }
 
@@ -133,26 +172,27 @@ func (r *passReader) Read(p []byte) (n int, err error) {
 
 // BroadcastTx attempts to broadcast a signed transaction. If it fails, a few re-attempts
 // will be made until the transaction succeeds or ultimately times out or fails.
+// It returns the response of the successful broadcast.
 // Ref: https://github.com/terra-money/oracle-feeder/blob/baef2a4a02f57a2ffeaa207932b2e03d7fb0fb25/feeder/src/vote.ts#L230
-func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ...sdk.Msg) error {
+func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
 	maxBlockHeight := nextBlockHeight + timeoutHeight
 	lastCheckHeight := nextBlockHeight - 1
 
 	clientCtx, err := oc.CreateClientContext()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	factory, err := oc.CreateTxFactory()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// re-try voting until timeout
 	for lastCheckHeight < maxBlockHeight {
 		latestBlockHeight, err := oc.ChainHeight.GetChainHeight()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if latestBlockHeight <= lastCheckHeight {
@@ -162,10 +202,17 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ..
 		// set last check height to latest block height
 		lastCheckHeight = latestBlockHeight
 
-		resp, err := BroadcastTx(clientCtx, factory, msgs...)
+		resp, err := BroadcastTx(clientCtx, factory, oc.signer(clientCtx), msgs...)
 		if resp != nil && resp.Code != 0 {
 			telemetry.IncrCounter(1, "failure", "tx", "code")
 			err = fmt.Errorf("invalid response code from tx: %d", resp.Code)
+		} else if err != nil {
+			// the node may be down, retry on another one if so
+			if _, ok := oc.TMRPCEndpoints.ReportFailure(context.Background(), clientCtx.NodeURI); ok {
+				if clientCtx, err = oc.CreateClientContext(); err != nil {
+					return nil, err
+				}
+			}
 		}
 		if err != nil {
 			var (
@@ -195,44 +242,64 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ..
 			Int64("tx_height", resp.Height).
 			Msg("successfully broadcasted tx")
 
-		return nil
+		return resp, nil
 	}
 
 	telemetry.IncrCounter(1, "failure", "tx", "timeout")
-	return errors.New("broadcasting tx timed out")
+	return nil, errors.New("broadcasting tx timed out")
 }
 
-// CreateClientContext creates an SDK client Context instance used for transaction
-// generation, signing and broadcasting.
-func (oc OracleClient) CreateClientContext() (client.Context, error) {
-	var keyringInput io.Reader
-	if len(oc.KeyringPass) > 0 {
-		keyringInput = newPassReader(oc.KeyringPass)
-	} else {
-		keyringInput = os.Stdin
-	}
-
-	kr, err := keyring.New("oracle", oc.KeyringBackend, oc.KeyringDir, keyringInput, oc.Encoding.Codec)
+// QueryTxHeight returns the height the transaction with the given hash was
+// included at. It returns an error if the transaction is not found.
+func (oc OracleClient) QueryTxHeight(hash string) (int64, error) {
+	clientCtx, err := oc.CreateClientContext()
 	if err != nil {
-		return client.Context{}, err
+		return 0, err
 	}
 
-	httpClient, err := tmjsonclient.DefaultHTTPClient(oc.TMRPC)
+	resp, err := authtx.QueryTx(clientCtx, hash)
 	if err != nil {
-		return client.Context{}, err
+		return 0, err
 	}
 
-	httpClient.Timeout = oc.RPCTimeout
+	return resp.Height, nil
+}
 
-	tmRPC, err := rpchttp.NewWithClient(oc.TMRPC, "/websocket", httpClient)
-	if err != nil {
-		return client.Context{}, err
+// CreateClientContext creates an SDK client Context instance used for transaction
+// generation, signing and broadcasting. The keyring is left unset when the
+// transactions are signed by a Signer.
+func (oc OracleClient) CreateClientContext() (client.Context, error) {
+	var (
+		kr      keyring.Keyring
+		keyName string
+	)
+	if oc.Signer == nil {
+		var keyringInput io.Reader
+		if len(oc.KeyringPass) > 0 {
+			keyringInput = newPassReader(oc.KeyringPass)
+		} else {
+			keyringInput = os.Stdin
+		}
+
+		var err error
+		kr, err = keyring.New("oracle", oc.KeyringBackend, oc.KeyringDir, keyringInput, oc.Encoding.Codec)
+		if err != nil {
+			return client.Context{}, err
+		}
+
+		keyInfo, err := kr.KeyByAddress(oc.OracleAddr)
+		if err != nil {
+			return client.Context{}, err
+		}
+		keyName = keyInfo.Name
 	}
 
-	keyInfo, err := kr.KeyByAddress(oc.OracleAddr)
+	tmRPCEndpoint := oc.TMRPCEndpoints.Active()
+	tmRPC, err := oc.newTMRPCClient(tmRPCEndpoint)
 	if err != nil {
 		return client.Context{}, err
 	}
+
 	clientCtx := client.Context{
 		ChainID:           oc.ChainID,
 		InterfaceRegistry: oc.Encoding.InterfaceRegistry,
@@ -243,12 +310,12 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		Codec:             oc.Encoding.Codec,
 		LegacyAmino:       oc.Encoding.Amino,
 		Input:             os.Stdin,
-		NodeURI:           oc.TMRPC,
+		NodeURI:           tmRPCEndpoint,
 		Client:            tmRPC,
 		Keyring:           kr,
 		FromAddress:       oc.OracleAddr,
-		FromName:          keyInfo.Name,
-		From:              keyInfo.Name,
+		FromName:          keyName,
+		From:              keyName,
 		OutputFormat:      "json",
 		UseLedger:         false,
 		Simulate:          false,
@@ -260,6 +327,27 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 	return clientCtx, nil
 }
 
+// signer returns the Signer of the oracle transactions, either the configured
+// one or the key of the oracle account in the keyring of the client context.
+func (oc OracleClient) signer(clientCtx client.Context) Signer {
+	if oc.Signer != nil {
+		return oc.Signer
+	}
+	return NewKeyringSigner(clientCtx.Keyring, oc.OracleAddr)
+}
+
+// newTMRPCClient returns a Tendermint RPC client of the given endpoint.
+func (oc OracleClient) newTMRPCClient(endpoint string) (tmrpcclient.Client, error) {
+	httpClient, err := tmjsonclient.DefaultHTTPClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient.Timeout = oc.RPCTimeout
+
+	return rpchttp.NewWithClient(endpoint, "/websocket", httpClient)
+}
+
 // CreateTxFactory creates an SDK Factory instance used for transaction
 // generation, signing and broadcasting.
 func (oc OracleClient) CreateTxFactory() (tx.Factory, error) {
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/rs/zerolog"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// healthCheckInterval defines how often the endpoints of a pool are
+	// checked in order to switch back to the preferred healthy one.
+	healthCheckInterval = 30 * time.Second
+
+	healthCheckTimeout = 5 * time.Second
+)
+
+type (
+	// HealthCheck returns an error if the node at the endpoint is unhealthy.
+	HealthCheck func(ctx context.Context, endpoint string) error
+
+	// EndpointPool defines an ordered list of endpoints of the same service of
+	// which a single one is active. The active endpoint is the first healthy
+	// one: when it fails a health check, the pool fails over to the next
+	// healthy endpoint and switches back once the preferred one recovers.
+	EndpointPool struct {
+		logger      zerolog.Logger
+		endpoints   []string
+		healthCheck HealthCheck
+
+		mtx    sync.RWMutex
+		active int
+	}
+)
+
+// NewEndpointPool returns an EndpointPool of the given endpoints, in order of
+// preference, with the first one active.
+func NewEndpointPool(logger zerolog.Logger, name string, endpoints []string, healthCheck HealthCheck) (*EndpointPool, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no %s endpoint", name)
+	}
+
+	return &EndpointPool{
+		logger:      logger.With().Str("endpoints", name).Logger(),
+		endpoints:   endpoints,
+		healthCheck: healthCheck,
+	}, nil
+}
+
+// Active returns the active endpoint.
+func (p *EndpointPool) Active() string {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.endpoints[p.active]
+}
+
+// Do calls fn with the active endpoint. If it fails and the endpoint turns out
+// to be unhealthy, fn is called again with the endpoint failed over to.
+func (p *EndpointPool) Do(ctx context.Context, fn func(endpoint string) error) error {
+	endpoint := p.Active()
+	err := fn(endpoint)
+	if err == nil {
+		return nil
+	}
+
+	if next, ok := p.ReportFailure(ctx, endpoint); ok {
+		return fn(next)
+	}
+	return err
+}
+
+// ReportFailure checks the health of an endpoint a request failed on. If the
+// endpoint is still the active one and is unhealthy, the pool fails over to
+// the next healthy endpoint. It returns the active endpoint and whether it
+// differs from the failed one.
+func (p *EndpointPool) ReportFailure(ctx context.Context, endpoint string) (string, bool) {
+	if active := p.Active(); active != endpoint {
+		// another request already failed over
+		return active, true
+	}
+	if err := p.check(ctx, endpoint); err == nil {
+		return endpoint, false
+	}
+
+	p.mtx.RLock()
+	failed := p.active
+	p.mtx.RUnlock()
+
+	for i := 1; i < len(p.endpoints); i++ {
+		next := (failed + i) % len(p.endpoints)
+		if err := p.check(ctx, p.endpoints[next]); err == nil {
+			return p.switchFrom(failed, next), true
+		}
+	}
+
+	p.logger.Error().Msg("no healthy endpoint to fail over to")
+	return endpoint, false
+}
+
+// Start checks the health of the endpoints at every healthCheckInterval and
+// switches to the first healthy one, until the context is done.
+func (p *EndpointPool) Start(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			p.mtx.RLock()
+			active := p.active
+			p.mtx.RUnlock()
+
+			for i, endpoint := range p.endpoints {
+				if err := p.check(ctx, endpoint); err == nil {
+					p.switchFrom(active, i)
+					break
+				}
+			}
+		}
+	}
+}
+
+func (p *EndpointPool) check(ctx context.Context, endpoint string) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	err := p.healthCheck(ctx, endpoint)
+	if err != nil {
+		p.logger.Warn().Err(err).Str("endpoint", endpoint).Msg("endpoint health check failed")
+	}
+	return err
+}
+
+// switchFrom activates the endpoint at the given index, unless the active
+// endpoint changed from the given one meanwhile. It returns the active
+// endpoint.
+func (p *EndpointPool) switchFrom(active, index int) string {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.active != active || index == p.active {
+		return p.endpoints[p.active]
+	}
+
+	p.logger.Info().
+		Str("from", p.endpoints[p.active]).
+		Str("to", p.endpoints[index]).
+		Msg("switching endpoint")
+	p.active = index
+	return p.endpoints[index]
+}
+
+// TMRPCHealthCheck checks that the Tendermint RPC endpoint responds and that
+// its node is not catching up.
+func TMRPCHealthCheck(ctx context.Context, endpoint string) error {
+	rpcClient, err := rpchttp.New(endpoint, "/websocket")
+	if err != nil {
+		return err
+	}
+
+	status, err := rpcClient.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if status.SyncInfo.CatchingUp {
+		return fmt.Errorf("node is catching up")
+	}
+	return nil
+}
+
+// GRPCHealthCheck checks that the gRPC endpoint responds and that its node is
+// not syncing.
+func GRPCHealthCheck(ctx context.Context, endpoint string) error {
+	grpcConn, err := grpc.Dial(
+		endpoint,
+		// the Cosmos SDK doesn't support any transport security mechanism
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialerFunc),
+	)
+	if err != nil {
+		return err
+	}
+	defer grpcConn.Close()
+
+	resp, err := tmservice.NewServiceClient(grpcConn).GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Syncing {
+		return fmt.Errorf("node is syncing")
+	}
+	return nil
+}
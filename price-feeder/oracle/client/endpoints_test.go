@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHealth defines the health of endpoints for testing an EndpointPool.
+type fakeHealth struct {
+	mtx       sync.Mutex
+	unhealthy map[string]bool
+}
+
+func (h *fakeHealth) set(endpoint string, unhealthy bool) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.unhealthy[endpoint] = unhealthy
+}
+
+func (h *fakeHealth) check(_ context.Context, endpoint string) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if h.unhealthy[endpoint] {
+		return fmt.Errorf("%s is down", endpoint)
+	}
+	return nil
+}
+
+func TestNewEndpointPool(t *testing.T) {
+	_, err := NewEndpointPool(zerolog.Nop(), "tmrpc", nil, nil)
+	require.EqualError(t, err, "no tmrpc endpoint")
+}
+
+func TestEndpointPool_ReportFailure(t *testing.T) {
+	ctx := context.Background()
+	health := &fakeHealth{unhealthy: map[string]bool{}}
+	pool, err := NewEndpointPool(zerolog.Nop(), "tmrpc", []string{"a", "b", "c"}, health.check)
+	require.NoError(t, err)
+	require.Equal(t, "a", pool.Active())
+
+	// a failed request on a healthy endpoint doesn't fail over
+	active, switched := pool.ReportFailure(ctx, "a")
+	require.False(t, switched)
+	require.Equal(t, "a", active)
+
+	// the next healthy endpoint is failed over to
+	health.set("a", true)
+	health.set("b", true)
+	active, switched = pool.ReportFailure(ctx, "a")
+	require.True(t, switched)
+	require.Equal(t, "c", active)
+	require.Equal(t, "c", pool.Active())
+
+	// a late failure of the previous endpoint reports the active one
+	active, switched = pool.ReportFailure(ctx, "a")
+	require.True(t, switched)
+	require.Equal(t, "c", active)
+
+	// with no healthy endpoint, the active one is kept
+	health.set("c", true)
+	active, switched = pool.ReportFailure(ctx, "c")
+	require.False(t, switched)
+	require.Equal(t, "c", active)
+}
+
+func TestEndpointPool_Do(t *testing.T) {
+	ctx := context.Background()
+	health := &fakeHealth{unhealthy: map[string]bool{"a": true}}
+	pool, err := NewEndpointPool(zerolog.Nop(), "grpc", []string{"a", "b"}, health.check)
+	require.NoError(t, err)
+
+	var called []string
+	err = pool.Do(ctx, func(endpoint string) error {
+		called = append(called, endpoint)
+		if health.check(ctx, endpoint) != nil {
+			return fmt.Errorf("request failed")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, called)
+	require.Equal(t, "b", pool.Active())
+
+	// a request failing on a healthy endpoint is not retried
+	called = nil
+	err = pool.Do(ctx, func(endpoint string) error {
+		called = append(called, endpoint)
+		return fmt.Errorf("request failed")
+	})
+	require.EqualError(t, err, "request failed")
+	require.Equal(t, []string{"b"}, called)
+}
+
+func TestEndpointPool_switchFrom(t *testing.T) {
+	health := &fakeHealth{unhealthy: map[string]bool{}}
+	pool, err := NewEndpointPool(zerolog.Nop(), "grpc", []string{"a", "b", "c"}, health.check)
+	require.NoError(t, err)
+
+	require.Equal(t, "b", pool.switchFrom(0, 1))
+
+	// the active endpoint changed meanwhile
+	require.Equal(t, "b", pool.switchFrom(0, 2))
+	require.Equal(t, "b", pool.Active())
+
+	// switching back to the preferred endpoint
+	require.Equal(t, "a", pool.switchFrom(1, 0))
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	signerServiceName     = "umee.pricefeeder.signer.v1.Signer"
+	signerMethodGetPubKey = "/" + signerServiceName + "/GetPubKey"
+	signerMethodSign      = "/" + signerServiceName + "/Sign"
+
+	defaultRemoteSignerTimeout = 5 * time.Second
+)
+
+type (
+	// Signer defines the signer of the oracle transactions, owning the private
+	// key of the oracle account.
+	Signer interface {
+		// PubKey returns the public key of the oracle account.
+		PubKey(ctx context.Context) (cryptotypes.PubKey, error)
+
+		// Sign returns the signature of the sign bytes of a transaction.
+		Sign(ctx context.Context, signBytes []byte) ([]byte, error)
+	}
+
+	// KeyringSigner defines a Signer of a key of a local keyring.
+	KeyringSigner struct {
+		keyring keyring.Keyring
+		addr    sdk.AccAddress
+	}
+
+	// RemoteSigner defines a Signer delegating to a remote signing service over
+	// gRPC, so the key of the oracle account doesn't need to live on the
+	// price-feeder host. The service implements:
+	//
+	//	service Signer {
+	//	  rpc GetPubKey(google.protobuf.Empty) returns (google.protobuf.BytesValue);
+	//	  rpc Sign(google.protobuf.BytesValue) returns (google.protobuf.BytesValue);
+	//	}
+	//
+	// in the umee.pricefeeder.signer.v1 package, where the public key is a
+	// compressed secp256k1 key, the sign bytes are the SIGN_MODE_DIRECT sign
+	// doc of the transaction and the signature is a secp256k1 signature of
+	// them. See RegisterSignerServer.
+	RemoteSigner struct {
+		conn    *grpc.ClientConn
+		timeout time.Duration
+	}
+)
+
+var (
+	_ Signer = KeyringSigner{}
+	_ Signer = (*RemoteSigner)(nil)
+)
+
+// NewKeyringSigner returns a Signer of the key of the given address in the
+// keyring.
+func NewKeyringSigner(kr keyring.Keyring, addr sdk.AccAddress) KeyringSigner {
+	return KeyringSigner{
+		keyring: kr,
+		addr:    addr,
+	}
+}
+
+// PubKey implements the Signer interface.
+func (s KeyringSigner) PubKey(context.Context) (cryptotypes.PubKey, error) {
+	record, err := s.keyring.KeyByAddress(s.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.GetPubKey()
+}
+
+// Sign implements the Signer interface.
+func (s KeyringSigner) Sign(_ context.Context, signBytes []byte) ([]byte, error) {
+	sig, _, err := s.keyring.SignByAddress(s.addr, signBytes)
+	return sig, err
+}
+
+// NewRemoteSigner dials the remote signing service at the given endpoint. The
+// connection is closed when the context is done. Every request to the service
+// times out after the given timeout, or a default of 5s if zero.
+func NewRemoteSigner(ctx context.Context, endpoint string, useTLS bool, timeout time.Duration) (*RemoteSigner, error) {
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(creds), grpc.WithContextDialer(dialerFunc))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	if timeout == 0 {
+		timeout = defaultRemoteSignerTimeout
+	}
+
+	return &RemoteSigner{
+		conn:    conn,
+		timeout: timeout,
+	}, nil
+}
+
+// PubKey implements the Signer interface.
+func (s *RemoteSigner) PubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp := &wrapperspb.BytesValue{}
+	if err := s.conn.Invoke(ctx, signerMethodGetPubKey, &emptypb.Empty{}, resp); err != nil {
+		return nil, fmt.Errorf("failed to get public key from remote signer: %w", err)
+	}
+	if len(resp.Value) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key size from remote signer: %d", len(resp.Value))
+	}
+
+	return &secp256k1.PubKey{Key: resp.Value}, nil
+}
+
+// Sign implements the Signer interface.
+func (s *RemoteSigner) Sign(ctx context.Context, signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp := &wrapperspb.BytesValue{}
+	if err := s.conn.Invoke(ctx, signerMethodSign, wrapperspb.Bytes(signBytes), resp); err != nil {
+		return nil, fmt.Errorf("failed to sign with remote signer: %w", err)
+	}
+
+	return resp.Value, nil
+}
+
+// RegisterSignerServer registers the remote signing service, signing with the
+// given Signer, on the gRPC server. It allows to run a signing service of a
+// local key, ex. in front of an HSM, or to test the RemoteSigner.
+func RegisterSignerServer(server *grpc.Server, signer Signer) {
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: signerServiceName,
+		HandlerType: (*Signer)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "GetPubKey",
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					if err := dec(&emptypb.Empty{}); err != nil {
+						return nil, err
+					}
+
+					pubKey, err := srv.(Signer).PubKey(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return wrapperspb.Bytes(pubKey.Bytes()), nil
+				},
+			},
+			{
+				MethodName: "Sign",
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					req := &wrapperspb.BytesValue{}
+					if err := dec(req); err != nil {
+						return nil, err
+					}
+
+					sig, err := srv.(Signer).Sign(ctx, req.Value)
+					if err != nil {
+						return nil, err
+					}
+					return wrapperspb.Bytes(sig), nil
+				},
+			},
+		},
+	}, signer)
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// wrongSigner defines a Signer returning signatures of another key.
+type wrongSigner struct {
+	Signer
+	other Signer
+}
+
+func (s wrongSigner) Sign(ctx context.Context, signBytes []byte) ([]byte, error) {
+	return s.other.Sign(ctx, signBytes)
+}
+
+// startSignerServer starts a stand-in remote signing service of a new key and
+// returns its endpoint and the local signer of the key.
+func startSignerServer(t *testing.T, kr keyring.Keyring, uid string) (string, KeyringSigner) {
+	record, _, err := kr.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	localSigner := NewKeyringSigner(kr, addr)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	RegisterSignerServer(server, localSigner)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String(), localSigner
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	encoding := umeeapp.MakeEncodingConfig()
+	kr := keyring.NewInMemory(encoding.Codec)
+	endpoint, localSigner := startSignerServer(t, kr, "oracle")
+
+	remoteSigner, err := NewRemoteSigner(ctx, endpoint, false, 0)
+	require.NoError(t, err)
+
+	pubKey, err := remoteSigner.PubKey(ctx)
+	require.NoError(t, err)
+	localPubKey, err := localSigner.PubKey(ctx)
+	require.NoError(t, err)
+	require.True(t, localPubKey.Equals(pubKey))
+
+	sig, err := remoteSigner.Sign(ctx, []byte("sign bytes"))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature([]byte("sign bytes"), sig))
+
+	// sign a prevote with the remote signer
+	clientCtx := client.Context{}.WithTxConfig(encoding.TxConfig)
+	txf := tx.Factory{}.
+		WithTxConfig(encoding.TxConfig).
+		WithChainID("umee-test").
+		WithAccountNumber(3).
+		WithSequence(7).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	addr := sdk.AccAddress(pubKey.Address())
+	txBuilder, err := txf.BuildUnsignedTx(oracletypes.NewMsgAggregateExchangeRatePrevote(
+		oracletypes.AggregateVoteHash{1, 2, 3}, addr, sdk.ValAddress(addr),
+	))
+	require.NoError(t, err)
+	require.NoError(t, signTx(ctx, clientCtx, txf, remoteSigner, txBuilder))
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, pubKey.Equals(sigs[0].PubKey))
+
+	signBytes, err := encoding.TxConfig.SignModeHandler().GetSignBytes(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{
+			ChainID:       "umee-test",
+			AccountNumber: 3,
+			Sequence:      7,
+			PubKey:        pubKey,
+			Address:       addr.String(),
+		},
+		txBuilder.GetTx(),
+	)
+	require.NoError(t, err)
+	sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, ok)
+	require.True(t, pubKey.VerifySignature(signBytes, sigData.Signature))
+
+	// a signature of another key is rejected
+	_, otherSigner := startSignerServer(t, kr, "other")
+	err = signTx(ctx, clientCtx, txf, wrongSigner{Signer: remoteSigner, other: otherSigner}, txBuilder)
+	require.EqualError(t, err, "invalid signature from signer")
+}
+
+func TestRemoteSigner_Unavailable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	endpoint := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	remoteSigner, err := NewRemoteSigner(ctx, endpoint, false, 0)
+	require.NoError(t, err)
+
+	_, err = remoteSigner.PubKey(ctx)
+	require.Error(t, err)
+}
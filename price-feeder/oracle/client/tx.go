@@ -1,9 +1,14 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 // BroadcastTx attempts to generate, sign and broadcast a transaction with the
@@ -12,8 +17,9 @@ import (
 //
 // Note, BroadcastTx is copied from the SDK except it removes a few unnecessary
 // things like prompting for confirmation and printing the response. Instead,
-// we return the TxResponse.
-func BroadcastTx(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+// we return the TxResponse. The transaction is signed by the given Signer
+// rather than the keyring of the client context.
+func BroadcastTx(clientCtx client.Context, txf tx.Factory, signer Signer, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
 	txf, err := prepareFactory(clientCtx, txf)
 	if err != nil {
 		return nil, err
@@ -34,7 +40,7 @@ func BroadcastTx(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sd
 	unsignedTx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
 	// unsignedTx.SetFeePayer(clientCtx.GetFeePayerAddress())
 
-	if err = tx.Sign(txf, clientCtx.GetFromName(), unsignedTx, true); err != nil {
+	if err = signTx(context.Background(), clientCtx, txf, signer, unsignedTx); err != nil {
 		return nil, err
 	}
 
@@ -46,6 +52,61 @@ func BroadcastTx(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sd
 	return clientCtx.BroadcastTx(txBytes)
 }
 
+// signTx signs the transaction with the Signer, overwriting any signature.
+//
+// Note, signTx is copied from the SDK's tx.Sign except it signs with a Signer
+// instead of a keyring and verifies the returned signature.
+func signTx(ctx context.Context, clientCtx client.Context, txf tx.Factory, signer Signer, txBuilder client.TxBuilder) error {
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		// use the SignModeHandler's default mode if unspecified
+		signMode = clientCtx.TxConfig.SignModeHandler().DefaultMode()
+	}
+
+	pubKey, err := signer.PubKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+
+	// For SIGN_MODE_DIRECT, the signer infos are part of the sign bytes, hence
+	// setting the signature with a nil signature first.
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
+
+	bytesToSign, err := clientCtx.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	sigBytes, err := signer.Sign(ctx, bytesToSign)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(bytesToSign, sigBytes) {
+		return fmt.Errorf("invalid signature from signer")
+	}
+
+	sig.Data = &signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: sigBytes,
+	}
+	return txBuilder.SetSignatures(sig)
+}
+
 // prepareFactory ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
 // they will be queried for and set on the provided Factory. A new Factory with
@@ -0,0 +1,162 @@
+package oracle
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// availablePairsTTL defines how long the available pairs of a provider are
+// cached for.
+const availablePairsTTL = time.Hour
+
+// availablePairs defines the pairs available on a provider at a given time.
+type availablePairs struct {
+	pairs     map[string]struct{}
+	updatedAt time.Time
+}
+
+// discoverPairs derives the bases required by the AcceptList and discovers the
+// currency pairs of the ones no pair is configured for. For every such base,
+// the providers are picked in order of preference among the ones listing the
+// base with a quote that can be converted to USD, the first available quote in
+// order of preference being used. The discovered pairs of the bases removed
+// from the AcceptList are dropped.
+func (o *Oracle) discoverPairs(ctx context.Context, params oracletypes.Params) {
+	required := make(map[string]struct{}, len(params.AcceptList))
+	for _, denom := range params.AcceptList {
+		required[strings.ToUpper(denom.SymbolDenom)] = struct{}{}
+	}
+
+	configuredBases := basesOf(o.configuredPairs)
+	discovered := make(map[provider.Name][]types.CurrencyPair)
+	for providerName, pairs := range o.discoveredPairs {
+		for _, pair := range pairs {
+			_, isRequired := required[pair.Base]
+			_, isConfigured := configuredBases[pair.Base]
+			if isRequired && !isConfigured {
+				discovered[providerName] = append(discovered[providerName], pair)
+			}
+		}
+	}
+
+	providerPairs := mergeProviderPairs(o.configuredPairs, discovered)
+	coveredBases := basesOf(providerPairs)
+	usdQuotes := usdQuotesOf(providerPairs)
+
+	missing := make([]string, 0, len(required))
+	for base := range required {
+		if _, ok := coveredBases[base]; !ok {
+			missing = append(missing, base)
+		}
+	}
+	sort.Strings(missing)
+
+	for _, base := range missing {
+		providers := []provider.Name{}
+		for _, providerName := range o.autoPairs.Providers {
+			if len(providers) == o.autoPairs.ProvidersPerBase {
+				break
+			}
+
+			available, err := o.getAvailablePairs(ctx, providerName)
+			if err != nil {
+				o.logger.Warn().Err(err).Str("provider", string(providerName)).Msg("failed to get available pairs")
+				continue
+			}
+
+			for _, quote := range o.autoPairs.Quotes {
+				pair := types.CurrencyPair{Base: base, Quote: strings.ToUpper(quote)}
+				if _, ok := usdQuotes[pair.Quote]; !ok {
+					continue
+				}
+				if _, ok := available[pair.String()]; ok {
+					discovered[providerName] = append(discovered[providerName], pair)
+					providers = append(providers, providerName)
+					break
+				}
+			}
+		}
+
+		if len(providers) == 0 {
+			o.logger.Warn().Str("denom", base).Msg("no provider found for required denom")
+			continue
+		}
+
+		telemetry.IncrCounter(1, "auto_pairs", "discovered")
+		o.logger.Info().
+			Str("denom", base).
+			Interface("providers", providers).
+			Msg("discovered currency pairs for required denom")
+	}
+
+	o.discoveredPairs = discovered
+	o.setProviderPairs(mergeProviderPairs(o.configuredPairs, discovered))
+}
+
+// getAvailablePairs returns the available pairs of the provider, cached for
+// availablePairsTTL. A provider that is not running is created to be queried
+// and closed afterwards.
+func (o *Oracle) getAvailablePairs(ctx context.Context, providerName provider.Name) (map[string]struct{}, error) {
+	if cached, ok := o.availablePairs[providerName]; ok && time.Since(cached.updatedAt) < availablePairsTTL {
+		return cached.pairs, nil
+	}
+
+	priceProvider, ok := o.priceProviders[providerName]
+	if !ok {
+		providerCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		newProvider, err := o.newProvider(providerCtx, providerName)
+		if err != nil {
+			return nil, err
+		}
+		priceProvider = newProvider
+	}
+
+	pairs, err := priceProvider.GetAvailablePairs()
+	if err != nil {
+		return nil, err
+	}
+
+	o.availablePairs[providerName] = availablePairs{
+		pairs:     pairs,
+		updatedAt: time.Now(),
+	}
+	return pairs, nil
+}
+
+// basesOf returns the bases of the currency pairs of every provider.
+func basesOf(providerPairs map[provider.Name][]types.CurrencyPair) map[string]struct{} {
+	bases := make(map[string]struct{})
+	for _, pairs := range providerPairs {
+		for _, pair := range pairs {
+			bases[strings.ToUpper(pair.Base)] = struct{}{}
+		}
+	}
+
+	return bases
+}
+
+// usdQuotesOf returns the quotes that prices can be converted to USD from: USD
+// and the bases of the USD quoted pairs.
+func usdQuotesOf(providerPairs map[provider.Name][]types.CurrencyPair) map[string]struct{} {
+	quotes := map[string]struct{}{config.DenomUSD: {}}
+	for _, pairs := range providerPairs {
+		for _, pair := range pairs {
+			if strings.ToUpper(pair.Quote) == config.DenomUSD {
+				quotes[strings.ToUpper(pair.Base)] = struct{}{}
+			}
+		}
+	}
+
+	return quotes
+}
@@ -0,0 +1,142 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/client"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// listingProvider defines a mock provider listing the given pairs.
+type listingProvider struct {
+	subscribingProvider
+	available map[string]struct{}
+}
+
+func (p *listingProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	if p.available == nil {
+		return nil, fmt.Errorf("unavailable")
+	}
+	return p.available, nil
+}
+
+func newListingProvider(pairs ...string) *listingProvider {
+	p := &listingProvider{available: make(map[string]struct{}, len(pairs))}
+	for _, pair := range pairs {
+		p.available[pair] = struct{}{}
+	}
+	return p
+}
+
+func TestOracle_DiscoverPairs(t *testing.T) {
+	o, err := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{Base: "UMEE", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance}},
+			{Base: "USDT", Quote: "USD", Providers: []provider.Name{provider.ProviderCoinbase}},
+		},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		make(map[provider.Name]provider.Endpoint),
+		Options{AutoPairs: config.AutoPairs{
+			Enabled: true,
+			Providers: []provider.Name{
+				provider.ProviderOkx,
+				provider.ProviderBinance,
+				provider.ProviderKraken,
+				provider.ProviderHuobi,
+			},
+			Quotes:           []string{"USDT", "USD", "USDC"},
+			ProvidersPerBase: 2,
+		}},
+	)
+	require.NoError(t, err)
+
+	binance := newListingProvider("UMEEUSDT", "ATOMUSDT", "OSMOUSDT")
+	okx := newListingProvider("ATOMUSDC", "ATOMUSD", "JUNOUSDT")
+	kraken := newListingProvider("ATOMUSDT", "OSMOUSDC")
+	huobi := &listingProvider{}
+	o.priceProviders = map[provider.Name]provider.Provider{
+		provider.ProviderBinance:  binance,
+		provider.ProviderOkx:      okx,
+		provider.ProviderKraken:   kraken,
+		provider.ProviderHuobi:    huobi,
+		provider.ProviderCoinbase: newListingProvider(),
+	}
+
+	params := oracletypes.DefaultParams()
+	params.AcceptList = oracletypes.DenomList{
+		{SymbolDenom: "UMEE"},
+		{SymbolDenom: "atom"},
+		{SymbolDenom: "OSMO"},
+		{SymbolDenom: "JUNO"},
+		{SymbolDenom: "STARS"},
+	}
+
+	ctx := context.Background()
+	o.discoverPairs(ctx, params)
+
+	atomUSD := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	atomUSDT := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	junoUSDT := types.CurrencyPair{Base: "JUNO", Quote: "USDT"}
+	osmoUSDT := types.CurrencyPair{Base: "OSMO", Quote: "USDT"}
+	umeeUSDT := types.CurrencyPair{Base: "UMEE", Quote: "USDT"}
+
+	// the preferred providers are picked with their preferred quote, USDC not
+	// being convertible to USD
+	require.Equal(t, []types.CurrencyPair{atomUSD, junoUSDT}, o.providerPairs[provider.ProviderOkx])
+	require.Equal(t, []types.CurrencyPair{umeeUSDT, atomUSDT, osmoUSDT}, o.providerPairs[provider.ProviderBinance])
+	require.NotContains(t, o.providerPairs, provider.ProviderKraken)
+	require.NotContains(t, o.providerPairs, provider.ProviderHuobi)
+	require.Equal(t, []types.CurrencyPair{atomUSD, junoUSDT}, okx.subscribed)
+	require.Equal(t, []types.CurrencyPair{atomUSDT, osmoUSDT}, binance.subscribed)
+
+	// the configured pairs are kept
+	require.Equal(t, o.configuredPairs[provider.ProviderCoinbase], o.providerPairs[provider.ProviderCoinbase])
+
+	// unused providers are closed
+	require.NotContains(t, o.priceProviders, provider.ProviderKraken)
+	require.NotContains(t, o.priceProviders, provider.ProviderHuobi)
+
+	// a denom removed from the AcceptList is dropped
+	params.AcceptList = params.AcceptList[:3]
+	o.discoverPairs(ctx, params)
+	require.Equal(t, []types.CurrencyPair{atomUSD}, o.providerPairs[provider.ProviderOkx])
+	require.Equal(t, []types.CurrencyPair{umeeUSDT, atomUSDT, osmoUSDT}, o.providerPairs[provider.ProviderBinance])
+}
+
+func TestMergeProviderPairs(t *testing.T) {
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	umee := types.CurrencyPair{Base: "UMEE", Quote: "USDT"}
+
+	merged := mergeProviderPairs(
+		map[provider.Name][]types.CurrencyPair{provider.ProviderBinance: {umee, atom}},
+		map[provider.Name][]types.CurrencyPair{
+			provider.ProviderBinance: {atom},
+			provider.ProviderOkx:     {umee},
+		},
+	)
+	require.Equal(t, map[provider.Name][]types.CurrencyPair{
+		provider.ProviderBinance: {umee, atom},
+		provider.ProviderOkx:     {umee},
+	}, merged)
+}
+
+func TestUsdQuotesOf(t *testing.T) {
+	quotes := usdQuotesOf(map[provider.Name][]types.CurrencyPair{
+		provider.ProviderBinance:  {{Base: "UMEE", Quote: "USDT"}},
+		provider.ProviderCoinbase: {{Base: "USDT", Quote: "USD"}},
+	})
+	require.Equal(t, map[string]struct{}{"USD": {}, "USDT": {}}, quotes)
+}
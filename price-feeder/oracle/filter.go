@@ -1,6 +1,8 @@
 package oracle
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
@@ -145,6 +147,52 @@ func FilterCandleDeviations(
 	return filteredCandles, nil
 }
 
+// FilteredTickerProviders returns the providers of every base whose tickers
+// were filtered out by FilterTickerDeviations.
+func FilteredTickerProviders(
+	prices provider.AggregatedProviderPrices,
+	filteredPrices provider.AggregatedProviderPrices,
+) map[string][]provider.Name {
+	filteredOut := make(map[string][]provider.Name)
+	for providerName, priceMap := range prices {
+		for base := range priceMap {
+			if _, ok := filteredPrices[providerName][base]; !ok {
+				filteredOut[base] = append(filteredOut[base], providerName)
+			}
+		}
+	}
+
+	sortProviderNames(filteredOut)
+	return filteredOut
+}
+
+// FilteredCandleProviders returns the providers of every base whose candles
+// were filtered out by FilterCandleDeviations.
+func FilteredCandleProviders(
+	candles provider.AggregatedProviderCandles,
+	filteredCandles provider.AggregatedProviderCandles,
+) map[string][]provider.Name {
+	filteredOut := make(map[string][]provider.Name)
+	for providerName, candleMap := range candles {
+		for base := range candleMap {
+			if _, ok := filteredCandles[providerName][base]; !ok {
+				filteredOut[base] = append(filteredOut[base], providerName)
+			}
+		}
+	}
+
+	sortProviderNames(filteredOut)
+	return filteredOut
+}
+
+func sortProviderNames(providers map[string][]provider.Name) {
+	for _, names := range providers {
+		sort.Slice(names, func(i, j int) bool {
+			return names[i] < names[j]
+		})
+	}
+}
+
 func isBetween(p, mean, margin sdk.Dec) bool {
 	return p.GTE(mean.Sub(margin)) &&
 		p.LTE(mean.Add(margin))
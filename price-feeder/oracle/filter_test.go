@@ -57,6 +57,11 @@ func TestSuccessFilterCandleDeviations(t *testing.T) {
 	_, ok := pricesFiltered[provider.ProviderCoinbase]
 	require.NoError(t, err, "It should successfully filter out the provider using candles")
 	require.False(t, ok, "The filtered candle deviation price at coinbase should be empty")
+	require.Equal(
+		t,
+		map[string][]provider.Name{pair.Base: {provider.ProviderCoinbase}},
+		FilteredCandleProviders(providerCandles, pricesFiltered),
+	)
 
 	customDeviations := make(map[string]sdk.Dec, 1)
 	customDeviations[pair.Base] = sdk.NewDec(2)
@@ -112,6 +117,11 @@ func TestSuccessFilterTickerDeviations(t *testing.T) {
 	_, ok := pricesFiltered[provider.ProviderCoinbase]
 	require.NoError(t, err, "It should successfully filter out the provider using tickers")
 	require.False(t, ok, "The filtered ticker deviation price at coinbase should be empty")
+	require.Equal(
+		t,
+		map[string][]provider.Name{pair.Base: {provider.ProviderCoinbase}},
+		FilteredTickerProviders(providerTickers, pricesFiltered),
+	)
 
 	customDeviations := make(map[string]sdk.Dec, 1)
 	customDeviations[pair.Base] = sdk.NewDec(2)
@@ -2,10 +2,57 @@ package oracle
 
 import (
 	"context"
+	"fmt"
 	"net"
-	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/client"
+	uibctypes "github.com/umee-network/umee/v3/x/ibctransfer/types"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
 )
 
+// queryOracle calls fn with a x/oracle query client of the active gRPC
+// endpoint, failing over to another endpoint if it is unhealthy.
+func (o *Oracle) queryOracle(ctx context.Context, fn func(context.Context, oracletypes.QueryClient) error) error {
+	return o.queryChain(ctx, func(ctx context.Context, grpcConn *grpc.ClientConn) error {
+		return fn(ctx, oracletypes.NewQueryClient(grpcConn))
+	})
+}
+
+// queryUIBC calls fn with a uibc query client of the active gRPC endpoint,
+// failing over to another endpoint if it is unhealthy.
+func (o *Oracle) queryUIBC(ctx context.Context, fn func(context.Context, uibctypes.QueryClient) error) error {
+	return o.queryChain(ctx, func(ctx context.Context, grpcConn *grpc.ClientConn) error {
+		return fn(ctx, uibctypes.NewQueryClient(grpcConn))
+	})
+}
+
+// queryChain calls fn with a connection to the active gRPC endpoint, failing
+// over to another endpoint if it is unhealthy.
+func (o *Oracle) queryChain(ctx context.Context, fn func(context.Context, *grpc.ClientConn) error) error {
+	return o.oracleClient.GRPCEndpoints.Do(ctx, func(endpoint string) error {
+		grpcConn, err := grpc.Dial(
+			endpoint,
+			// the Cosmos SDK doesn't support any transport security mechanism
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(dialerFunc),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		}
+
+		defer grpcConn.Close()
+
+		ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		defer cancel()
+
+		return fn(ctx, grpcConn)
+	})
+}
+
 func dialerFunc(_ context.Context, addr string) (net.Conn, error) {
 	return Connect(addr)
 }
@@ -14,21 +61,12 @@ func dialerFunc(_ context.Context, addr string) (net.Conn, error) {
 // argument should be prefixed with the protocol,
 // eg. "tcp://127.0.0.1:8080" or "unix:///tmp/test.sock".
 func Connect(protoAddr string) (net.Conn, error) {
-	proto, address := ProtocolAndAddress(protoAddr)
-	conn, err := net.Dial(proto, address)
-	return conn, err
+	return client.Connect(protoAddr)
 }
 
 // ProtocolAndAddress splits an address into the protocol and address components.
 // For instance, "tcp://127.0.0.1:8080" will be split into "tcp" and "127.0.0.1:8080".
 // If the address has no protocol prefix, the default is "tcp".
 func ProtocolAndAddress(listenAddr string) (string, string) {
-	protocol, address := "tcp", listenAddr
-
-	parts := strings.SplitN(address, "://", 2)
-	if len(parts) == 2 {
-		protocol, address = parts[0], parts[1]
-	}
-
-	return protocol, address
+	return client.ProtocolAndAddress(listenAddr)
 }
@@ -0,0 +1,170 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+)
+
+const dbName = "history"
+
+type (
+	// Tick defines the outcome of an oracle tick: the prices it computed, the
+	// providers it used or discarded, the transactions it broadcast and the
+	// error it failed with, if any.
+	Tick struct {
+		Time        time.Time `json:"time"`
+		BlockHeight int64     `json:"block_height,omitempty"`
+
+		// Prices defines the computed price of every base
+		Prices map[string]sdk.Dec `json:"prices,omitempty"`
+
+		// ProviderPrices defines the TVWAP, or VWAP, of every base per provider
+		ProviderPrices map[provider.Name]map[string]sdk.Dec `json:"provider_prices,omitempty"`
+
+		// FilteredProviders defines the providers whose prices of a base were
+		// filtered out for deviating from the others
+		FilteredProviders map[string][]provider.Name `json:"filtered_providers,omitempty"`
+
+		// ProviderErrors defines the error of every provider that failed to
+		// return prices
+		ProviderErrors map[provider.Name]string `json:"provider_errors,omitempty"`
+
+		Prevote *Tx    `json:"prevote,omitempty"`
+		Vote    *Tx    `json:"vote,omitempty"`
+		Error   string `json:"error,omitempty"`
+	}
+
+	// Tx defines a prevote or vote transaction broadcast during a tick.
+	Tx struct {
+		Hash          string `json:"hash"`
+		ExchangeRates string `json:"exchange_rates,omitempty"`
+
+		// Height defines the height the transaction was included at, zero until
+		// it is found on-chain
+		Height int64  `json:"height,omitempty"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	// Store defines a persistent store of the ticks of the oracle, keyed by
+	// their time. Ticks older than the retention are pruned.
+	Store struct {
+		db        dbm.DB
+		retention time.Duration
+	}
+)
+
+// NewStore opens, or creates, the tick history store in the given directory.
+func NewStore(dir string, retention time.Duration) (*Store, error) {
+	db, err := dbm.NewGoLevelDB(dbName, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history store: %w", err)
+	}
+
+	return newStore(db, retention), nil
+}
+
+func newStore(db dbm.DB, retention time.Duration) *Store {
+	return &Store{
+		db:        db,
+		retention: retention,
+	}
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Save persists the tick and prunes the ticks older than the retention.
+func (s *Store) Save(tick Tick) error {
+	bz, err := json.Marshal(tick)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Set(timeKey(tick.Time), bz); err != nil {
+		return err
+	}
+
+	return s.prune(tick.Time.Add(-s.retention))
+}
+
+// Update applies fn to the tick saved at the given time.
+func (s *Store) Update(t time.Time, fn func(*Tick)) error {
+	bz, err := s.db.Get(timeKey(t))
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("no tick at %s", t)
+	}
+
+	var tick Tick
+	if err := json.Unmarshal(bz, &tick); err != nil {
+		return err
+	}
+	fn(&tick)
+
+	if bz, err = json.Marshal(tick); err != nil {
+		return err
+	}
+	return s.db.Set(timeKey(t), bz)
+}
+
+// Latest returns up to limit of the latest ticks, the most recent first. When
+// votesOnly is set, only the ticks that broadcast a prevote or a vote are
+// returned.
+func (s *Store) Latest(limit int, votesOnly bool) ([]Tick, error) {
+	iter, err := s.db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	ticks := []Tick{}
+	for ; iter.Valid() && len(ticks) < limit; iter.Next() {
+		var tick Tick
+		if err := json.Unmarshal(iter.Value(), &tick); err != nil {
+			return nil, err
+		}
+		if votesOnly && tick.Prevote == nil && tick.Vote == nil {
+			continue
+		}
+		ticks = append(ticks, tick)
+	}
+
+	return ticks, iter.Error()
+}
+
+// prune deletes the ticks before the given time.
+func (s *Store) prune(before time.Time) error {
+	iter, err := s.db.Iterator(nil, timeKey(before))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := s.db.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func timeKey(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
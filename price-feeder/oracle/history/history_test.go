@@ -0,0 +1,79 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+)
+
+func TestStore(t *testing.T) {
+	store := newStore(dbm.NewMemDB(), time.Hour)
+	start := time.Unix(1670000000, 0)
+
+	ticks := []Tick{
+		{
+			Time:        start,
+			BlockHeight: 10,
+			Prices:      map[string]sdk.Dec{"UMEE": sdk.MustNewDecFromStr("0.005")},
+			ProviderPrices: map[provider.Name]map[string]sdk.Dec{
+				provider.ProviderBinance: {"UMEE": sdk.MustNewDecFromStr("0.005")},
+				provider.ProviderOkx:     {"UMEE": sdk.MustNewDecFromStr("0.0051")},
+			},
+			FilteredProviders: map[string][]provider.Name{"UMEE": {provider.ProviderHuobi}},
+			ProviderErrors:    map[provider.Name]string{provider.ProviderKraken: "provider timed out"},
+		},
+		{
+			Time:        start.Add(time.Second),
+			BlockHeight: 10,
+			Prevote:     &Tx{Hash: "AB12"},
+		},
+		{
+			Time:        start.Add(2 * time.Second),
+			BlockHeight: 11,
+			Error:       "expected positive block height",
+		},
+	}
+	for _, tick := range ticks {
+		require.NoError(t, store.Save(tick))
+	}
+
+	latest, err := store.Latest(10, false)
+	require.NoError(t, err)
+	require.Len(t, latest, 3)
+	require.Equal(t, ticks[2].Error, latest[0].Error)
+	require.Equal(t, ticks[0].Prices, latest[2].Prices)
+	require.Equal(t, ticks[0].ProviderPrices, latest[2].ProviderPrices)
+	require.Equal(t, ticks[0].FilteredProviders, latest[2].FilteredProviders)
+	require.Equal(t, ticks[0].ProviderErrors, latest[2].ProviderErrors)
+
+	latest, err = store.Latest(2, false)
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	require.Equal(t, int64(11), latest[0].BlockHeight)
+
+	votes, err := store.Latest(10, true)
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+	require.Equal(t, "AB12", votes[0].Prevote.Hash)
+
+	require.NoError(t, store.Update(ticks[1].Time, func(tick *Tick) {
+		tick.Prevote.Height = 12
+	}))
+	votes, err = store.Latest(10, true)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), votes[0].Prevote.Height)
+
+	require.Error(t, store.Update(start.Add(time.Minute), func(*Tick) {}))
+
+	// saving a tick an hour later prunes the ticks older than the retention
+	require.NoError(t, store.Save(Tick{Time: start.Add(time.Hour + 2*time.Second)}))
+	latest, err = store.Latest(10, false)
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	require.Equal(t, ticks[2].Time.Unix(), latest[1].Time.Unix())
+}
@@ -14,15 +14,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/umee-network/umee/price-feeder/v2/config"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/client"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/history"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
 	pfsync "github.com/umee-network/umee/price-feeder/v2/pkg/sync"
+	uibctypes "github.com/umee-network/umee/v3/x/ibctransfer/types"
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
 )
 
@@ -32,6 +32,10 @@ import (
 // at least one block during each voting period.
 const (
 	tickerSleep = 1000 * time.Millisecond
+
+	// maxTxQueryAttempts defines the number of ticks during which the inclusion
+	// of a broadcast transaction is queried before it is deemed not included.
+	maxTxQueryAttempts = 30
 )
 
 // PreviousPrevote defines a structure for defining the previous prevote
@@ -42,6 +46,15 @@ type PreviousPrevote struct {
 	SubmitBlockHeight int64
 }
 
+// pendingTx defines a broadcast prevote or vote transaction whose inclusion
+// height is not known yet.
+type pendingTx struct {
+	tickTime time.Time
+	hash     string
+	vote     bool
+	attempts int
+}
+
 func NewPreviousPrevote() *PreviousPrevote {
 	return &PreviousPrevote{
 		Salt:              "",
@@ -59,13 +72,30 @@ type Oracle struct {
 
 	providerTimeout    time.Duration
 	providerPairs      map[provider.Name][]types.CurrencyPair
+	configuredPairs    map[provider.Name][]types.CurrencyPair
+	discoveredPairs    map[provider.Name][]types.CurrencyPair
+	autoPairs          config.AutoPairs
+	aggregators        map[string]Aggregator
+	availablePairs     map[provider.Name]availablePairs
 	previousPrevote    *PreviousPrevote
 	previousVotePeriod float64
 	priceProviders     map[provider.Name]provider.Provider
+	providerCancels    map[provider.Name]context.CancelFunc
 	oracleClient       client.OracleClient
 	deviations         map[string]sdk.Dec
 	endpoints          map[provider.Name]provider.Endpoint
+	recording          config.Recording
+	customProviders    map[provider.Name]provider.CustomProviderConfig
+	dexProviders       map[provider.Name]provider.DexProviderConfig
 	paramCache         ParamCache
+	history            *history.Store
+	tickRecord         *history.Tick
+	pendingTxs         []pendingTx
+	pendingVoteAudit   *voteAudit
+	missCounter        *uint64
+
+	reloadMutex   sync.Mutex
+	pendingReload *reload
 
 	pricesMutex     sync.RWMutex
 	lastPriceSyncTS time.Time
@@ -75,6 +105,37 @@ type Oracle struct {
 	vwapsByProvider  PricesWithMutex
 }
 
+// Options defines the optional features of the oracle, configured from the
+// price-feeder config file.
+type Options struct {
+	// Recording records the responses of the price providers, or replays
+	// previously recorded responses instead of querying the providers.
+	Recording config.Recording
+	// CustomProviders defines the providers configured from the config file.
+	CustomProviders []provider.CustomProviderConfig
+	// DexProviders defines the providers querying DEX chains over gRPC.
+	DexProviders []provider.DexProviderConfig
+	// AutoPairs discovers the currency pairs of the denoms of the on-chain
+	// AcceptList that no pair is configured for.
+	AutoPairs config.AutoPairs
+	// Aggregations defines the aggregation strategies of the bases.
+	Aggregations []config.Aggregation
+	// History defines the store the outcome of every tick is persisted to.
+	History *history.Store
+}
+
+// NewOptions returns the options configured from the price-feeder config file.
+// The history store is opened by the caller.
+func NewOptions(cfg config.Config) Options {
+	return Options{
+		Recording:       cfg.Recording,
+		CustomProviders: cfg.CustomProviders,
+		DexProviders:    cfg.DexProviders,
+		AutoPairs:       cfg.AutoPairs,
+		Aggregations:    cfg.Aggregations,
+	}
+}
+
 func New(
 	logger zerolog.Logger,
 	oc client.OracleClient,
@@ -82,16 +143,22 @@ func New(
 	providerTimeout time.Duration,
 	deviations map[string]sdk.Dec,
 	endpoints map[provider.Name]provider.Endpoint,
-) *Oracle {
-	providerPairs := make(map[provider.Name][]types.CurrencyPair)
-
-	for _, pair := range currencyPairs {
-		for _, provider := range pair.Providers {
-			providerPairs[provider] = append(providerPairs[provider], types.CurrencyPair{
-				Base:  pair.Base,
-				Quote: pair.Quote,
-			})
-		}
+	opts Options,
+) (*Oracle, error) {
+	providerPairs := providerPairsOf(currencyPairs)
+
+	aggregators, err := newAggregators(opts.Aggregations)
+	if err != nil {
+		return nil, err
+	}
+
+	customProviders := make(map[provider.Name]provider.CustomProviderConfig, len(opts.CustomProviders))
+	for _, custom := range opts.CustomProviders {
+		customProviders[custom.Name] = custom
+	}
+	dexProviders := make(map[provider.Name]provider.DexProviderConfig, len(opts.DexProviders))
+	for _, dex := range opts.DexProviders {
+		dexProviders[dex.Name] = dex
 	}
 
 	return &Oracle{
@@ -99,32 +166,52 @@ func New(
 		closer:          pfsync.NewCloser(),
 		oracleClient:    oc,
 		providerPairs:   providerPairs,
+		configuredPairs: providerPairs,
+		autoPairs:       opts.AutoPairs,
+		aggregators:     aggregators,
+		availablePairs:  make(map[provider.Name]availablePairs),
 		priceProviders:  make(map[provider.Name]provider.Provider),
+		providerCancels: make(map[provider.Name]context.CancelFunc),
 		previousPrevote: nil,
 		providerTimeout: providerTimeout,
 		deviations:      deviations,
 		paramCache:      ParamCache{},
 		endpoints:       endpoints,
-	}
+		recording:       opts.Recording,
+		customProviders: customProviders,
+		dexProviders:    dexProviders,
+		history:         opts.History,
+	}, nil
 }
 
-// Start starts the oracle process in a blocking fashion.
+// Start starts the oracle process in a blocking fashion. It closes the price
+// providers and returns once the context is done.
 func (o *Oracle) Start(ctx context.Context) error {
 	for {
 		select {
 		case <-ctx.Done():
+			o.closeProviders()
 			o.closer.Close()
+			return nil
 
 		default:
 			o.logger.Debug().Msg("starting oracle tick")
 
 			startTime := time.Now()
+			if o.history != nil {
+				o.tickRecord = &history.Tick{Time: startTime}
+			}
+
+			o.applyReload()
 
 			if err := o.tick(ctx); err != nil {
 				telemetry.IncrCounter(1, "failure", "tick")
 				o.logger.Err(err).Msg("oracle tick failed")
+				o.recordTick(func(t *history.Tick) { t.Error = err.Error() })
 			}
 
+			o.saveTickRecord()
+
 			o.lastPriceSyncTS = time.Now()
 
 			telemetry.MeasureSince(startTime, "runtime", "tick")
@@ -176,6 +263,17 @@ func (o *Oracle) GetVwapPrices() PricesByProvider {
 	return o.vwapsByProvider.GetPricesClone()
 }
 
+// GetTickHistory returns up to limit of the latest tick records, the most
+// recent first. When votesOnly is set, only the ticks that broadcast a prevote
+// or a vote are returned.
+func (o *Oracle) GetTickHistory(limit int, votesOnly bool) ([]history.Tick, error) {
+	if o.history == nil {
+		return nil, fmt.Errorf("tick history is disabled")
+	}
+
+	return o.history.Latest(limit, votesOnly)
+}
+
 // SetPrices retrieves all the prices and candles from our set of providers as
 // determined in the config. If candles are available, uses TVWAP in order
 // to determine prices. If candles are not available, uses the most recent prices
@@ -203,6 +301,19 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			}
 		}
 
+		// providerErr records the error of the provider in the tick record
+		providerErr := func(err error) error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			o.recordTick(func(t *history.Tick) {
+				if t.ProviderErrors == nil {
+					t.ProviderErrors = make(map[provider.Name]string)
+				}
+				t.ProviderErrors[providerName] = err.Error()
+			})
+			return err
+		}
+
 		g.Go(func() error {
 			prices := make(map[string]types.TickerPrice, 0)
 			candles := make(map[string][]types.CandlePrice, 0)
@@ -228,10 +339,10 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			case <-ch:
 				break
 			case err := <-errCh:
-				return err
+				return providerErr(err)
 			case <-time.After(o.providerTimeout):
 				telemetry.IncrCounter(1, "failure", "provider", "type", "timeout")
-				return fmt.Errorf("provider timed out")
+				return providerErr(fmt.Errorf("provider timed out"))
 			}
 
 			// flatten and collect prices based on the base currency per provider
@@ -242,7 +353,7 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 				success := SetProviderTickerPricesAndCandles(providerName, providerPrices, providerCandles, prices, candles, pair)
 				if !success {
 					mtx.Unlock()
-					return fmt.Errorf("failed to find any exchange rates in provider responses")
+					return providerErr(fmt.Errorf("failed to find any exchange rates in provider responses"))
 				}
 			}
 
@@ -274,13 +385,16 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 	o.pricesMutex.Lock()
 	o.prices = computedPrices
 	o.pricesMutex.Unlock()
+
+	o.recordTick(func(t *history.Tick) { t.Prices = computedPrices })
 	return nil
 }
 
 // GetComputedPrices gets the candle and ticker prices and computes it.
 // It returns candles' TVWAP if possible, if not possible (not available
 // or due to some staleness) it will use the most recent ticker prices
-// and the VWAP formula instead.
+// and the VWAP formula instead. The prices of the bases with an Aggregator
+// are computed by it instead.
 func (o *Oracle) GetComputedPrices(
 	providerCandles provider.AggregatedProviderCandles,
 	providerPrices provider.AggregatedProviderPrices,
@@ -298,6 +412,87 @@ func (o *Oracle) GetComputedPrices(
 		return nil, err
 	}
 
+	// the tickers are converted at most once, as the conversion is done in place
+	var (
+		convertedTickers provider.AggregatedProviderPrices
+		convertErr       error
+		converted        bool
+	)
+	convertTickers := func() (provider.AggregatedProviderPrices, error) {
+		if !converted {
+			convertedTickers, convertErr = ConvertTickersToUSD(
+				o.logger,
+				providerPrices,
+				providerPairs,
+				deviations,
+			)
+			converted = true
+		}
+		return convertedTickers, convertErr
+	}
+
+	if len(o.aggregators) == 0 {
+		return o.computeDefaultPrices(convertedCandles, convertTickers, deviations)
+	}
+
+	defaultCandles, aggregatedCandles := splitCandlesByAggregator(convertedCandles, o.aggregators)
+	prices, err = o.computeDefaultPrices(
+		defaultCandles,
+		func() (provider.AggregatedProviderPrices, error) {
+			tickers, err := convertTickers()
+			if err != nil {
+				return nil, err
+			}
+			defaultTickers, _ := splitTickersByAggregator(tickers, o.aggregators)
+			return defaultTickers, nil
+		},
+		deviations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tickers are only required by the bases without candles
+	var aggregatedTickers provider.AggregatedProviderPrices
+	if tickers, err := convertTickers(); err != nil {
+		o.logger.Warn().Err(err).Msg("failed to convert tickers of aggregated bases")
+	} else {
+		_, aggregatedTickers = splitTickersByAggregator(tickers, o.aggregators)
+	}
+
+	aggregatedPrices, pricesByProvider, err := AggregateProviderPrices(
+		o.aggregators,
+		aggregatedCandles,
+		aggregatedTickers,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for base, price := range aggregatedPrices {
+		prices[base] = price
+	}
+
+	tvwapsByProvider := o.tvwapsByProvider.GetPricesClone()
+	mergePricesByProvider(tvwapsByProvider, pricesByProvider)
+	o.tvwapsByProvider.SetPrices(tvwapsByProvider)
+	o.recordTick(func(t *history.Tick) {
+		providerPrices := make(PricesByProvider, len(t.ProviderPrices)+len(pricesByProvider))
+		mergePricesByProvider(providerPrices, t.ProviderPrices)
+		mergePricesByProvider(providerPrices, pricesByProvider)
+		t.ProviderPrices = providerPrices
+	})
+
+	return prices, nil
+}
+
+// computeDefaultPrices computes the TVWAP of the USD denominated candles or, if
+// none is available, the VWAP of the USD denominated tickers, after filtering
+// out the providers deviating from the others.
+func (o *Oracle) computeDefaultPrices(
+	convertedCandles provider.AggregatedProviderCandles,
+	convertTickers func() (provider.AggregatedProviderPrices, error),
+	deviations map[string]sdk.Dec,
+) (map[string]sdk.Dec, error) {
 	// filter out any erroneous candles
 	filteredCandles, err := FilterCandleDeviations(
 		o.logger,
@@ -310,6 +505,10 @@ func (o *Oracle) GetComputedPrices(
 
 	computedPrices, _ := ComputeTvwapsByProvider(filteredCandles)
 	o.tvwapsByProvider.SetPrices(computedPrices)
+	o.recordTick(func(t *history.Tick) {
+		t.ProviderPrices = computedPrices
+		t.FilteredProviders = FilteredCandleProviders(convertedCandles, filteredCandles)
+	})
 
 	// attempt to use candles for TVWAP calculations
 	tvwapPrices, err := ComputeTVWAP(filteredCandles)
@@ -320,12 +519,7 @@ func (o *Oracle) GetComputedPrices(
 	// If TVWAP candles are not available or were filtered out due to staleness,
 	// use most recent prices & VWAP instead.
 	if len(tvwapPrices) == 0 {
-		convertedTickers, err := ConvertTickersToUSD(
-			o.logger,
-			providerPrices,
-			providerPairs,
-			deviations,
-		)
+		convertedTickers, err := convertTickers()
 		if err != nil {
 			return nil, err
 		}
@@ -339,7 +533,12 @@ func (o *Oracle) GetComputedPrices(
 			return nil, err
 		}
 
-		o.vwapsByProvider.SetPrices(ComputeVwapsByProvider(filteredProviderPrices))
+		vwapsByProvider := ComputeVwapsByProvider(filteredProviderPrices)
+		o.vwapsByProvider.SetPrices(vwapsByProvider)
+		o.recordTick(func(t *history.Tick) {
+			t.ProviderPrices = vwapsByProvider
+			t.FilteredProviders = FilteredTickerProviders(convertedTickers, filteredProviderPrices)
+		})
 
 		vwapPrices := ComputeVWAP(filteredProviderPrices)
 
@@ -392,35 +591,59 @@ func (o *Oracle) GetParamCache(ctx context.Context, currentBlockHeigh int64) (or
 		return oracletypes.Params{}, err
 	}
 
-	o.checkAcceptList(params)
+	if o.autoPairs.Enabled {
+		o.discoverPairs(ctx, params)
+	}
+
+	assets, err := o.GetAssets(ctx)
+	if err != nil {
+		// the asset registry is only used to check the AcceptList
+		o.logger.Warn().Err(err).Msg("failed to get the IBC asset registry")
+	}
+	o.checkAcceptList(params, assets)
 	o.paramCache.Update(currentBlockHeigh, params)
 	return params, nil
 }
 
 // GetParams returns the current on-chain parameters of the x/oracle module.
 func (o *Oracle) GetParams(ctx context.Context) (oracletypes.Params, error) {
-	grpcConn, err := grpc.Dial(
-		o.oracleClient.GRPCEndpoint,
-		// the Cosmos SDK doesn't support any transport security mechanism
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithContextDialer(dialerFunc),
-	)
+	var params oracletypes.Params
+	err := o.queryOracle(ctx, func(ctx context.Context, queryClient oracletypes.QueryClient) error {
+		queryResponse, err := queryClient.Params(ctx, &oracletypes.QueryParams{})
+		if err != nil {
+			return fmt.Errorf("failed to get x/oracle params: %w", err)
+		}
+
+		params = queryResponse.Params
+		return nil
+	})
 	if err != nil {
-		return oracletypes.Params{}, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		return oracletypes.Params{}, err
 	}
 
-	defer grpcConn.Close()
-	queryClient := oracletypes.NewQueryClient(grpcConn)
+	return params, nil
+}
 
-	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
-	defer cancel()
+// GetAssets returns the on-chain IBC asset registry of the uibc module, keyed
+// by IBC denom.
+func (o *Oracle) GetAssets(ctx context.Context) (map[string]uibctypes.Asset, error) {
+	assets := make(map[string]uibctypes.Asset)
+	err := o.queryUIBC(ctx, func(ctx context.Context, queryClient uibctypes.QueryClient) error {
+		queryResponse, err := queryClient.Assets(ctx, &uibctypes.QueryAssets{})
+		if err != nil {
+			return fmt.Errorf("failed to get uibc assets: %w", err)
+		}
 
-	queryResponse, err := queryClient.Params(ctx, &oracletypes.QueryParams{})
+		for _, asset := range queryResponse.Assets {
+			assets[asset.IbcDenom] = asset
+		}
+		return nil
+	})
 	if err != nil {
-		return oracletypes.Params{}, fmt.Errorf("failed to get x/oracle params: %w", err)
+		return nil, err
 	}
 
-	return queryResponse.Params, nil
+	return assets, nil
 }
 
 func (o *Oracle) getOrSetProvider(ctx context.Context, providerName provider.Name) (provider.Provider, error) {
@@ -431,24 +654,60 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName provider.Nam
 
 	priceProvider, ok = o.priceProviders[providerName]
 	if !ok {
-		newProvider, err := NewProvider(
-			ctx,
-			providerName,
-			o.logger,
-			o.endpoints[providerName],
-			o.providerPairs[providerName]...,
-		)
+		// the provider has its own context to be closed when removed on reload
+		providerCtx, cancel := context.WithCancel(ctx)
+		newProvider, err := o.newProvider(providerCtx, providerName)
 		if err != nil {
+			cancel()
 			return nil, err
 		}
 		priceProvider = newProvider
 
 		o.priceProviders[providerName] = priceProvider
+		o.providerCancels[providerName] = cancel
 	}
 
 	return priceProvider, nil
 }
 
+// newProvider creates the provider with the given name, either a supported,
+// a custom or a dex one, wrapped in a recorder or replaced by a replay of its
+// recording when recording is configured.
+func (o *Oracle) newProvider(ctx context.Context, providerName provider.Name) (provider.Provider, error) {
+	if o.recording.Dir != "" && o.recording.Replay {
+		return provider.NewReplayProvider(
+			provider.RecordingPath(o.recording.Dir, providerName),
+			o.recording.Speed,
+		)
+	}
+
+	var (
+		priceProvider provider.Provider
+		err           error
+	)
+	if custom, ok := o.customProviders[providerName]; ok {
+		priceProvider, err = provider.NewCustomProvider(custom)
+	} else if dex, ok := o.dexProviders[providerName]; ok {
+		priceProvider, err = provider.NewDexProvider(ctx, dex)
+	} else {
+		priceProvider, err = NewProvider(
+			ctx,
+			providerName,
+			o.logger,
+			o.endpoints[providerName],
+			o.providerPairs[providerName]...,
+		)
+	}
+	if err != nil || o.recording.Dir == "" {
+		return priceProvider, err
+	}
+
+	return provider.NewRecordingProvider(
+		priceProvider,
+		provider.RecordingPath(o.recording.Dir, providerName),
+	)
+}
+
 func NewProvider(
 	ctx context.Context,
 	providerName provider.Name,
@@ -500,13 +759,40 @@ func NewProvider(
 	return nil, fmt.Errorf("provider %s not found", providerName)
 }
 
-func (o *Oracle) checkAcceptList(params oracletypes.Params) {
+// checkAcceptList warns about the denoms of the AcceptList without price, and
+// about the IBC denoms whose symbol or exponent don't match the asset
+// registry, the feeder then likely voting the price of another token.
+func (o *Oracle) checkAcceptList(params oracletypes.Params, assets map[string]uibctypes.Asset) {
 	for _, denom := range params.AcceptList {
 		symbol := strings.ToUpper(denom.SymbolDenom)
 		if _, ok := o.prices[symbol]; !ok {
 			o.logger.Warn().Str("denom", symbol).Msg("price missing for required denom")
 		}
 	}
+
+	for _, denom := range mismatchedAssets(params.AcceptList, assets) {
+		asset := assets[denom.BaseDenom]
+		o.logger.Warn().
+			Str("denom", denom.BaseDenom).
+			Str("symbol", denom.SymbolDenom).
+			Uint32("exponent", denom.Exponent).
+			Str("asset_symbol", asset.Symbol).
+			Uint32("asset_exponent", asset.Exponent).
+			Msg("required denom doesn't match the IBC asset registry")
+	}
+}
+
+// mismatchedAssets returns the denoms of the AcceptList registered in the IBC
+// asset registry with another symbol or exponent.
+func mismatchedAssets(acceptList oracletypes.DenomList, assets map[string]uibctypes.Asset) oracletypes.DenomList {
+	var mismatched oracletypes.DenomList
+	for _, denom := range acceptList {
+		asset, ok := assets[denom.BaseDenom]
+		if ok && (!strings.EqualFold(asset.Symbol, denom.SymbolDenom) || asset.Exponent != denom.Exponent) {
+			mismatched = append(mismatched, denom)
+		}
+	}
+	return mismatched
 }
 
 func (o *Oracle) tick(ctx context.Context) error {
@@ -519,12 +805,21 @@ func (o *Oracle) tick(ctx context.Context) error {
 	if blockHeight < 1 {
 		return fmt.Errorf("expected positive block height")
 	}
+	o.recordTick(func(t *history.Tick) { t.BlockHeight = blockHeight })
 
 	oracleParams, err := o.GetParamCache(ctx, blockHeight)
 	if err != nil {
 		return err
 	}
 
+	// audit our last vote once its vote period has been tallied
+	if o.pendingVoteAudit != nil && blockHeight >= o.pendingVoteAudit.tallyHeight {
+		if err := o.auditVote(ctx, *o.pendingVoteAudit, oracleParams); err != nil {
+			o.logger.Err(err).Msg("failed to audit vote")
+		}
+		o.pendingVoteAudit = nil
+	}
+
 	if err := o.SetPrices(ctx); err != nil {
 		return err
 	}
@@ -593,7 +888,9 @@ func (o *Oracle) tick(ctx context.Context) error {
 			Str("validator", preVoteMsg.Validator).
 			Str("feeder", preVoteMsg.Feeder).
 			Msg("broadcasting pre-vote")
-		if err := o.oracleClient.BroadcastTx(nextBlockHeight, oracleVotePeriod*2, preVoteMsg); err != nil {
+		resp, err := o.oracleClient.BroadcastTx(nextBlockHeight, oracleVotePeriod*2, preVoteMsg)
+		o.recordTx(resp, err, exchangeRatesStr, false)
+		if err != nil {
 			return err
 		}
 
@@ -622,14 +919,22 @@ func (o *Oracle) tick(ctx context.Context) error {
 			Str("validator", voteMsg.Validator).
 			Str("feeder", voteMsg.Feeder).
 			Msg("broadcasting vote")
-		if err := o.oracleClient.BroadcastTx(
+		resp, err := o.oracleClient.BroadcastTx(
 			nextBlockHeight,
 			oracleVotePeriod-indexInVotePeriod,
 			voteMsg,
-		); err != nil {
+		)
+		o.recordTx(resp, err, voteMsg.ExchangeRates, true)
+		if err != nil {
 			return err
 		}
 
+		// the vote is tallied at the last block of the current vote period
+		o.pendingVoteAudit = &voteAudit{
+			exchangeRates: voteMsg.ExchangeRates,
+			tallyHeight:   (int64(currentVotePeriod) + 1) * oracleVotePeriod,
+		}
+
 		o.previousPrevote = nil
 		o.previousVotePeriod = 0
 	}
@@ -637,6 +942,82 @@ func (o *Oracle) tick(ctx context.Context) error {
 	return nil
 }
 
+// recordTick applies fn to the record of the current tick. It is a no-op when
+// the tick history is disabled.
+func (o *Oracle) recordTick(fn func(*history.Tick)) {
+	if o.tickRecord != nil {
+		fn(o.tickRecord)
+	}
+}
+
+// recordTx records a broadcast prevote or vote in the record of the current
+// tick and queues it to query its inclusion height.
+func (o *Oracle) recordTx(resp *sdk.TxResponse, err error, exchangeRates string, vote bool) {
+	if o.tickRecord == nil {
+		return
+	}
+
+	tx := &history.Tx{ExchangeRates: exchangeRates}
+	if err != nil {
+		tx.Error = err.Error()
+	} else {
+		tx.Hash = resp.TxHash
+		o.pendingTxs = append(o.pendingTxs, pendingTx{
+			tickTime: o.tickRecord.Time,
+			hash:     resp.TxHash,
+			vote:     vote,
+		})
+	}
+
+	if vote {
+		o.tickRecord.Vote = tx
+	} else {
+		o.tickRecord.Prevote = tx
+	}
+}
+
+// saveTickRecord persists the record of the current tick and updates the
+// records of the transactions included since the previous tick.
+func (o *Oracle) saveTickRecord() {
+	if o.tickRecord == nil {
+		return
+	}
+
+	if err := o.history.Save(*o.tickRecord); err != nil {
+		o.logger.Err(err).Msg("failed to save tick record")
+	}
+	o.tickRecord = nil
+
+	pending := o.pendingTxs[:0]
+	for _, ptx := range o.pendingTxs {
+		height, err := o.oracleClient.QueryTxHeight(ptx.hash)
+		if err != nil {
+			ptx.attempts++
+			if ptx.attempts < maxTxQueryAttempts {
+				pending = append(pending, ptx)
+				continue
+			}
+			o.logger.Warn().Str("tx_hash", ptx.hash).Msg("broadcast tx not included")
+		}
+
+		err = o.history.Update(ptx.tickTime, func(t *history.Tick) {
+			tx := t.Prevote
+			if ptx.vote {
+				tx = t.Vote
+			}
+			if height > 0 {
+				tx.Height = height
+			} else {
+				tx.Error = "transaction not included"
+			}
+		})
+		if err != nil {
+			o.logger.Err(err).Msg("failed to update tick record")
+		}
+	}
+	o.pendingTxs = pending
+}
+
 // GenerateSalt generates a random salt, size length/2,  as a HEX encoded string.
 func GenerateSalt(length int) (string, error) {
 	if length == 0 {
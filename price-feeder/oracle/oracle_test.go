@@ -75,7 +75,7 @@ type OracleTestSuite struct {
 
 // SetupSuite executes once before the suite's tests are executed.
 func (ots *OracleTestSuite) SetupSuite() {
-	ots.oracle = New(
+	oracle, err := New(
 		zerolog.Nop(),
 		client.OracleClient{},
 		[]config.CurrencyPair{
@@ -108,7 +108,10 @@ func (ots *OracleTestSuite) SetupSuite() {
 		time.Millisecond*100,
 		make(map[string]sdk.Dec),
 		make(map[provider.Name]provider.Endpoint),
+		Options{},
 	)
+	ots.Require().NoError(err)
+	ots.oracle = oracle
 }
 
 func TestServiceTestSuite(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	uibctypes "github.com/umee-network/umee/v3/x/ibctransfer/types"
 	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
 )
 
@@ -63,3 +64,19 @@ func TestParamCacheIsOutdated(t *testing.T) {
 		})
 	}
 }
+
+func TestMismatchedAssets(t *testing.T) {
+	atom := oracletypes.Denom{BaseDenom: "ibc/ATOM", SymbolDenom: "ATOM", Exponent: 6}
+	osmo := oracletypes.Denom{BaseDenom: "ibc/OSMO", SymbolDenom: "OSMO", Exponent: 6}
+	umee := oracletypes.Denom{BaseDenom: "uumee", SymbolDenom: "UMEE", Exponent: 6}
+	assets := map[string]uibctypes.Asset{
+		"ibc/ATOM": {IbcDenom: "ibc/ATOM", Symbol: "atom", Exponent: 6},
+		"ibc/OSMO": {IbcDenom: "ibc/OSMO", Symbol: "OSMO", Exponent: 18},
+	}
+
+	require.Equal(t,
+		oracletypes.DenomList{osmo},
+		mismatchedAssets(oracletypes.DenomList{atom, osmo, umee}, assets),
+	)
+	require.Empty(t, mismatchedAssets(oracletypes.DenomList{atom, osmo, umee}, nil))
+}
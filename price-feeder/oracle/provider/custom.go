@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+var _ Provider = (*CustomProvider)(nil)
+
+type (
+	// CustomProviderConfig defines a provider configured from the price-feeder
+	// config file. It polls a REST endpoint, or reads a local file, returning
+	// JSON and extracts the price and volume of every currency pair with
+	// JSONPath selectors.
+	CustomProviderConfig struct {
+		// Name of the provider, used in the currency pair providers
+		Name Name `mapstructure:"name" validate:"required"`
+
+		// URL of the JSON document, ex. "https://api.example.com/tickers" or
+		// "file:///var/lib/prices.json"
+		URL string `mapstructure:"url"`
+
+		// Pairs defines the selectors of the supported currency pairs
+		Pairs []CustomPairConfig `mapstructure:"pairs" validate:"required,gt=0,dive"`
+	}
+
+	// CustomPairConfig defines where the price and volume of a currency pair
+	// are found in the JSON document of a custom provider.
+	CustomPairConfig struct {
+		Base  string `mapstructure:"base" validate:"required"`
+		Quote string `mapstructure:"quote" validate:"required"`
+
+		// URL overrides the provider URL for this pair
+		URL string `mapstructure:"url"`
+
+		// Price and Volume are JSONPath selectors, ex. "$.data[0].last"
+		Price  string `mapstructure:"price" validate:"required"`
+		Volume string `mapstructure:"volume" validate:"required"`
+	}
+
+	// CustomProvider defines a generic JSON price provider configured with a
+	// CustomProviderConfig.
+	CustomProvider struct {
+		name   Name
+		client *http.Client
+		pairs  map[string]customPair
+	}
+
+	customPair struct {
+		url    string
+		price  jsonPath
+		volume jsonPath
+	}
+)
+
+// NewCustomProvider returns a CustomProvider for the given configuration. It
+// returns an error if any selector is invalid or a pair has no URL.
+func NewCustomProvider(cfg CustomProviderConfig) (*CustomProvider, error) {
+	p := &CustomProvider{
+		name:   cfg.Name,
+		client: newDefaultHTTPClient(),
+		pairs:  make(map[string]customPair, len(cfg.Pairs)),
+	}
+
+	for _, pc := range cfg.Pairs {
+		cp := types.CurrencyPair{Base: strings.ToUpper(pc.Base), Quote: strings.ToUpper(pc.Quote)}
+
+		pair := customPair{url: cfg.URL}
+		if pc.URL != "" {
+			pair.url = pc.URL
+		}
+		if pair.url == "" {
+			return nil, fmt.Errorf("%s: no url for %s", cfg.Name, cp)
+		}
+
+		var err error
+		if pair.price, err = parseJSONPath(pc.Price); err != nil {
+			return nil, fmt.Errorf("%s: invalid price selector for %s: %w", cfg.Name, cp, err)
+		}
+		if pair.volume, err = parseJSONPath(pc.Volume); err != nil {
+			return nil, fmt.Errorf("%s: invalid volume selector for %s: %w", cfg.Name, cp, err)
+		}
+
+		if _, ok := p.pairs[cp.String()]; ok {
+			return nil, fmt.Errorf("%s: duplicate pair %s", cfg.Name, cp)
+		}
+		p.pairs[cp.String()] = pair
+	}
+
+	return p, nil
+}
+
+// SubscribeCurrencyPairs performs a no-op since the custom provider polls its
+// URLs on every request.
+func (p *CustomProvider) SubscribeCurrencyPairs(...types.CurrencyPair) error {
+	return nil
+}
+
+// GetTickerPrices returns the ticker prices of the given pairs. Each URL is
+// fetched once per call, however many pairs it serves.
+func (p *CustomProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]types.TickerPrice, error) {
+	documents := make(map[string]interface{})
+	tickerPrices := make(map[string]types.TickerPrice, len(pairs))
+
+	for _, cp := range pairs {
+		ticker := strings.ToUpper(cp.String())
+		pair, ok := p.pairs[ticker]
+		if !ok {
+			return nil, fmt.Errorf(types.ErrMissingExchangeRate.Error(), ticker)
+		}
+
+		doc, ok := documents[pair.url]
+		if !ok {
+			var err error
+			if doc, err = p.fetch(pair.url); err != nil {
+				return nil, err
+			}
+			documents[pair.url] = doc
+		}
+
+		price, err := pair.price.decValue(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s price for %s: %w", p.name, ticker, err)
+		}
+		volume, err := pair.volume.decValue(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s volume for %s: %w", p.name, ticker, err)
+		}
+
+		tickerPrices[ticker] = types.TickerPrice{Price: price, Volume: volume}
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns a single candle built from the current ticker price
+// of each pair, since the custom provider has no price history.
+func (p *CustomProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]types.CandlePrice, error) {
+	prices, err := p.GetTickerPrices(pairs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return tickerCandles(prices), nil
+}
+
+// GetAvailablePairs returns the configured pairs.
+func (p *CustomProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	availablePairs := make(map[string]struct{}, len(p.pairs))
+	for pair := range p.pairs {
+		availablePairs[pair] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+// fetch reads and decodes the JSON document at rawURL, which is either an
+// http(s) URL or a local file path, optionally with the file scheme.
+func (p *CustomProvider) fetch(rawURL string) (interface{}, error) {
+	var body io.ReadCloser
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s url: %w", p.name, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		resp, err := p.client.Get(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make %s request: %w", p.name, err)
+		}
+		if err := checkHTTPStatus(resp); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		body = resp.Body
+
+	case "file", "":
+		path := rawURL
+		if u.Scheme == "file" {
+			path = u.Path
+		}
+		if body, err = os.Open(path); err != nil {
+			return nil, fmt.Errorf("failed to read %s file: %w", p.name, err)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported %s url scheme: %s", p.name, u.Scheme)
+	}
+	defer body.Close()
+
+	var doc interface{}
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode %s response: %w", p.name, err)
+	}
+
+	return doc, nil
+}
+
+// jsonPath defines a parsed JSONPath selector. It supports the subset of
+// JSONPath needed to address a single value: the root "$", child keys as
+// ".key" or "['key']", and array indexes as "[0]".
+type jsonPath []interface{}
+
+func parseJSONPath(selector string) (jsonPath, error) {
+	if !strings.HasPrefix(selector, "$") {
+		return nil, fmt.Errorf("selector must start with $: %s", selector)
+	}
+
+	var path jsonPath
+	rest := selector[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("empty key in selector: %s", selector)
+			}
+			path = append(path, key)
+			rest = rest[end+1:]
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated bracket in selector: %s", selector)
+			}
+			inner := rest[1:end]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				path = append(path, inner[1:len(inner)-1])
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid index %q in selector: %s", inner, selector)
+				}
+				path = append(path, index)
+			}
+			rest = rest[end+1:]
+
+		default:
+			return nil, fmt.Errorf("unexpected %q in selector: %s", rest[0], selector)
+		}
+	}
+
+	return path, nil
+}
+
+// decValue returns the decimal value the selector points to in the document.
+// The value may be a JSON number or a numeric string.
+func (path jsonPath) decValue(doc interface{}) (sdk.Dec, error) {
+	value := doc
+	for _, segment := range path {
+		switch s := segment.(type) {
+		case string:
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				return sdk.Dec{}, fmt.Errorf("key %s of a non-object", s)
+			}
+			if value, ok = obj[s]; !ok {
+				return sdk.Dec{}, fmt.Errorf("key %s not found", s)
+			}
+
+		case int:
+			arr, ok := value.([]interface{})
+			if !ok {
+				return sdk.Dec{}, fmt.Errorf("index %d of a non-array", s)
+			}
+			if s >= len(arr) {
+				return sdk.Dec{}, fmt.Errorf("index %d out of range", s)
+			}
+			value = arr[s]
+		}
+	}
+
+	var str string
+	switch v := value.(type) {
+	case json.Number:
+		str = v.String()
+	case string:
+		str = v
+	default:
+		return sdk.Dec{}, fmt.Errorf("value %v is not a number", value)
+	}
+
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		// fall back for exponent notation, which sdk.Dec does not parse
+		f, ferr := strconv.ParseFloat(str, 64)
+		if ferr != nil {
+			return sdk.Dec{}, err
+		}
+		return sdk.NewDecFromStr(strconv.FormatFloat(f, 'f', sdk.Precision, 64))
+	}
+
+	return dec, nil
+}
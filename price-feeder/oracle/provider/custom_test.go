@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+func TestCustomProvider_GetTickerPrices(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		require.Equal(t, "/tickers", req.URL.String())
+		resp := `{
+	"data": [
+		{"symbol": "UMEE_USDT", "last": "0.0051", "vol": 1827884.77},
+		{"symbol": "ATOM_USDT", "last": 12.34, "vol": "3.5e2"}
+	],
+	"index.price": {"BTC": 16000}
+}`
+		rw.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	p, err := NewCustomProvider(CustomProviderConfig{
+		Name: "venue",
+		URL:  server.URL + "/tickers",
+		Pairs: []CustomPairConfig{
+			{Base: "UMEE", Quote: "USDT", Price: "$.data[0].last", Volume: "$.data[0].vol"},
+			{Base: "ATOM", Quote: "USDT", Price: "$.data[1].last", Volume: "$.data[1].vol"},
+			{Base: "BTC", Quote: "USD", Price: "$['index.price'].BTC", Volume: "$['index.price'].BTC"},
+			{Base: "FOO", Quote: "USDT", Price: "$.data[2].last", Volume: "$.data[2].vol"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("valid_request_multi_ticker", func(t *testing.T) {
+		requests = 0
+		prices, err := p.GetTickerPrices(
+			types.CurrencyPair{Base: "UMEE", Quote: "USDT"},
+			types.CurrencyPair{Base: "ATOM", Quote: "USDT"},
+			types.CurrencyPair{Base: "BTC", Quote: "USD"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, requests, "the url is fetched once per call")
+		require.Len(t, prices, 3)
+		require.Equal(t, sdk.MustNewDecFromStr("0.0051"), prices["UMEEUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("1827884.77"), prices["UMEEUSDT"].Volume)
+		require.Equal(t, sdk.MustNewDecFromStr("12.34"), prices["ATOMUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("350"), prices["ATOMUSDT"].Volume)
+		require.Equal(t, sdk.MustNewDecFromStr("16000"), prices["BTCUSD"].Price)
+	})
+
+	t.Run("missing_value", func(t *testing.T) {
+		_, err := p.GetTickerPrices(types.CurrencyPair{Base: "FOO", Quote: "USDT"})
+		require.EqualError(t, err, "failed to read venue price for FOOUSDT: index 2 out of range")
+	})
+
+	t.Run("unconfigured_pair", func(t *testing.T) {
+		_, err := p.GetTickerPrices(types.CurrencyPair{Base: "BAR", Quote: "USDT"})
+		require.Error(t, err)
+	})
+
+	t.Run("candles", func(t *testing.T) {
+		candles, err := p.GetCandlePrices(types.CurrencyPair{Base: "UMEE", Quote: "USDT"})
+		require.NoError(t, err)
+		require.Len(t, candles["UMEEUSDT"], 1)
+		require.Equal(t, sdk.MustNewDecFromStr("0.0051"), candles["UMEEUSDT"][0].Price)
+	})
+
+	t.Run("available_pairs", func(t *testing.T) {
+		pairs, err := p.GetAvailablePairs()
+		require.NoError(t, err)
+		require.Len(t, pairs, 4)
+		require.Contains(t, pairs, "UMEEUSDT")
+	})
+}
+
+func TestCustomProvider_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"umee": {"usd": 0.005, "usd_24h_vol": 100}}`), 0o600))
+
+	for _, url := range []string{path, "file://" + path} {
+		p, err := NewCustomProvider(CustomProviderConfig{
+			Name: "file",
+			Pairs: []CustomPairConfig{
+				{Base: "UMEE", Quote: "USD", URL: url, Price: "$.umee.usd", Volume: "$.umee.usd_24h_vol"},
+			},
+		})
+		require.NoError(t, err)
+
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "UMEE", Quote: "USD"})
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("0.005"), prices["UMEEUSD"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("100"), prices["UMEEUSD"].Volume)
+	}
+}
+
+func TestNewCustomProvider_Invalid(t *testing.T) {
+	testCases := map[string]CustomProviderConfig{
+		"no_url": {
+			Name:  "venue",
+			Pairs: []CustomPairConfig{{Base: "UMEE", Quote: "USDT", Price: "$.p", Volume: "$.v"}},
+		},
+		"invalid_selector": {
+			Name:  "venue",
+			URL:   "http://localhost",
+			Pairs: []CustomPairConfig{{Base: "UMEE", Quote: "USDT", Price: "data.p", Volume: "$.v"}},
+		},
+		"invalid_index": {
+			Name:  "venue",
+			URL:   "http://localhost",
+			Pairs: []CustomPairConfig{{Base: "UMEE", Quote: "USDT", Price: "$.p", Volume: "$.v[x]"}},
+		},
+		"duplicate_pair": {
+			Name: "venue",
+			URL:  "http://localhost",
+			Pairs: []CustomPairConfig{
+				{Base: "UMEE", Quote: "USDT", Price: "$.p", Volume: "$.v"},
+				{Base: "umee", Quote: "usdt", Price: "$.p", Volume: "$.v"},
+			},
+		},
+	}
+
+	for name, cfg := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCustomProvider(cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	path, err := parseJSONPath(`$.data[0]["last price"].value`)
+	require.NoError(t, err)
+	require.Equal(t, jsonPath{"data", 0, "last price", "value"}, path)
+
+	for _, selector := range []string{"", "$.", "$[0", "$x", "$[-1]"} {
+		_, err := parseJSONPath(selector)
+		require.Error(t, err, selector)
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+const (
+	// DexChainOsmosis defines the Osmosis gamm and twap module queries, also
+	// served by the chains running the Osmosis DEX modules.
+	DexChainOsmosis = "osmosis"
+
+	defaultDexExponent = 6
+)
+
+var _ Provider = (*DexProvider)(nil)
+
+type (
+	// DexProviderConfig defines a provider reading pool prices
+	// directly from the gRPC endpoint of a Cosmos DEX chain, instead of a
+	// centralized HTTP indexer.
+	DexProviderConfig struct {
+		// Name of the provider, used in the currency pair providers
+		Name Name `mapstructure:"name" validate:"required"`
+
+		// Chain defines the DEX queries served by the endpoint, ex. "osmosis"
+		Chain string `mapstructure:"chain" validate:"required,oneof=osmosis"`
+
+		// GRPCEndpoint of a node of the DEX chain, ex. "grpc.osmosis.zone:9090"
+		GRPCEndpoint string `mapstructure:"grpc_endpoint" validate:"required"`
+
+		// TLS enables transport security on the gRPC connection
+		TLS bool `mapstructure:"tls"`
+
+		// TWAPWindow defines the duration of the TWAP used as price, ex. "5m".
+		// The pool spot price is used when it is not set.
+		TWAPWindow string `mapstructure:"twap_window"`
+
+		// Pairs defines the pools of the supported currency pairs
+		Pairs []DexPairConfig `mapstructure:"pairs" validate:"required,gt=0,dive"`
+	}
+
+	// DexPairConfig defines the pool and on-chain denoms of a currency pair.
+	// Exponents convert the on-chain amounts to the currency pair symbols and
+	// default to 6. Since pools report no traded volume, Volume defines the
+	// volume, in base symbol units, weighting the pool price against the other
+	// providers of the pair.
+	DexPairConfig struct {
+		Base          string `mapstructure:"base" validate:"required"`
+		Quote         string `mapstructure:"quote" validate:"required"`
+		PoolID        uint64 `mapstructure:"pool_id" validate:"required"`
+		BaseDenom     string `mapstructure:"base_denom" validate:"required"`
+		QuoteDenom    string `mapstructure:"quote_denom" validate:"required"`
+		BaseExponent  uint32 `mapstructure:"base_exponent" validate:"lte=18"`
+		QuoteExponent uint32 `mapstructure:"quote_exponent" validate:"lte=18"`
+		Volume        string `mapstructure:"volume" validate:"required"`
+	}
+
+	// DexProvider defines an Oracle provider querying the pools of a Cosmos
+	// DEX chain over gRPC. The price of a pair is the spot price, or the TWAP
+	// if a window is configured, of its pool and its volume is the configured
+	// one.
+	DexProvider struct {
+		name       Name
+		querier    dexQuerier
+		twapWindow time.Duration
+		pairs      map[string]DexPairConfig
+		volumes    map[string]sdk.Dec
+	}
+
+	// dexQuerier defines the queries a DEX chain must serve.
+	dexQuerier interface {
+		spotPrice(ctx context.Context, pair DexPairConfig) (sdk.Dec, error)
+		twap(ctx context.Context, pair DexPairConfig, start time.Time) (sdk.Dec, error)
+	}
+)
+
+// NewDexProvider returns a DexProvider connected to the configured gRPC
+// endpoint. The connection is closed when the context is done.
+func NewDexProvider(ctx context.Context, cfg DexProviderConfig) (*DexProvider, error) {
+	p := &DexProvider{
+		name:    cfg.Name,
+		pairs:   make(map[string]DexPairConfig, len(cfg.Pairs)),
+		volumes: make(map[string]sdk.Dec, len(cfg.Pairs)),
+	}
+
+	if cfg.TWAPWindow != "" {
+		window, err := time.ParseDuration(cfg.TWAPWindow)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("%s: invalid twap window: %s", cfg.Name, cfg.TWAPWindow)
+		}
+		p.twapWindow = window
+	}
+
+	for _, pc := range cfg.Pairs {
+		cp := types.CurrencyPair{Base: strings.ToUpper(pc.Base), Quote: strings.ToUpper(pc.Quote)}
+		if pc.BaseExponent == 0 {
+			pc.BaseExponent = defaultDexExponent
+		}
+		if pc.QuoteExponent == 0 {
+			pc.QuoteExponent = defaultDexExponent
+		}
+
+		if _, ok := p.pairs[cp.String()]; ok {
+			return nil, fmt.Errorf("%s: duplicate pair %s", cfg.Name, cp)
+		}
+		volume, err := sdk.NewDecFromStr(pc.Volume)
+		if err != nil || !volume.IsPositive() {
+			return nil, fmt.Errorf("%s: invalid volume of %s: %s", cfg.Name, cp, pc.Volume)
+		}
+		p.pairs[cp.String()] = pc
+		p.volumes[cp.String()] = volume
+	}
+
+	creds := insecure.NewCredentials()
+	if cfg.TLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.Dial(cfg.GRPCEndpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s gRPC service: %w", cfg.Name, err)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	switch cfg.Chain {
+	case DexChainOsmosis:
+		p.querier = osmosisQuerier{conn: conn}
+	default:
+		conn.Close()
+		return nil, fmt.Errorf("%s: unsupported dex chain: %s", cfg.Name, cfg.Chain)
+	}
+
+	return p, nil
+}
+
+// SubscribeCurrencyPairs performs a no-op since the dex provider queries its
+// pools on every request.
+func (p *DexProvider) SubscribeCurrencyPairs(...types.CurrencyPair) error {
+	return nil
+}
+
+// GetTickerPrices returns the ticker prices of the given pairs from their
+// pools.
+func (p *DexProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]types.TickerPrice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	tickerPrices := make(map[string]types.TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		ticker := strings.ToUpper(cp.String())
+		pair, ok := p.pairs[ticker]
+		if !ok {
+			return nil, fmt.Errorf(types.ErrMissingExchangeRate.Error(), ticker)
+		}
+
+		var (
+			price sdk.Dec
+			err   error
+		)
+		if p.twapWindow > 0 {
+			price, err = p.querier.twap(ctx, pair, time.Now().Add(-p.twapWindow))
+		} else {
+			price, err = p.querier.spotPrice(ctx, pair)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s price of %s: %w", p.name, ticker, err)
+		}
+
+		tickerPrices[ticker] = types.TickerPrice{
+			Price:  price.Mul(exponentFactor(pair.BaseExponent, pair.QuoteExponent)),
+			Volume: p.volumes[ticker],
+		}
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns a single candle built from the current ticker price
+// of each pair, since pools expose no candles.
+func (p *DexProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]types.CandlePrice, error) {
+	prices, err := p.GetTickerPrices(pairs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return tickerCandles(prices), nil
+}
+
+// GetAvailablePairs returns the configured pairs.
+func (p *DexProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	availablePairs := make(map[string]struct{}, len(p.pairs))
+	for pair := range p.pairs {
+		availablePairs[pair] = struct{}{}
+	}
+
+	return availablePairs, nil
+}
+
+var ten = sdk.NewDec(10)
+
+// exponentFactor returns the factor converting a price of base in quote
+// on-chain units to a price in symbol units.
+func exponentFactor(baseExponent, quoteExponent uint32) sdk.Dec {
+	if baseExponent >= quoteExponent {
+		return ten.Power(uint64(baseExponent - quoteExponent))
+	}
+	return sdk.OneDec().Quo(ten.Power(uint64(quoteExponent - baseExponent)))
+}
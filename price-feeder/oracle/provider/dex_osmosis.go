@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Osmosis gRPC methods queried by the dex provider.
+//
+// REF: https://github.com/osmosis-labs/osmosis/tree/main/proto/osmosis
+const (
+	osmosisSpotPriceMethod = "/osmosis.gamm.v2.Query/SpotPrice"
+	osmosisTwapMethod      = "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow"
+)
+
+var _ dexQuerier = osmosisQuerier{}
+
+type (
+	// osmosisQuerier queries the gamm and twap modules of Osmosis. The
+	// messages are encoded by hand to avoid depending on the Osmosis module.
+	osmosisQuerier struct {
+		conn grpc.ClientConnInterface
+	}
+
+	// osmosisSpotPriceRequest defines the gamm v2 QuerySpotPriceRequest.
+	osmosisSpotPriceRequest struct {
+		PoolID     uint64
+		BaseDenom  string
+		QuoteDenom string
+	}
+
+	// osmosisSpotPriceResponse defines the gamm v2 QuerySpotPriceResponse.
+	osmosisSpotPriceResponse struct {
+		SpotPrice string
+	}
+
+	// osmosisTwapRequest defines the twap ArithmeticTwapToNowRequest.
+	osmosisTwapRequest struct {
+		PoolID     uint64
+		BaseDenom  string
+		QuoteDenom string
+		StartTime  time.Time
+	}
+
+	// osmosisTwapResponse defines the twap ArithmeticTwapToNowResponse.
+	osmosisTwapResponse struct {
+		ArithmeticTwap sdk.Dec
+	}
+)
+
+func (q osmosisQuerier) spotPrice(ctx context.Context, pair DexPairConfig) (sdk.Dec, error) {
+	req := &osmosisSpotPriceRequest{
+		PoolID:     pair.PoolID,
+		BaseDenom:  pair.BaseDenom,
+		QuoteDenom: pair.QuoteDenom,
+	}
+	resp := &osmosisSpotPriceResponse{}
+	if err := q.conn.Invoke(ctx, osmosisSpotPriceMethod, req, resp, grpc.ForceCodec(dexCodec{})); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.NewDecFromStr(resp.SpotPrice)
+}
+
+func (q osmosisQuerier) twap(ctx context.Context, pair DexPairConfig, start time.Time) (sdk.Dec, error) {
+	req := &osmosisTwapRequest{
+		PoolID:     pair.PoolID,
+		BaseDenom:  pair.BaseDenom,
+		QuoteDenom: pair.QuoteDenom,
+		StartTime:  start,
+	}
+	resp := &osmosisTwapResponse{}
+	if err := q.conn.Invoke(ctx, osmosisTwapMethod, req, resp, grpc.ForceCodec(dexCodec{})); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return resp.ArithmeticTwap, nil
+}
+
+func (m *osmosisSpotPriceRequest) marshal() ([]byte, error) {
+	var b []byte
+	b = appendProtoVarint(b, 1, m.PoolID)
+	b = appendProtoString(b, 2, m.BaseDenom)
+	b = appendProtoString(b, 3, m.QuoteDenom)
+	return b, nil
+}
+
+func (m *osmosisSpotPriceRequest) unmarshal(b []byte) error {
+	fields, err := decodeProtoFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			m.PoolID = f.varint
+		case 2:
+			m.BaseDenom = string(f.bytes)
+		case 3:
+			m.QuoteDenom = string(f.bytes)
+		}
+	}
+	return nil
+}
+
+func (m *osmosisSpotPriceResponse) marshal() ([]byte, error) {
+	return appendProtoString(nil, 1, m.SpotPrice), nil
+}
+
+func (m *osmosisSpotPriceResponse) unmarshal(b []byte) error {
+	fields, err := decodeProtoFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.num == 1 {
+			m.SpotPrice = string(f.bytes)
+		}
+	}
+	return nil
+}
+
+func (m *osmosisTwapRequest) marshal() ([]byte, error) {
+	var timestamp []byte
+	timestamp = appendProtoVarint(timestamp, 1, uint64(m.StartTime.Unix()))
+	timestamp = appendProtoVarint(timestamp, 2, uint64(m.StartTime.Nanosecond()))
+
+	var b []byte
+	b = appendProtoVarint(b, 1, m.PoolID)
+	b = appendProtoString(b, 2, m.BaseDenom)
+	b = appendProtoString(b, 3, m.QuoteDenom)
+	b = appendProtoBytes(b, 4, timestamp)
+	return b, nil
+}
+
+func (m *osmosisTwapRequest) unmarshal(b []byte) error {
+	fields, err := decodeProtoFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			m.PoolID = f.varint
+		case 2:
+			m.BaseDenom = string(f.bytes)
+		case 3:
+			m.QuoteDenom = string(f.bytes)
+		case 4:
+			timestamp, err := decodeProtoFields(f.bytes)
+			if err != nil {
+				return err
+			}
+			var seconds, nanos uint64
+			for _, tf := range timestamp {
+				switch tf.num {
+				case 1:
+					seconds = tf.varint
+				case 2:
+					nanos = tf.varint
+				}
+			}
+			m.StartTime = time.Unix(int64(seconds), int64(nanos))
+		}
+	}
+	return nil
+}
+
+func (m *osmosisTwapResponse) marshal() ([]byte, error) {
+	// sdk.Dec fields are encoded as their integer representation
+	bz, err := m.ArithmeticTwap.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return appendProtoBytes(nil, 1, bz), nil
+}
+
+func (m *osmosisTwapResponse) unmarshal(b []byte) error {
+	fields, err := decodeProtoFields(b)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if f.num == 1 {
+			if err := m.ArithmeticTwap.Unmarshal(f.bytes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type (
+	// dexMessage defines a protobuf message encoded by hand.
+	dexMessage interface {
+		marshal() ([]byte, error)
+		unmarshal([]byte) error
+	}
+
+	// dexCodec defines a gRPC codec for dexMessage values.
+	dexCodec struct{}
+
+	// protoField defines a decoded protobuf field, either a varint or a
+	// length-delimited value.
+	protoField struct {
+		num    protowire.Number
+		varint uint64
+		bytes  []byte
+	}
+)
+
+func (dexCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(dexMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type: %T", v)
+	}
+	return msg.marshal()
+}
+
+func (dexCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(dexMessage)
+	if !ok {
+		return fmt.Errorf("unexpected message type: %T", v)
+	}
+	return msg.unmarshal(data)
+}
+
+func (dexCodec) Name() string {
+	return "proto"
+}
+
+func appendProtoVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendProtoString(b []byte, num protowire.Number, v string) []byte {
+	return appendProtoBytes(b, num, []byte(v))
+}
+
+func appendProtoBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// decodeProtoFields decodes the fields of a protobuf message, skipping the
+// fields that are neither varints nor length-delimited.
+func decodeProtoFields(b []byte) ([]protoField, error) {
+	var fields []protoField
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		field := protoField{num: num}
+		switch typ {
+		case protowire.VarintType:
+			field.varint, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			field.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
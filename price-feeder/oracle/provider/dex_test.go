@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+const (
+	osmoDenom = "uosmo"
+	atomDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	usdcDenom = "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858"
+)
+
+// osmosisStandIn serves the Osmosis queries used by the dex provider from
+// fixed pools.
+type osmosisStandIn struct {
+	spotPrices map[uint64]string
+	twaps      map[uint64]sdk.Dec
+	twapStarts []time.Time
+}
+
+func (s *osmosisStandIn) handle(_ interface{}, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+
+	switch method {
+	case osmosisSpotPriceMethod:
+		req := &osmosisSpotPriceRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		price, ok := s.spotPrices[req.PoolID]
+		if !ok {
+			return fmt.Errorf("pool %d not found", req.PoolID)
+		}
+		return stream.SendMsg(&osmosisSpotPriceResponse{SpotPrice: price})
+
+	case osmosisTwapMethod:
+		req := &osmosisTwapRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		s.twapStarts = append(s.twapStarts, req.StartTime)
+		return stream.SendMsg(&osmosisTwapResponse{ArithmeticTwap: s.twaps[req.PoolID]})
+
+	default:
+		return fmt.Errorf("unknown method %s", method)
+	}
+}
+
+func startOsmosisStandIn(t *testing.T, standIn *osmosisStandIn) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(dexCodec{}),
+		grpc.UnknownServiceHandler(standIn.handle),
+	)
+	go server.Serve(listener) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestDexProvider_GetTickerPrices(t *testing.T) {
+	standIn := &osmosisStandIn{
+		spotPrices: map[uint64]string{
+			1:   "0.073500000000000000",
+			678: "1.020000000000000000",
+		},
+		twaps: map[uint64]sdk.Dec{
+			1:   sdk.MustNewDecFromStr("0.0731"),
+			678: sdk.MustNewDecFromStr("1.01"),
+		},
+	}
+	endpoint := startOsmosisStandIn(t, standIn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := DexProviderConfig{
+		Name:         "osmosisgrpc",
+		Chain:        DexChainOsmosis,
+		GRPCEndpoint: endpoint,
+		Pairs: []DexPairConfig{
+			{Base: "OSMO", Quote: "ATOM", PoolID: 1, BaseDenom: osmoDenom, QuoteDenom: atomDenom, Volume: "500000"},
+			{Base: "OSMO", Quote: "USDC", PoolID: 678, BaseDenom: osmoDenom, QuoteDenom: usdcDenom, Volume: "2000000"},
+			{
+				Base: "WETH", Quote: "OSMO", PoolID: 2, BaseDenom: "weth-wei", QuoteDenom: osmoDenom,
+				BaseExponent: 18, Volume: "10",
+			},
+		},
+	}
+
+	t.Run("spot_price", func(t *testing.T) {
+		p, err := NewDexProvider(ctx, cfg)
+		require.NoError(t, err)
+
+		prices, err := p.GetTickerPrices(
+			types.CurrencyPair{Base: "OSMO", Quote: "ATOM"},
+			types.CurrencyPair{Base: "OSMO", Quote: "USDC"},
+		)
+		require.NoError(t, err)
+		require.Len(t, prices, 2)
+		require.Equal(t, sdk.MustNewDecFromStr("0.0735"), prices["OSMOATOM"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("500000"), prices["OSMOATOM"].Volume)
+		require.Equal(t, sdk.MustNewDecFromStr("1.02"), prices["OSMOUSDC"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2000000"), prices["OSMOUSDC"].Volume)
+	})
+
+	t.Run("twap", func(t *testing.T) {
+		twapCfg := cfg
+		twapCfg.TWAPWindow = "5m"
+		p, err := NewDexProvider(ctx, twapCfg)
+		require.NoError(t, err)
+
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "OSMO", Quote: "ATOM"})
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("0.0731"), prices["OSMOATOM"].Price)
+
+		require.Len(t, standIn.twapStarts, 1)
+		require.WithinDuration(t, time.Now().Add(-5*time.Minute), standIn.twapStarts[0], time.Minute)
+	})
+
+	t.Run("exponents", func(t *testing.T) {
+		standIn.spotPrices[2] = "0.000000001500000000"
+
+		p, err := NewDexProvider(ctx, cfg)
+		require.NoError(t, err)
+
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "WETH", Quote: "OSMO"})
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("1500"), prices["WETHOSMO"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("10"), prices["WETHOSMO"].Volume)
+	})
+
+	t.Run("unconfigured_pair", func(t *testing.T) {
+		p, err := NewDexProvider(ctx, cfg)
+		require.NoError(t, err)
+
+		_, err = p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USDC"})
+		require.Error(t, err)
+	})
+
+	t.Run("available_pairs", func(t *testing.T) {
+		p, err := NewDexProvider(ctx, cfg)
+		require.NoError(t, err)
+
+		pairs, err := p.GetAvailablePairs()
+		require.NoError(t, err)
+		require.Equal(t, map[string]struct{}{"OSMOATOM": {}, "OSMOUSDC": {}, "WETHOSMO": {}}, pairs)
+	})
+}
+
+func TestNewDexProvider_Invalid(t *testing.T) {
+	pair := DexPairConfig{Base: "OSMO", Quote: "ATOM", PoolID: 1, BaseDenom: osmoDenom, QuoteDenom: atomDenom, Volume: "1"}
+	zeroVolume := pair
+	zeroVolume.Volume = "0"
+
+	testCases := map[string]DexProviderConfig{
+		"unsupported_chain": {
+			Name:         "dex",
+			Chain:        "foo",
+			GRPCEndpoint: "localhost:9090",
+			Pairs:        []DexPairConfig{pair},
+		},
+		"invalid_twap_window": {
+			Name:         "dex",
+			Chain:        DexChainOsmosis,
+			GRPCEndpoint: "localhost:9090",
+			TWAPWindow:   "-5m",
+			Pairs:        []DexPairConfig{pair},
+		},
+		"duplicate_pair": {
+			Name:         "dex",
+			Chain:        DexChainOsmosis,
+			GRPCEndpoint: "localhost:9090",
+			Pairs:        []DexPairConfig{pair, pair},
+		},
+		"zero_volume": {
+			Name:         "dex",
+			Chain:        DexChainOsmosis,
+			GRPCEndpoint: "localhost:9090",
+			Pairs:        []DexPairConfig{zeroVolume},
+		},
+	}
+
+	for name, cfg := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDexProvider(context.Background(), cfg)
+			require.Error(t, err)
+		})
+	}
+}
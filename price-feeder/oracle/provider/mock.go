@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
@@ -102,21 +101,11 @@ func (p MockProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]t
 }
 
 func (p MockProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]types.CandlePrice, error) {
-	price, err := p.GetTickerPrices(pairs...)
+	prices, err := p.GetTickerPrices(pairs...)
 	if err != nil {
 		return nil, err
 	}
-	candles := make(map[string][]types.CandlePrice)
-	for pair, price := range price {
-		candles[pair] = []types.CandlePrice{
-			{
-				Price:     price.Price,
-				Volume:    price.Volume,
-				TimeStamp: PastUnixTime(1 * time.Minute),
-			},
-		}
-	}
-	return candles, nil
+	return tickerCandles(prices), nil
 }
 
 // GetAvailablePairs return all available pairs symbol to susbscribe.
@@ -101,6 +101,22 @@ func PastUnixTime(t time.Duration) int64 {
 	return time.Now().Add(t*-1).Unix() * int64(time.Second/time.Millisecond)
 }
 
+// tickerCandles returns a single candle, dated a minute ago, built from the
+// ticker price of each pair, for the providers without price history.
+func tickerCandles(prices map[string]types.TickerPrice) map[string][]types.CandlePrice {
+	candles := make(map[string][]types.CandlePrice, len(prices))
+	for pair, price := range prices {
+		candles[pair] = []types.CandlePrice{
+			{
+				Price:     price.Price,
+				Volume:    price.Volume,
+				TimeStamp: PastUnixTime(1 * time.Minute),
+			},
+		}
+	}
+	return candles
+}
+
 // SecondsToMilli converts seconds to milliseconds for our unix timestamps.
 func SecondsToMilli(t int64) int64 {
 	return t * int64(time.Second/time.Millisecond)
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+var _ Provider = (*RecordingProvider)(nil)
+
+const (
+	recordKindTickers = "tickers"
+	recordKindCandles = "candles"
+)
+
+type (
+	// RecordingProvider wraps a Provider and captures every ticker and candle
+	// response it returns, along with the time it was returned, so it can be
+	// served again by a ReplayProvider.
+	RecordingProvider struct {
+		provider Provider
+		mtx      sync.Mutex
+		encoder  *json.Encoder
+		closer   io.Closer
+		now      func() time.Time
+	}
+
+	// providerRecord defines a single provider response. Records are written
+	// as JSON lines, in the order the responses were returned.
+	providerRecord struct {
+		// unix timestamp in milliseconds of the response
+		Time int64 `json:"time"`
+		// kind of the call, either recordKindTickers or recordKindCandles
+		Kind    string                         `json:"kind"`
+		Tickers map[string]types.TickerPrice   `json:"tickers,omitempty"`
+		Candles map[string][]types.CandlePrice `json:"candles,omitempty"`
+		Error   string                         `json:"error,omitempty"`
+	}
+)
+
+// RecordingPath returns the path of the recording of a provider in the given
+// directory.
+func RecordingPath(dir string, providerName Name) string {
+	return filepath.Join(dir, providerName.String()+".jsonl")
+}
+
+// NewRecordingProvider returns a RecordingProvider which appends the responses
+// of the given provider to the file at path, creating it if needed. The file
+// is closed by Close.
+func NewRecordingProvider(provider Provider, path string) (*RecordingProvider, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open provider recording: %w", err)
+	}
+
+	return newRecordingProvider(provider, f), nil
+}
+
+func newRecordingProvider(provider Provider, w io.Writer) *RecordingProvider {
+	p := &RecordingProvider{
+		provider: provider,
+		encoder:  json.NewEncoder(w),
+		now:      time.Now,
+	}
+	if closer, ok := w.(io.Closer); ok {
+		p.closer = closer
+	}
+
+	return p
+}
+
+// GetTickerPrices returns and records the ticker prices of the wrapped
+// provider.
+func (p *RecordingProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]types.TickerPrice, error) {
+	tickers, err := p.provider.GetTickerPrices(pairs...)
+	p.record(providerRecord{Kind: recordKindTickers, Tickers: tickers}, err)
+	return tickers, err
+}
+
+// GetCandlePrices returns and records the candle prices of the wrapped
+// provider.
+func (p *RecordingProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]types.CandlePrice, error) {
+	candles, err := p.provider.GetCandlePrices(pairs...)
+	p.record(providerRecord{Kind: recordKindCandles, Candles: candles}, err)
+	return candles, err
+}
+
+// GetAvailablePairs returns the available pairs of the wrapped provider.
+func (p *RecordingProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	return p.provider.GetAvailablePairs()
+}
+
+// SubscribeCurrencyPairs subscribes the wrapped provider to the currency pairs.
+func (p *RecordingProvider) SubscribeCurrencyPairs(pairs ...types.CurrencyPair) error {
+	return p.provider.SubscribeCurrencyPairs(pairs...)
+}
+
+// Close closes the recording. The responses returned afterwards are no longer
+// recorded.
+func (p *RecordingProvider) Close() error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.closer == nil {
+		return nil
+	}
+	err := p.closer.Close()
+	p.closer = nil
+	p.encoder = nil
+	return err
+}
+
+// record writes a response to the recording. A failure to record never fails
+// the response itself.
+func (p *RecordingProvider) record(r providerRecord, err error) {
+	if err != nil {
+		r = providerRecord{Kind: r.Kind, Error: err.Error()}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.encoder == nil {
+		return
+	}
+	r.Time = p.now().UnixMilli()
+	_ = p.encoder.Encode(r)
+}
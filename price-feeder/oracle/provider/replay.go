@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+var _ Provider = (*ReplayProvider)(nil)
+
+// ReplayProvider defines a provider serving the responses captured by a
+// RecordingProvider. The recording is replayed from the moment the provider
+// is created, at the original pace or accelerated by the given speed. Each
+// call returns the latest recorded response at the current replay time, and
+// the last one once the recording is over.
+type ReplayProvider struct {
+	tickerRecords []providerRecord
+	candleRecords []providerRecord
+	speed         float64
+	start         time.Time
+	recordStart   int64
+	now           func() time.Time
+}
+
+// NewReplayProvider returns a ReplayProvider serving the recording at path.
+// A speed of 1 replays the recording at its original pace, a speed of 10 ten
+// times faster.
+func NewReplayProvider(path string, speed float64) (*ReplayProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open provider recording: %w", err)
+	}
+	defer f.Close()
+
+	return newReplayProvider(f, speed, time.Now)
+}
+
+func newReplayProvider(r io.Reader, speed float64, now func() time.Time) (*ReplayProvider, error) {
+	if speed <= 0 {
+		return nil, fmt.Errorf("invalid replay speed: %v", speed)
+	}
+
+	p := &ReplayProvider{
+		speed: speed,
+		start: now(),
+		now:   now,
+	}
+
+	scanner := bufio.NewScanner(r)
+	// candle responses can be much larger than the default 64KiB token size
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var record providerRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("failed to decode provider recording: %w", err)
+		}
+
+		switch record.Kind {
+		case recordKindTickers:
+			p.tickerRecords = append(p.tickerRecords, record)
+		case recordKindCandles:
+			p.candleRecords = append(p.candleRecords, record)
+		default:
+			return nil, fmt.Errorf("invalid provider record kind: %q", record.Kind)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read provider recording: %w", err)
+	}
+	if len(p.tickerRecords) == 0 && len(p.candleRecords) == 0 {
+		return nil, errors.New("empty provider recording")
+	}
+
+	p.recordStart = p.firstRecordTime()
+	return p, nil
+}
+
+// SubscribeCurrencyPairs performs a no-op since the replay serves a fixed
+// recording.
+func (p *ReplayProvider) SubscribeCurrencyPairs(...types.CurrencyPair) error {
+	return nil
+}
+
+// GetTickerPrices returns the ticker prices recorded at the current replay
+// time.
+func (p *ReplayProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]types.TickerPrice, error) {
+	record, err := p.currentRecord(p.tickerRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerPrices := make(map[string]types.TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		ticker, ok := record.Tickers[cp.String()]
+		if !ok {
+			return nil, fmt.Errorf(types.ErrMissingExchangeRate.Error(), cp.String())
+		}
+		tickerPrices[cp.String()] = ticker
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the candle prices recorded at the current replay
+// time. Candle timestamps are shifted by the time elapsed since they were
+// recorded, so they are as recent as they were in the original run.
+func (p *ReplayProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]types.CandlePrice, error) {
+	record, err := p.currentRecord(p.candleRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	shift := p.now().UnixMilli() - p.replayTime()
+	candlePrices := make(map[string][]types.CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		candles, ok := record.Candles[cp.String()]
+		if !ok {
+			return nil, fmt.Errorf(types.ErrMissingExchangeRate.Error(), cp.String())
+		}
+
+		shifted := make([]types.CandlePrice, len(candles))
+		for i, candle := range candles {
+			shifted[i] = candle
+			shifted[i].TimeStamp = candle.TimeStamp + shift
+		}
+		candlePrices[cp.String()] = shifted
+	}
+
+	return candlePrices, nil
+}
+
+// GetAvailablePairs returns all the pairs found in the recording.
+func (p *ReplayProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	availablePairs := make(map[string]struct{})
+	for _, record := range p.tickerRecords {
+		for pair := range record.Tickers {
+			availablePairs[pair] = struct{}{}
+		}
+	}
+	for _, record := range p.candleRecords {
+		for pair := range record.Candles {
+			availablePairs[pair] = struct{}{}
+		}
+	}
+
+	return availablePairs, nil
+}
+
+// replayTime returns the unix timestamp in milliseconds of the recording which
+// corresponds to the current time.
+func (p *ReplayProvider) replayTime() int64 {
+	elapsed := float64(p.now().Sub(p.start).Milliseconds()) * p.speed
+	return p.recordStart + int64(elapsed)
+}
+
+// currentRecord returns the latest of the records at the current replay time,
+// or the first one when the replay time precedes it.
+func (p *ReplayProvider) currentRecord(records []providerRecord) (providerRecord, error) {
+	if len(records) == 0 {
+		return providerRecord{}, errors.New("no recorded response")
+	}
+
+	replayTime := p.replayTime()
+	i := sort.Search(len(records), func(i int) bool {
+		return records[i].Time > replayTime
+	})
+	if i > 0 {
+		i--
+	}
+
+	record := records[i]
+	if record.Error != "" {
+		return providerRecord{}, errors.New(record.Error)
+	}
+
+	return record, nil
+}
+
+func (p *ReplayProvider) firstRecordTime() int64 {
+	switch {
+	case len(p.tickerRecords) == 0:
+		return p.candleRecords[0].Time
+	case len(p.candleRecords) == 0:
+		return p.tickerRecords[0].Time
+	case p.candleRecords[0].Time < p.tickerRecords[0].Time:
+		return p.candleRecords[0].Time
+	default:
+		return p.tickerRecords[0].Time
+	}
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+// scriptedProvider returns the next of its ticker prices on every call, or an
+// error once they are exhausted.
+type scriptedProvider struct {
+	prices     []sdk.Dec
+	calls      int
+	candlesErr error
+}
+
+func (p *scriptedProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]types.TickerPrice, error) {
+	if p.calls >= len(p.prices) {
+		return nil, fmt.Errorf("provider is down")
+	}
+	price := p.prices[p.calls]
+	p.calls++
+
+	tickers := make(map[string]types.TickerPrice, len(pairs))
+	for _, cp := range pairs {
+		tickers[cp.String()] = types.TickerPrice{Price: price, Volume: sdk.OneDec()}
+	}
+	return tickers, nil
+}
+
+func (p *scriptedProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]types.CandlePrice, error) {
+	if p.candlesErr != nil {
+		return nil, p.candlesErr
+	}
+	candles := make(map[string][]types.CandlePrice, len(pairs))
+	for _, cp := range pairs {
+		candles[cp.String()] = []types.CandlePrice{
+			{Price: sdk.OneDec(), Volume: sdk.OneDec(), TimeStamp: 1000},
+		}
+	}
+	return candles, nil
+}
+
+func (p *scriptedProvider) GetAvailablePairs() (map[string]struct{}, error) {
+	return map[string]struct{}{}, nil
+}
+
+func (p *scriptedProvider) SubscribeCurrencyPairs(...types.CurrencyPair) error {
+	return nil
+}
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func TestReplayProvider(t *testing.T) {
+	atomUSDT := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	umeeUSDT := types.CurrencyPair{Base: "UMEE", Quote: "USDT"}
+
+	// record three ticker responses 10 seconds apart, a candle response, and
+	// a failure
+	var buf bytes.Buffer
+	recordClock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := newRecordingProvider(&scriptedProvider{
+		prices: []sdk.Dec{sdk.NewDec(10), sdk.NewDec(11), sdk.NewDec(12)},
+	}, &buf)
+	recorder.now = recordClock.now
+
+	for i := 0; i < 3; i++ {
+		_, err := recorder.GetTickerPrices(atomUSDT, umeeUSDT)
+		require.NoError(t, err)
+		recordClock.advance(10 * time.Second)
+	}
+	_, err := recorder.GetCandlePrices(atomUSDT)
+	require.NoError(t, err)
+	recordClock.advance(10 * time.Second)
+	_, err = recorder.GetTickerPrices(atomUSDT)
+	require.EqualError(t, err, "provider is down")
+
+	recording := buf.Bytes()
+
+	t.Run("original_speed", func(t *testing.T) {
+		clock := &fakeClock{t: time.Unix(5000, 0)}
+		rp, err := newReplayProvider(bytes.NewReader(recording), 1, clock.now)
+		require.NoError(t, err)
+
+		prices, err := rp.GetTickerPrices(atomUSDT, umeeUSDT)
+		require.NoError(t, err)
+		require.Len(t, prices, 2)
+		require.Equal(t, sdk.NewDec(10), prices["ATOMUSDT"].Price)
+		require.Equal(t, sdk.NewDec(10), prices["UMEEUSDT"].Price)
+
+		clock.advance(9 * time.Second)
+		prices, err = rp.GetTickerPrices(atomUSDT)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(10), prices["ATOMUSDT"].Price)
+
+		clock.advance(1 * time.Second)
+		prices, err = rp.GetTickerPrices(atomUSDT)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(11), prices["ATOMUSDT"].Price)
+
+		// the candle was recorded 30 seconds after the recording started, so
+		// its timestamp is shifted by the same offset as the replay
+		clock.advance(20 * time.Second)
+		candles, err := rp.GetCandlePrices(atomUSDT)
+		require.NoError(t, err)
+		require.Len(t, candles["ATOMUSDT"], 1)
+		require.Equal(t, int64(1000+(5000-1000)*1000), candles["ATOMUSDT"][0].TimeStamp)
+
+		// failures are replayed, and so is the last response after the end
+		clock.advance(10 * time.Second)
+		_, err = rp.GetTickerPrices(atomUSDT)
+		require.EqualError(t, err, "provider is down")
+		clock.advance(time.Hour)
+		_, err = rp.GetTickerPrices(atomUSDT)
+		require.EqualError(t, err, "provider is down")
+	})
+
+	t.Run("accelerated", func(t *testing.T) {
+		clock := &fakeClock{t: time.Unix(5000, 0)}
+		rp, err := newReplayProvider(bytes.NewReader(recording), 10, clock.now)
+		require.NoError(t, err)
+
+		clock.advance(2 * time.Second)
+		prices, err := rp.GetTickerPrices(atomUSDT)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(12), prices["ATOMUSDT"].Price)
+	})
+
+	t.Run("missing_pair", func(t *testing.T) {
+		clock := &fakeClock{t: time.Unix(5000, 0)}
+		rp, err := newReplayProvider(bytes.NewReader(recording), 1, clock.now)
+		require.NoError(t, err)
+
+		_, err = rp.GetTickerPrices(types.CurrencyPair{Base: "FOO", Quote: "USDT"})
+		require.Error(t, err)
+	})
+
+	t.Run("available_pairs", func(t *testing.T) {
+		rp, err := newReplayProvider(bytes.NewReader(recording), 1, time.Now)
+		require.NoError(t, err)
+
+		pairs, err := rp.GetAvailablePairs()
+		require.NoError(t, err)
+		require.Equal(t, map[string]struct{}{"ATOMUSDT": {}, "UMEEUSDT": {}}, pairs)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := newReplayProvider(bytes.NewReader(recording), 0, time.Now)
+		require.Error(t, err)
+		_, err = newReplayProvider(bytes.NewReader(nil), 1, time.Now)
+		require.Error(t, err)
+		_, err = newReplayProvider(bytes.NewReader([]byte("{")), 1, time.Now)
+		require.Error(t, err)
+	})
+}
+
+func TestRecordingProvider_File(t *testing.T) {
+	path := RecordingPath(t.TempDir(), ProviderMock)
+	require.Equal(t, "mock.jsonl", filepath.Base(path))
+
+	recorder, err := NewRecordingProvider(&scriptedProvider{prices: []sdk.Dec{sdk.NewDec(3)}}, path)
+	require.NoError(t, err)
+	_, err = recorder.GetTickerPrices(types.CurrencyPair{Base: "UMEE", Quote: "USDT"})
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+
+	rp, err := NewReplayProvider(path, 1)
+	require.NoError(t, err)
+	prices, err := rp.GetTickerPrices(types.CurrencyPair{Base: "UMEE", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), prices["UMEEUSDT"].Price)
+}
+
+func TestReplayProvider_CallKinds(t *testing.T) {
+	atomUSDT := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+
+	// record a ticker response, a candle failure, and an empty ticker
+	// response
+	var buf bytes.Buffer
+	recordClock := &fakeClock{t: time.Unix(1000, 0)}
+	scripted := &scriptedProvider{
+		prices:     []sdk.Dec{sdk.NewDec(10)},
+		candlesErr: fmt.Errorf("candles are down"),
+	}
+	recorder := newRecordingProvider(scripted, &buf)
+	recorder.now = recordClock.now
+
+	_, err := recorder.GetTickerPrices(atomUSDT)
+	require.NoError(t, err)
+	recordClock.advance(10 * time.Second)
+	_, err = recorder.GetCandlePrices(atomUSDT)
+	require.EqualError(t, err, "candles are down")
+	recordClock.advance(10 * time.Second)
+	scripted.prices = append(scripted.prices, sdk.NewDec(11))
+	_, err = recorder.GetTickerPrices()
+	require.NoError(t, err)
+
+	clock := &fakeClock{t: time.Unix(5000, 0)}
+	rp, err := newReplayProvider(bytes.NewReader(buf.Bytes()), 1, clock.now)
+	require.NoError(t, err)
+
+	// the candle failure is only replayed for candles
+	clock.advance(10 * time.Second)
+	prices, err := rp.GetTickerPrices(atomUSDT)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), prices["ATOMUSDT"].Price)
+	_, err = rp.GetCandlePrices(atomUSDT)
+	require.EqualError(t, err, "candles are down")
+
+	// the empty ticker response is replayed as an empty result
+	clock.advance(10 * time.Second)
+	prices, err = rp.GetTickerPrices()
+	require.NoError(t, err)
+	require.Empty(t, prices)
+
+	_, err = newReplayProvider(bytes.NewReader([]byte(`{"time":1}`)), 1, time.Now)
+	require.ErrorContains(t, err, "invalid provider record kind")
+}
+
+func TestRecordingProvider_Close(t *testing.T) {
+	path := RecordingPath(t.TempDir(), ProviderMock)
+	recorder, err := NewRecordingProvider(&scriptedProvider{prices: []sdk.Dec{sdk.NewDec(3), sdk.NewDec(4)}}, path)
+	require.NoError(t, err)
+
+	_, err = recorder.GetTickerPrices(types.CurrencyPair{Base: "UMEE", Quote: "USDT"})
+	require.NoError(t, err)
+	require.NoError(t, recorder.Close())
+	require.NoError(t, recorder.Close())
+
+	// responses are still returned, but no longer recorded, once closed
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	_, err = recorder.GetTickerPrices(types.CurrencyPair{Base: "UMEE", Quote: "USDT"})
+	require.NoError(t, err)
+	after, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, bz, after)
+}
@@ -0,0 +1,190 @@
+package oracle
+
+import (
+	"io"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+// reload defines the configuration to apply to the oracle on its next tick.
+type reload struct {
+	providerPairs map[provider.Name][]types.CurrencyPair
+	deviations    map[string]sdk.Dec
+	endpoints     map[provider.Name]provider.Endpoint
+}
+
+// providerPairsOf returns the currency pairs of every provider.
+func providerPairsOf(currencyPairs []config.CurrencyPair) map[provider.Name][]types.CurrencyPair {
+	providerPairs := make(map[provider.Name][]types.CurrencyPair)
+
+	for _, pair := range currencyPairs {
+		for _, provider := range pair.Providers {
+			providerPairs[provider] = append(providerPairs[provider], types.CurrencyPair{
+				Base:  pair.Base,
+				Quote: pair.Quote,
+			})
+		}
+	}
+
+	return providerPairs
+}
+
+// Reload replaces the currency pairs, deviation thresholds and provider
+// endpoints of the oracle. It is safe to call while the oracle is running: the
+// configuration is applied at the start of the next tick, leaving the
+// in-flight prevote and vote state intact.
+func (o *Oracle) Reload(
+	currencyPairs []config.CurrencyPair,
+	deviations map[string]sdk.Dec,
+	endpoints map[provider.Name]provider.Endpoint,
+) {
+	o.reloadMutex.Lock()
+	defer o.reloadMutex.Unlock()
+
+	o.pendingReload = &reload{
+		providerPairs: providerPairsOf(currencyPairs),
+		deviations:    deviations,
+		endpoints:     endpoints,
+	}
+}
+
+// applyReload applies the pending reload, if any. The providers whose endpoint
+// changed are closed, to be created again if needed.
+func (o *Oracle) applyReload() {
+	o.reloadMutex.Lock()
+	r := o.pendingReload
+	o.pendingReload = nil
+	o.reloadMutex.Unlock()
+
+	if r == nil {
+		return
+	}
+
+	for providerName := range o.priceProviders {
+		if o.endpoints[providerName] != r.endpoints[providerName] {
+			o.closeProvider(providerName)
+		}
+	}
+
+	o.configuredPairs = r.providerPairs
+	o.deviations = r.deviations
+	o.endpoints = r.endpoints
+	o.setProviderPairs(mergeProviderPairs(o.configuredPairs, o.discoveredPairs))
+
+	o.logger.Info().Msg("reloaded configuration")
+}
+
+// setProviderPairs replaces the currency pairs of every provider. The new
+// pairs of running providers are subscribed to, while the providers that are
+// no longer used are closed. Providers can't unsubscribe from a pair, so the
+// running providers losing pairs are closed as well, to be created again with
+// their remaining pairs only.
+func (o *Oracle) setProviderPairs(providerPairs map[provider.Name][]types.CurrencyPair) {
+	for providerName, priceProvider := range o.priceProviders {
+		pairs, ok := providerPairs[providerName]
+		if !ok {
+			o.closeProvider(providerName)
+			continue
+		}
+
+		added, removed := diffPairs(o.providerPairs[providerName], pairs)
+		if len(removed) > 0 {
+			o.logger.Info().
+				Str("provider", string(providerName)).
+				Interface("removed_pairs", removed).
+				Msg("currency pairs removed; restarting provider")
+			o.closeProvider(providerName)
+			continue
+		}
+		if len(added) > 0 {
+			if err := priceProvider.SubscribeCurrencyPairs(added...); err != nil {
+				o.logger.Err(err).
+					Str("provider", string(providerName)).
+					Msg("failed to subscribe to new currency pairs; restarting provider")
+				o.closeProvider(providerName)
+				continue
+			}
+			o.logger.Info().
+				Str("provider", string(providerName)).
+				Interface("added_pairs", added).
+				Msg("updated provider currency pairs")
+		}
+	}
+
+	o.providerPairs = providerPairs
+}
+
+// mergeProviderPairs returns the union of the currency pairs of every provider.
+func mergeProviderPairs(a, b map[provider.Name][]types.CurrencyPair) map[provider.Name][]types.CurrencyPair {
+	merged := make(map[provider.Name][]types.CurrencyPair, len(a)+len(b))
+	seen := make(map[provider.Name]map[string]struct{}, len(a)+len(b))
+	for _, providerPairs := range []map[provider.Name][]types.CurrencyPair{a, b} {
+		for providerName, pairs := range providerPairs {
+			if _, ok := seen[providerName]; !ok {
+				seen[providerName] = make(map[string]struct{}, len(pairs))
+			}
+			for _, pair := range pairs {
+				if _, ok := seen[providerName][pair.String()]; !ok {
+					seen[providerName][pair.String()] = struct{}{}
+					merged[providerName] = append(merged[providerName], pair)
+				}
+			}
+		}
+	}
+
+	return merged
+}
+
+// closeProvider closes the provider and removes it from the running providers.
+// Providers holding resources besides their context, such as a recording file,
+// are closed as well.
+func (o *Oracle) closeProvider(providerName provider.Name) {
+	if cancel, ok := o.providerCancels[providerName]; ok {
+		cancel()
+		delete(o.providerCancels, providerName)
+	}
+	if closer, ok := o.priceProviders[providerName].(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			o.logger.Err(err).Str("provider", string(providerName)).Msg("failed to close provider")
+		}
+	}
+	delete(o.priceProviders, providerName)
+
+	o.logger.Info().Str("provider", string(providerName)).Msg("closed provider")
+}
+
+// closeProviders closes all the running providers.
+func (o *Oracle) closeProviders() {
+	for providerName := range o.priceProviders {
+		o.closeProvider(providerName)
+	}
+}
+
+// diffPairs returns the pairs added to and removed from the old pairs, sorted.
+func diffPairs(oldPairs, newPairs []types.CurrencyPair) (added, removed []types.CurrencyPair) {
+	oldSet := make(map[string]struct{}, len(oldPairs))
+	for _, pair := range oldPairs {
+		oldSet[pair.String()] = struct{}{}
+	}
+	newSet := make(map[string]struct{}, len(newPairs))
+	for _, pair := range newPairs {
+		newSet[pair.String()] = struct{}{}
+		if _, ok := oldSet[pair.String()]; !ok {
+			added = append(added, pair)
+		}
+	}
+	for _, pair := range oldPairs {
+		if _, ok := newSet[pair.String()]; !ok {
+			removed = append(removed, pair)
+		}
+	}
+
+	sort.Slice(added, func(i, j int) bool { return added[i].String() < added[j].String() })
+	sort.Slice(removed, func(i, j int) bool { return removed[i].String() < removed[j].String() })
+	return added, removed
+}
@@ -0,0 +1,206 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/v2/config"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/client"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/types"
+)
+
+// subscribingProvider defines a mock provider recording its subscriptions.
+type subscribingProvider struct {
+	mockProvider
+	subscribed []types.CurrencyPair
+}
+
+func (p *subscribingProvider) SubscribeCurrencyPairs(pairs ...types.CurrencyPair) error {
+	p.subscribed = append(p.subscribed, pairs...)
+	return nil
+}
+
+func TestOracle_Reload(t *testing.T) {
+	binanceEndpoint := provider.Endpoint{Name: provider.ProviderBinance, Rest: "https://binance", Websocket: "binance"}
+	o, err := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{Base: "UMEE", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance, provider.ProviderKraken}},
+			{Base: "ATOM", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance, provider.ProviderOkx}},
+			{Base: "USDT", Quote: "USD", Providers: []provider.Name{provider.ProviderCoinbase}},
+		},
+		time.Millisecond*100,
+		map[string]sdk.Dec{"UMEE": sdk.MustNewDecFromStr("1.5")},
+		map[provider.Name]provider.Endpoint{provider.ProviderBinance: binanceEndpoint},
+		Options{},
+	)
+	require.NoError(t, err)
+
+	binance, kraken := &subscribingProvider{}, &subscribingProvider{}
+	okx, coinbase := &subscribingProvider{}, &subscribingProvider{}
+	o.priceProviders = map[provider.Name]provider.Provider{
+		provider.ProviderBinance:  binance,
+		provider.ProviderKraken:   kraken,
+		provider.ProviderOkx:      okx,
+		provider.ProviderCoinbase: coinbase,
+	}
+	okxCtx, cancelOkx := context.WithCancel(context.Background())
+	o.providerCancels[provider.ProviderOkx] = cancelOkx
+
+	prevote := &PreviousPrevote{ExchangeRates: "UMEE:1.0", Salt: "salt", SubmitBlockHeight: 10}
+	o.previousPrevote = prevote
+	o.previousVotePeriod = 2
+
+	o.Reload(
+		[]config.CurrencyPair{
+			{Base: "UMEE", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance, provider.ProviderKraken}},
+			{Base: "OSMO", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance, provider.ProviderKraken}},
+			{Base: "USDT", Quote: "USD", Providers: []provider.Name{provider.ProviderCoinbase}},
+		},
+		map[string]sdk.Dec{"OSMO": sdk.MustNewDecFromStr("2")},
+		map[provider.Name]provider.Endpoint{
+			provider.ProviderBinance:  binanceEndpoint,
+			provider.ProviderCoinbase: {Name: provider.ProviderCoinbase, Rest: "https://coinbase", Websocket: "coinbase"},
+		},
+	)
+
+	// the reload is only applied on the next tick
+	require.Len(t, o.providerPairs[provider.ProviderOkx], 1)
+	o.applyReload()
+
+	osmo := types.CurrencyPair{Base: "OSMO", Quote: "USDT"}
+	umee := types.CurrencyPair{Base: "UMEE", Quote: "USDT"}
+
+	// new pairs of running providers are subscribed to
+	require.Equal(t, []types.CurrencyPair{osmo}, kraken.subscribed)
+	require.Equal(t, []types.CurrencyPair{umee, osmo}, o.providerPairs[provider.ProviderKraken])
+	require.Contains(t, o.priceProviders, provider.ProviderKraken)
+
+	// providers losing pairs are closed to be created again with their
+	// remaining pairs only
+	require.NotContains(t, o.priceProviders, provider.ProviderBinance)
+	require.Empty(t, binance.subscribed)
+	require.Equal(t, []types.CurrencyPair{umee, osmo}, o.providerPairs[provider.ProviderBinance])
+
+	// unused providers are closed
+	require.NotContains(t, o.priceProviders, provider.ProviderOkx)
+	require.NotContains(t, o.providerPairs, provider.ProviderOkx)
+	require.Error(t, okxCtx.Err())
+
+	// providers whose endpoint changed are closed to be created again
+	require.NotContains(t, o.priceProviders, provider.ProviderCoinbase)
+	require.Empty(t, coinbase.subscribed)
+
+	require.Equal(t, map[string]sdk.Dec{"OSMO": sdk.MustNewDecFromStr("2")}, o.deviations)
+
+	// the in-flight vote state is kept
+	require.Same(t, prevote, o.previousPrevote)
+	require.Equal(t, float64(2), o.previousVotePeriod)
+
+	// applying without a pending reload is a no-op
+	o.applyReload()
+	require.Len(t, o.providerPairs, 3)
+}
+
+func TestDiffPairs(t *testing.T) {
+	atom := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	osmo := types.CurrencyPair{Base: "OSMO", Quote: "USDT"}
+	umee := types.CurrencyPair{Base: "UMEE", Quote: "USDT"}
+
+	added, removed := diffPairs(
+		[]types.CurrencyPair{umee, atom},
+		[]types.CurrencyPair{osmo, umee},
+	)
+	require.Equal(t, []types.CurrencyPair{osmo}, added)
+	require.Equal(t, []types.CurrencyPair{atom}, removed)
+
+	added, removed = diffPairs([]types.CurrencyPair{umee}, []types.CurrencyPair{umee})
+	require.Empty(t, added)
+	require.Empty(t, removed)
+}
+
+// closingProvider defines a mock provider recording whether it was closed.
+type closingProvider struct {
+	mockProvider
+	closed bool
+}
+
+func (p *closingProvider) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestOracle_CloseProvider(t *testing.T) {
+	o, err := New(zerolog.Nop(), client.OracleClient{}, nil, time.Millisecond*100, nil, nil, Options{})
+	require.NoError(t, err)
+
+	binance, kraken := &closingProvider{}, &closingProvider{}
+	o.priceProviders = map[provider.Name]provider.Provider{
+		provider.ProviderBinance: binance,
+		provider.ProviderKraken:  kraken,
+	}
+
+	o.closeProvider(provider.ProviderBinance)
+	require.True(t, binance.closed)
+	require.False(t, kraken.closed)
+	require.NotContains(t, o.priceProviders, provider.ProviderBinance)
+
+	o.closeProviders()
+	require.True(t, kraken.closed)
+	require.Empty(t, o.priceProviders)
+}
+
+func TestOracle_ReloadRemovePair(t *testing.T) {
+	o, err := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{Base: "UMEE", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance, provider.ProviderKraken}},
+			{Base: "ATOM", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance}},
+		},
+		time.Millisecond*100,
+		nil,
+		nil,
+		Options{},
+	)
+	require.NoError(t, err)
+
+	binance, kraken := &closingProvider{}, &closingProvider{}
+	o.priceProviders = map[provider.Name]provider.Provider{
+		provider.ProviderBinance: binance,
+		provider.ProviderKraken:  kraken,
+	}
+	binanceCtx, cancelBinance := context.WithCancel(context.Background())
+	o.providerCancels[provider.ProviderBinance] = cancelBinance
+
+	o.Reload(
+		[]config.CurrencyPair{
+			{Base: "UMEE", Quote: "USDT", Providers: []provider.Name{provider.ProviderBinance, provider.ProviderKraken}},
+		},
+		nil,
+		nil,
+	)
+	o.applyReload()
+
+	// the provider which lost a pair is closed, to be created again with its
+	// remaining pairs on the next tick
+	require.NotContains(t, o.priceProviders, provider.ProviderBinance)
+	require.True(t, binance.closed)
+	require.Error(t, binanceCtx.Err())
+	require.Equal(
+		t,
+		[]types.CurrencyPair{{Base: "UMEE", Quote: "USDT"}},
+		o.providerPairs[provider.ProviderBinance],
+	)
+
+	// providers with unchanged pairs keep running
+	require.Contains(t, o.priceProviders, provider.ProviderKraken)
+	require.False(t, kraken.closed)
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/umee-network/umee/price-feeder/v2/oracle"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/history"
 )
 
 // Oracle defines the Oracle interface contract that the v1 router depends on.
@@ -13,4 +14,5 @@ type Oracle interface {
 	GetPrices() map[string]sdk.Dec
 	GetTvwapPrices() oracle.PricesByProvider
 	GetVwapPrices() oracle.PricesByProvider
+	GetTickHistory(limit int, votesOnly bool) ([]history.Tick, error)
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/history"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
 )
 
@@ -32,6 +33,12 @@ type (
 	PricesPerProviderResponse struct {
 		Prices map[provider.Name]map[string]sdk.Dec `json:"providers"`
 	}
+
+	// TickHistoryResponse defines the response type for getting the latest
+	// oracle tick records.
+	TickHistoryResponse struct {
+		Ticks []history.Tick `json:"ticks"`
+	}
 )
 
 // errorResponse defines the attributes of a JSON error response.
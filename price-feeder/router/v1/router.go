@@ -3,6 +3,7 @@ package v1
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -17,6 +18,9 @@ import (
 
 const (
 	APIPathPrefix = "/api/v1"
+
+	defaultHistoryLimit = 100
+	maxHistoryLimit     = 1000
 )
 
 // Router defines a router wrapper used for registering v1 API routes.
@@ -80,6 +84,18 @@ func (r *Router) RegisterRoutes(rtr *mux.Router, prefix string) {
 		mChain.ThenFunc(r.tickerPricesHandler()),
 	).Methods(httputil.MethodGET)
 
+	if r.cfg.History.Dir != "" {
+		v1Router.Handle(
+			"/history",
+			mChain.ThenFunc(r.tickHistoryHandler(false)),
+		).Methods(httputil.MethodGET)
+
+		v1Router.Handle(
+			"/history/votes",
+			mChain.ThenFunc(r.tickHistoryHandler(true)),
+		).Methods(httputil.MethodGET)
+	}
+
 	if r.cfg.Telemetry.Enabled {
 		v1Router.Handle(
 			"/metrics",
@@ -131,6 +147,35 @@ func (r *Router) tickerPricesHandler() http.HandlerFunc {
 	}
 }
 
+// tickHistoryHandler returns the latest oracle tick records, up to the limit
+// query parameter. When votesOnly is set, only the ticks that broadcast a
+// prevote or a vote are returned.
+func (r *Router) tickHistoryHandler(votesOnly bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		limit := defaultHistoryLimit
+		if limitStr := strings.TrimSpace(req.FormValue("limit")); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit <= 0 || limit > maxHistoryLimit {
+				writeErrorResponse(
+					w,
+					http.StatusBadRequest,
+					fmt.Sprintf("limit must be between 1 and %d", maxHistoryLimit),
+				)
+				return
+			}
+		}
+
+		ticks, err := r.oracle.GetTickHistory(limit, votesOnly)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to get tick history: %s", err))
+			return
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, TickHistoryResponse{Ticks: ticks})
+	}
+}
+
 func (r *Router) metricsHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		format := strings.TrimSpace(req.FormValue("format"))
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/umee-network/umee/price-feeder/v2/config"
 	"github.com/umee-network/umee/price-feeder/v2/oracle"
+	"github.com/umee-network/umee/price-feeder/v2/oracle/history"
 	"github.com/umee-network/umee/price-feeder/v2/oracle/provider"
 	v1 "github.com/umee-network/umee/price-feeder/v2/router/v1"
 )
@@ -37,6 +38,23 @@ var (
 			"UMEE": sdk.MustNewDecFromStr("1.13000000"),
 		},
 	}
+
+	mockTicks = []history.Tick{
+		{
+			Time:        time.Unix(1670000002, 0).UTC(),
+			BlockHeight: 11,
+			Prices:      mockPrices,
+			Vote:        &history.Tx{Hash: "CD34", ExchangeRates: "ATOM:34.84,UMEE:4.21", Height: 12},
+		},
+		{
+			Time:              time.Unix(1670000001, 0).UTC(),
+			BlockHeight:       10,
+			Prices:            mockPrices,
+			ProviderPrices:    mockComputedPrices,
+			FilteredProviders: map[string][]provider.Name{"UMEE": {provider.ProviderHuobi}},
+			ProviderErrors:    map[provider.Name]string{provider.ProviderOkx: "provider timed out"},
+		},
+	}
 )
 
 type mockOracle struct{}
@@ -57,6 +75,16 @@ func (m mockOracle) GetVwapPrices() oracle.PricesByProvider {
 	return mockComputedPrices
 }
 
+func (m mockOracle) GetTickHistory(limit int, votesOnly bool) ([]history.Tick, error) {
+	ticks := []history.Tick{}
+	for _, tick := range mockTicks {
+		if len(ticks) < limit && (!votesOnly || tick.Vote != nil || tick.Prevote != nil) {
+			ticks = append(ticks, tick)
+		}
+	}
+	return ticks, nil
+}
+
 type mockMetrics struct{}
 
 func (mockMetrics) Gather(format string) (telemetry.GatherResponse, error) {
@@ -78,6 +106,9 @@ func (rts *RouterTestSuite) SetupSuite() {
 			AllowedOrigins: []string{},
 			VerboseCORS:    false,
 		},
+		History: config.History{
+			Dir: "history",
+		},
 	}
 
 	r := v1.New(zerolog.Nop(), cfg, mockOracle{}, mockMetrics{})
@@ -151,3 +182,40 @@ func (rts *RouterTestSuite) TestVwap() {
 		mockComputedPrices[provider.ProviderBinance]["ATOM"],
 	)
 }
+
+func (rts *RouterTestSuite) TestTickHistory() {
+	req, err := http.NewRequest("GET", "/api/v1/history", nil)
+	rts.Require().NoError(err)
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.TickHistoryResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(mockTicks, respBody.Ticks)
+
+	req, err = http.NewRequest("GET", "/api/v1/history?limit=1", nil)
+	rts.Require().NoError(err)
+	response = rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	respBody = v1.TickHistoryResponse{}
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(mockTicks[:1], respBody.Ticks)
+
+	req, err = http.NewRequest("GET", "/api/v1/history?limit=0", nil)
+	rts.Require().NoError(err)
+	response = rts.executeRequest(req)
+	rts.Require().Equal(http.StatusBadRequest, response.Code)
+}
+
+func (rts *RouterTestSuite) TestTickHistoryVotes() {
+	req, err := http.NewRequest("GET", "/api/v1/history/votes", nil)
+	rts.Require().NoError(err)
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.TickHistoryResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Len(respBody.Ticks, 1)
+	rts.Require().Equal(int64(12), respBody.Ticks[0].Vote.Height)
+}
@@ -20,8 +20,11 @@ func ConcatBytes(margin int, bzs ...[]byte) []byte {
 	return out
 }
 
+// UintWithNullPrefix serializes a uint64 in big endian format, prefixed by
+// a null byte. Big endian is used so that keys ending with the number are
+// lexicographically ordered by it.
 func UintWithNullPrefix(n uint64) []byte {
 	bz := make([]byte, 9)
-	binary.LittleEndian.PutUint64(bz[1:], n)
+	binary.BigEndian.PutUint64(bz[1:], n)
 	return bz
 }
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"bytes"
 	"encoding/binary"
 	"math"
 	"testing"
@@ -31,9 +32,12 @@ func TestMergeBytes(t *testing.T) {
 func TestUintWithNullPrefix(t *testing.T) {
 	expected := []byte{0}
 	num := make([]byte, 8)
-	binary.LittleEndian.PutUint64(num, math.MaxUint64)
+	binary.BigEndian.PutUint64(num, math.MaxUint64)
 	expected = append(expected, num...)
 
 	out := UintWithNullPrefix(math.MaxUint64)
 	require.Equal(t, expected, out)
+
+	// keys must be ordered by the number
+	require.Equal(t, -1, bytes.Compare(UintWithNullPrefix(255), UintWithNullPrefix(256)))
 }
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v3/util/cli"
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+)
+
+// Flags of the query commands
+const (
+	FlagDenom   = "denom"
+	FlagChannel = "channel"
+)
+
+// GetQueryCmd returns the CLI query commands for the IBC transfer quotas and
+// the IBC asset registry.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryQuotaUsage(),
+		GetCmdQueryAssets(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current uibc params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParams{})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryQuotaUsage implements the query quota usage command.
+func GetCmdQueryQuotaUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota-usage",
+		Args:  cobra.NoArgs,
+		Short: "Query the USD values transferred for the quotas over the current window",
+		Example: "umeed query uibc quota-usage\n" +
+			"umeed query uibc quota-usage --denom uumee\n" +
+			"umeed query uibc quota-usage --channel channel-0",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetString(FlagChannel)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuotaUsage(cmd.Context(), &types.QueryQuotaUsage{
+				Denom:     denom,
+				ChannelId: channelID,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Only show the quota of the denom")
+	cmd.Flags().String(FlagChannel, "", "Only show the quota of the channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAssets implements the query assets command.
+func GetCmdQueryAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets [ibc-denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the assets of the IBC asset registry, or a single one by its IBC denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAssets{}
+			if len(args) > 0 {
+				req.IbcDenom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Assets(cmd.Context(), req)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
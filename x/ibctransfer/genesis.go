@@ -0,0 +1,30 @@
+package ibctransfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/ibctransfer/keeper"
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+)
+
+// InitGenesis initializes the x/ibctransfer quotas and asset registry state
+// from a provided genesis state.
+func InitGenesis(ctx sdk.Context, qk keeper.QuotaKeeper, ak keeper.AssetKeeper, genState types.GenesisState) {
+	qk.SetParams(ctx, genState.Params)
+
+	for _, flow := range genState.Flows {
+		qk.SetFlow(ctx, flow)
+	}
+
+	for _, asset := range genState.Assets {
+		if err := ak.SetAsset(ctx, asset); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the x/ibctransfer quotas and asset registry exported
+// genesis state.
+func ExportGenesis(ctx sdk.Context, qk keeper.QuotaKeeper, ak keeper.AssetKeeper) *types.GenesisState {
+	return types.NewGenesisState(qk.GetParams(ctx), qk.GetAllFlows(ctx), ak.GetAllAssets(ctx))
+}
@@ -8,6 +8,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
 
 	"github.com/umee-network/umee/v3/x/ibctransfer/keeper"
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
 )
 
 // IBCModule embeds the ICS-20 transfer IBCModule where we only override specific
@@ -16,31 +17,92 @@ type IBCModule struct {
 	// embed the ICS-20 transfer's AppModule
 	ibctransfer.IBCModule
 
-	keeper keeper.Keeper
+	keeper      keeper.Keeper
+	quotaKeeper keeper.QuotaKeeper
 }
 
-func NewIBCModule(am ibctransfer.IBCModule, k keeper.Keeper) IBCModule {
+func NewIBCModule(am ibctransfer.IBCModule, k keeper.Keeper, qk keeper.QuotaKeeper) IBCModule {
 	return IBCModule{
-		IBCModule: am,
-		keeper:    k,
+		IBCModule:   am,
+		keeper:      k,
+		quotaKeeper: qk,
 	}
 }
 
-// OnRecvPacket delegates the OnRecvPacket call to the embedded ICS-20 transfer
-// IBCModule and updates metadata if successful.
+// OnRecvPacket checks and records the inflow against the IBC transfer quotas,
+// then delegates the OnRecvPacket call to the embedded ICS-20 transfer IBCModule
+// and updates metadata if successful. A transfer exceeding the quotas is
+// acknowledged with an error, refunding the sender.
 func (am IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// the embedded IBCModule acknowledges the invalid packet data
+		return am.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// the recorded inflow is discarded with the state changes of an
+	// unsuccessful acknowledgement
+	if amount, ok := keeper.ReceivedCoin(packet, data); ok {
+		if err := am.quotaKeeper.CheckAndRecordFlow(ctx, types.FlowIn, packet.GetDestChannel(), amount); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
 	ack := am.IBCModule.OnRecvPacket(ctx, packet, relayer)
 	if ack.Success() {
-		var data ibctransfertypes.FungibleTokenPacketData
-		if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
-			// track metadata
-			am.keeper.PostOnRecvPacket(ctx, packet, data)
-		}
+		// track metadata
+		am.keeper.PostOnRecvPacket(ctx, packet, data)
 	}
 
 	return ack
 }
+
+// OnAcknowledgementPacket delegates the OnAcknowledgementPacket call to the
+// embedded ICS-20 transfer IBCModule and reverts the outflow of a refunded
+// transfer.
+func (am IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := am.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		am.revertOutflow(ctx, packet)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket delegates the OnTimeoutPacket call to the embedded ICS-20
+// transfer IBCModule and reverts the outflow of the refunded transfer.
+func (am IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := am.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	am.revertOutflow(ctx, packet)
+	return nil
+}
+
+func (am IBCModule) revertOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+	if amount, ok := keeper.SentCoin(data); ok {
+		am.quotaKeeper.RevertOutflow(ctx, packet.GetSourceChannel(), amount)
+	}
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+)
+
+// AssetKeeper manages the governance curated registry of the IBC assets, which
+// sets the bank metadata of the registered IBC denoms.
+type AssetKeeper struct {
+	cdc            codec.BinaryCodec
+	storeKey       storetypes.StoreKey
+	bankKeeper     types.BankKeeper
+	leverageKeeper types.LeverageKeeper
+	authority      string // the gov module account
+}
+
+func NewAssetKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bk types.BankKeeper,
+	authority string,
+) AssetKeeper {
+	return AssetKeeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		bankKeeper: bk,
+		authority:  authority,
+	}
+}
+
+// SetLeverageKeeper sets the x/leverage keeper the assets are checked against.
+// The x/leverage keeper depends on the asset registry, so it can only be set
+// once both keepers are created. Note, it can only be set once.
+func (k *AssetKeeper) SetLeverageKeeper(lk types.LeverageKeeper) *AssetKeeper {
+	if k.leverageKeeper != nil {
+		panic("uibc leverage keeper already set")
+	}
+
+	k.leverageKeeper = lk
+
+	return k
+}
+
+// GetAsset returns the registered asset of an IBC denom, and false if the
+// denom is not registered.
+func (k AssetKeeper) GetAsset(ctx sdk.Context, ibcDenom string) (types.Asset, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAsset(ibcDenom))
+	if bz == nil {
+		return types.Asset{}, false
+	}
+
+	var asset types.Asset
+	k.cdc.MustUnmarshal(bz, &asset)
+	return asset, true
+}
+
+// GetAssetDisplay returns the symbol and the exponent of the display denom of
+// a registered IBC denom, and false if the denom is not registered.
+func (k AssetKeeper) GetAssetDisplay(ctx sdk.Context, ibcDenom string) (string, uint32, bool) {
+	asset, ok := k.GetAsset(ctx, ibcDenom)
+	if !ok {
+		return "", 0, false
+	}
+	return asset.Symbol, asset.Exponent, true
+}
+
+// SetAsset registers an asset and sets the bank metadata of its IBC denom,
+// replacing the metadata tracked without the registry.
+func (k AssetKeeper) SetAsset(ctx sdk.Context, asset types.Asset) error {
+	if err := asset.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAsset(asset.IbcDenom), k.cdc.MustMarshal(&asset))
+	k.bankKeeper.SetDenomMetaData(ctx, asset.Metadata())
+
+	return nil
+}
+
+// GetAllAssets returns all the registered assets.
+func (k AssetKeeper) GetAllAssets(ctx sdk.Context) []types.Asset {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixAsset)
+	defer iter.Close()
+
+	assets := []types.Asset{}
+	for ; iter.Valid(); iter.Next() {
+		var asset types.Asset
+		k.cdc.MustUnmarshal(iter.Value(), &asset)
+		assets = append(assets, asset)
+	}
+
+	return assets
+}
+
+// UpdateAssets registers the new assets and updates the registered ones. It
+// returns an error if a new asset is already registered or an updated asset is
+// not, or if the symbol or the exponent of an asset don't match its x/leverage
+// token.
+func (k AssetKeeper) UpdateAssets(ctx sdk.Context, addAssets, updateAssets []types.Asset) error {
+	for _, asset := range addAssets {
+		if _, ok := k.GetAsset(ctx, asset.IbcDenom); ok {
+			return types.ErrAssetExists.Wrap(asset.IbcDenom)
+		}
+	}
+	for _, asset := range updateAssets {
+		if _, ok := k.GetAsset(ctx, asset.IbcDenom); !ok {
+			return types.ErrAssetNotFound.Wrap(asset.IbcDenom)
+		}
+	}
+
+	for _, assets := range [][]types.Asset{addAssets, updateAssets} {
+		for _, asset := range assets {
+			if err := k.validateLeverageToken(ctx, asset); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, assets := range [][]types.Asset{addAssets, updateAssets} {
+		for _, asset := range assets {
+			if err := k.SetAsset(ctx, asset); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateLeverageToken returns an error if the symbol or the exponent of an
+// asset registered as an x/leverage token don't match the token.
+func (k AssetKeeper) validateLeverageToken(ctx sdk.Context, asset types.Asset) error {
+	token, err := k.leverageKeeper.GetTokenSettings(ctx, asset.IbcDenom)
+	if err != nil {
+		return nil
+	}
+
+	if !strings.EqualFold(token.SymbolDenom, asset.Symbol) || token.Exponent != asset.Exponent {
+		return types.ErrTokenMismatch.Wrapf(
+			"%s: asset symbol %s and exponent %d, token symbol %s and exponent %d",
+			asset.IbcDenom, asset.Symbol, asset.Exponent, token.SymbolDenom, token.Exponent,
+		)
+	}
+
+	return nil
+}
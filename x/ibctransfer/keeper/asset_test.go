@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/x/ibctransfer/keeper"
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+	"github.com/umee-network/umee/v3/x/leverage/fixtures"
+)
+
+func newAsset(trace, symbol string, exponent uint32) types.Asset {
+	return types.Asset{
+		IbcDenom:    ibctransfertypes.ParseDenomTrace(trace).IBCDenom(),
+		Symbol:      symbol,
+		Exponent:    exponent,
+		OriginChain: "origin-1",
+		Trace:       trace,
+	}
+}
+
+func TestGovUpdateAssets(t *testing.T) {
+	app := umeeapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(app.UIBCAssetKeeper)
+
+	atom := newAsset("transfer/channel-1/uatom", "ATOM", 6)
+	osmo := newAsset("transfer/channel-2/uosmo", "OSMO", 6)
+
+	// the metadata tracked on transfer is replaced by the registry
+	app.UIBCTransferKeeper.TrackDenomMetadata(ctx, atom.DenomTrace())
+	metadata, ok := app.BankKeeper.GetDenomMetaData(ctx, atom.IbcDenom)
+	require.True(t, ok)
+	require.Equal(t, "uatom", metadata.Symbol)
+
+	_, err := msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		"umee1invalid", "title", "description", []types.Asset{atom}, nil))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", []types.Asset{atom}, []types.Asset{osmo}))
+	require.ErrorIs(t, err, types.ErrAssetNotFound)
+	require.Empty(t, app.UIBCAssetKeeper.GetAllAssets(ctx))
+
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", []types.Asset{atom, osmo}, nil))
+	require.NoError(t, err)
+	require.Len(t, app.UIBCAssetKeeper.GetAllAssets(ctx), 2)
+
+	metadata, ok = app.BankKeeper.GetDenomMetaData(ctx, atom.IbcDenom)
+	require.True(t, ok)
+	require.Equal(t, atom.Metadata(), metadata)
+	require.NoError(t, metadata.Validate())
+
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", []types.Asset{atom}, nil))
+	require.ErrorIs(t, err, types.ErrAssetExists)
+
+	osmo.Exponent = 0
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", nil, []types.Asset{osmo}))
+	require.NoError(t, err)
+	symbol, exponent, ok := app.UIBCAssetKeeper.GetAssetDisplay(ctx, osmo.IbcDenom)
+	require.True(t, ok)
+	require.Equal(t, "OSMO", symbol)
+	require.Equal(t, uint32(0), exponent)
+
+	metadata, _ = app.BankKeeper.GetDenomMetaData(ctx, osmo.IbcDenom)
+	require.NoError(t, metadata.Validate())
+	require.Len(t, metadata.DenomUnits, 1)
+}
+
+func TestGovUpdateAssetsLeverageToken(t *testing.T) {
+	app := umeeapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(app.UIBCAssetKeeper)
+
+	atom := newAsset("transfer/channel-1/uatom", "ATOM", 6)
+	require.NoError(t, app.LeverageKeeper.SetTokenSettings(ctx, fixtures.Token(atom.IbcDenom, "ATOM", 6)))
+
+	// the assets registered as leverage tokens must match them
+	mismatched := atom
+	mismatched.Exponent = 18
+	_, err := msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", []types.Asset{mismatched}, nil))
+	require.ErrorIs(t, err, types.ErrTokenMismatch)
+	require.Empty(t, app.UIBCAssetKeeper.GetAllAssets(ctx))
+
+	mismatched = atom
+	mismatched.Symbol = "STATOM"
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", []types.Asset{mismatched}, nil))
+	require.ErrorIs(t, err, types.ErrTokenMismatch)
+
+	// the symbols are compared case insensitively
+	atom.Symbol = "atom"
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", []types.Asset{atom}, nil))
+	require.NoError(t, err)
+
+	atom.Exponent = 0
+	_, err = msgServer.GovUpdateAssets(ctx, types.NewMsgGovUpdateAssets(
+		authority, "title", "description", nil, []types.Asset{atom}))
+	require.ErrorIs(t, err, types.ErrTokenMismatch)
+}
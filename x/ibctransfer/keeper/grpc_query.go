@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements a QueryServer for the IBC transfer quotas and the IBC
+// asset registry.
+type Querier struct {
+	QuotaKeeper
+	AssetKeeper
+}
+
+func NewQuerier(qk QuotaKeeper, ak AssetKeeper) Querier {
+	return Querier{QuotaKeeper: qk, AssetKeeper: ak}
+}
+
+func (q Querier) Params(
+	goCtx context.Context,
+	req *types.QueryParams,
+) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.QuotaKeeper.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) QuotaUsage(
+	goCtx context.Context,
+	req *types.QueryQuotaUsage,
+) (*types.QueryQuotaUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	usage := q.QuotaKeeper.QuotaUsage(ctx, req.Denom, req.ChannelId)
+
+	return &types.QueryQuotaUsageResponse{Usage: usage}, nil
+}
+
+func (q Querier) Assets(
+	goCtx context.Context,
+	req *types.QueryAssets,
+) (*types.QueryAssetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.IbcDenom == "" {
+		return &types.QueryAssetsResponse{Assets: q.AssetKeeper.GetAllAssets(ctx)}, nil
+	}
+
+	asset, ok := q.AssetKeeper.GetAsset(ctx, req.IbcDenom)
+	if !ok {
+		return nil, status.Error(codes.NotFound, types.ErrAssetNotFound.Wrap(req.IbcDenom).Error())
+	}
+
+	return &types.QueryAssetsResponse{Assets: []types.Asset{asset}}, nil
+}
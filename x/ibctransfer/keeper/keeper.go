@@ -10,8 +10,10 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
 
 	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
 )
 
 // Keeper embeds the ICS-20 transfer keeper where we only override specific
@@ -21,12 +23,14 @@ type Keeper struct {
 	ibctransferkeeper.Keeper
 
 	bankKeeper types.BankKeeper
+	leverage   types.LeverageMsgServer
 }
 
-func New(tk ibctransferkeeper.Keeper, bk types.BankKeeper) Keeper {
+func New(tk ibctransferkeeper.Keeper, bk types.BankKeeper, lms types.LeverageMsgServer) Keeper {
 	return Keeper{
 		Keeper:     tk,
 		bankKeeper: bk,
+		leverage:   lms,
 	}
 }
 
@@ -96,26 +100,119 @@ func (k Keeper) OnRecvPacket(
 }
 
 // PostOnRecvPacket executes arbitrary logic after a successful OnRecvPacket
-// call. Currently, it checks and adds denomination metadata upon receiving an
-// IBC asset.
+// call. It checks and adds denomination metadata upon receiving an IBC asset,
+// then runs the x/leverage instruction of the transfer memo, if any.
 func (k Keeper) PostOnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data ibctransfertypes.FungibleTokenPacketData,
 ) {
-	var denomTrace ibctransfertypes.DenomTrace
+	k.TrackDenomMetadata(ctx, ReceivedDenomTrace(packet, data))
 
+	if memo, ok := types.ParseMemo(data.Memo); ok {
+		if amount, ok := ReceivedCoin(packet, data); ok {
+			k.ExecuteLeverageMemo(ctx, data.Receiver, amount, *memo.Leverage)
+		}
+	}
+}
+
+// ExecuteLeverageMemo supplies, supplies and collateralizes, or repays a borrow
+// with the received tokens on behalf of the receiver, as instructed by the
+// memo. The action runs in a cached context: when it fails, its state changes
+// are discarded and an EventMemoFailed is emitted, so the receiver is simply
+// credited the tokens and the transfer is still acknowledged successfully.
+func (k Keeper) ExecuteLeverageMemo(
+	ctx sdk.Context,
+	receiver string,
+	amount sdk.Coin,
+	memo types.LeverageMemo,
+) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.executeLeverageMemo(cacheCtx, receiver, amount, memo); err != nil {
+		k.Logger(ctx).Info(
+			"leverage memo failed",
+			"receiver", receiver,
+			"action", memo.Action,
+			"amount", amount.String(),
+			"error", err.Error(),
+		)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventMemoFailed{
+			Receiver: receiver,
+			Action:   memo.Action,
+			Amount:   amount,
+			Error:    err.Error(),
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+		}
+		return
+	}
+
+	write()
+}
+
+func (k Keeper) executeLeverageMemo(
+	ctx sdk.Context,
+	receiver string,
+	amount sdk.Coin,
+	memo types.LeverageMemo,
+) error {
+	if err := memo.Validate(); err != nil {
+		return err
+	}
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return err
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	switch memo.Action {
+	case types.MemoActionSupply:
+		_, err = k.leverage.Supply(goCtx, leveragetypes.NewMsgSupply(receiverAddr, amount))
+	case types.MemoActionSupplyCollateral:
+		_, err = k.leverage.SupplyCollateral(goCtx, leveragetypes.NewMsgSupplyCollateral(receiverAddr, amount))
+	default: // types.MemoActionRepay
+		_, err = k.leverage.Repay(goCtx, leveragetypes.NewMsgRepay(receiverAddr, amount))
+	}
+
+	return err
+}
+
+// ReceivedDenomTrace returns the denom trace of the tokens of a received packet
+// on this chain.
+func ReceivedDenomTrace(
+	packet ibcexported.PacketI,
+	data ibctransfertypes.FungibleTokenPacketData,
+) ibctransfertypes.DenomTrace {
 	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
 		unprefixedDenom := data.Denom[len(voucherPrefix):]
-		denomTrace = ibctransfertypes.ParseDenomTrace(unprefixedDenom)
-	} else {
-		sourcePrefix := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-		prefixedDenom := sourcePrefix + data.Denom // sourcePrefix contains the trailing "/"
-		denomTrace = ibctransfertypes.ParseDenomTrace(prefixedDenom)
+		return ibctransfertypes.ParseDenomTrace(unprefixedDenom)
+	}
+
+	sourcePrefix := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	prefixedDenom := sourcePrefix + data.Denom // sourcePrefix contains the trailing "/"
+	return ibctransfertypes.ParseDenomTrace(prefixedDenom)
+}
+
+// ReceivedCoin returns the tokens of a received packet in their denom on this
+// chain, and false if the packet amount is invalid.
+func ReceivedCoin(packet ibcexported.PacketI, data ibctransfertypes.FungibleTokenPacketData) (sdk.Coin, bool) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, false
 	}
+	return sdk.Coin{Denom: ReceivedDenomTrace(packet, data).IBCDenom(), Amount: amount}, true
+}
 
-	k.TrackDenomMetadata(ctx, denomTrace)
+// SentCoin returns the tokens of a sent packet in their denom on this chain,
+// and false if the packet amount is invalid.
+func SentCoin(data ibctransfertypes.FungibleTokenPacketData) (sdk.Coin, bool) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, false
+	}
+	return sdk.Coin{Denom: ibctransfertypes.ParseDenomTrace(data.Denom).IBCDenom(), Amount: amount}, true
 }
 
 // TrackDenomMetadata checks for the metadata existence of an IBC transferred
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v5/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/x/ibctransfer/keeper"
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+)
+
+// mockLeverageMsgServer records the executed actions, and fails after a state
+// change when fail is set.
+type mockLeverageMsgServer struct {
+	bankKeeper types.BankKeeper
+	fail       bool
+	actions    []string
+}
+
+func (m *mockLeverageMsgServer) execute(goCtx context.Context, action string) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if m.fail {
+		m.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: "failed"})
+		return fmt.Errorf("%s failed", action)
+	}
+	m.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: action})
+	m.actions = append(m.actions, action)
+	return nil
+}
+
+func (m *mockLeverageMsgServer) Supply(
+	goCtx context.Context, _ *leveragetypes.MsgSupply,
+) (*leveragetypes.MsgSupplyResponse, error) {
+	return &leveragetypes.MsgSupplyResponse{}, m.execute(goCtx, types.MemoActionSupply)
+}
+
+func (m *mockLeverageMsgServer) SupplyCollateral(
+	goCtx context.Context, _ *leveragetypes.MsgSupplyCollateral,
+) (*leveragetypes.MsgSupplyCollateralResponse, error) {
+	return &leveragetypes.MsgSupplyCollateralResponse{}, m.execute(goCtx, types.MemoActionSupplyCollateral)
+}
+
+func (m *mockLeverageMsgServer) Repay(
+	goCtx context.Context, _ *leveragetypes.MsgRepay,
+) (*leveragetypes.MsgRepayResponse, error) {
+	return &leveragetypes.MsgRepayResponse{}, m.execute(goCtx, types.MemoActionRepay)
+}
+
+func TestPostOnRecvPacketMemo(t *testing.T) {
+	app := umeeapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	lms := &mockLeverageMsgServer{bankKeeper: app.BankKeeper}
+	k := keeper.New(ibctransferkeeper.Keeper{}, app.BankKeeper, lms)
+
+	receiver := sdk.AccAddress([]byte("receiver____________")).String()
+	recv := func(memo string) {
+		data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "100", "sender", receiver)
+		data.Memo = memo
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, "channel-9",
+			ibctransfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+		k.PostOnRecvPacket(ctx, packet, data)
+	}
+	failures := func() int {
+		n := 0
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == proto.MessageName(&types.EventMemoFailed{}) {
+				n++
+			}
+		}
+		return n
+	}
+
+	// the memos without leverage instruction are ignored
+	recv("")
+	recv("plain text")
+	recv(`{"forward":{"receiver":"cosmos1..."}}`)
+	require.Empty(t, lms.actions)
+	require.Equal(t, 0, failures())
+
+	recv(`{"leverage":{"action":"supply"}}`)
+	recv(`{"leverage":{"action":"supply_collateral"}}`)
+	recv(`{"leverage":{"action":"repay"}}`)
+	require.Equal(t, []string{
+		types.MemoActionSupply,
+		types.MemoActionSupplyCollateral,
+		types.MemoActionRepay,
+	}, lms.actions)
+	_, ok := app.BankKeeper.GetDenomMetaData(ctx, types.MemoActionRepay)
+	require.True(t, ok)
+	require.Equal(t, 0, failures())
+
+	// an unknown action falls back to a plain credit
+	recv(`{"leverage":{"action":"borrow"}}`)
+	require.Len(t, lms.actions, 3)
+	require.Equal(t, 1, failures())
+
+	// the state changes of a failed action are discarded
+	lms.fail = true
+	recv(`{"leverage":{"action":"supply"}}`)
+	_, ok = app.BankKeeper.GetDenomMetaData(ctx, "failed")
+	require.False(t, ok)
+	require.Equal(t, 2, failures())
+}
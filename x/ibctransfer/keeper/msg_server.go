@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	keeper AssetKeeper
+}
+
+// NewMsgServerImpl returns an implementation of MsgServer for the uibc module.
+func NewMsgServerImpl(keeper AssetKeeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+func (s msgServer) GovUpdateAssets(
+	goCtx context.Context,
+	msg *types.MsgGovUpdateAssets,
+) (*types.MsgGovUpdateAssetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// checking req msg authority is the gov module address
+	if s.keeper.authority != msg.Authority {
+		return &types.MsgGovUpdateAssetsResponse{},
+			govtypes.ErrInvalidSigner.Wrapf(
+				"invalid authority: expected %s, got %s",
+				s.keeper.authority, msg.Authority,
+			)
+	}
+
+	if err := s.keeper.UpdateAssets(ctx, msg.AddAssets, msg.UpdateAssets); err != nil {
+		return &types.MsgGovUpdateAssetsResponse{}, err
+	}
+
+	return &types.MsgGovUpdateAssetsResponse{}, nil
+}
@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+)
+
+var _ ibctransfertypes.ICS4Wrapper = QuotaKeeper{}
+
+// QuotaKeeper limits the USD value of the IBC transfers over a rolling window,
+// per denom and per channel. It wraps the ICS4Wrapper of the ICS-20 transfer
+// keeper to check the outflows, the inflows being checked by the IBCModule.
+type QuotaKeeper struct {
+	storeKey       storetypes.StoreKey
+	paramSpace     paramstypes.Subspace
+	oracleKeeper   types.OracleKeeper
+	leverageKeeper types.LeverageKeeper
+	ics4Wrapper    ibctransfertypes.ICS4Wrapper
+}
+
+func NewQuotaKeeper(
+	storeKey storetypes.StoreKey,
+	paramSpace paramstypes.Subspace,
+	ok types.OracleKeeper,
+	lk types.LeverageKeeper,
+	ics4Wrapper ibctransfertypes.ICS4Wrapper,
+) QuotaKeeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return QuotaKeeper{
+		storeKey:       storeKey,
+		paramSpace:     paramSpace,
+		oracleKeeper:   ok,
+		leverageKeeper: lk,
+		ics4Wrapper:    ics4Wrapper,
+	}
+}
+
+// GetParams returns the total set of uibc parameters.
+func (k QuotaKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of uibc parameters.
+func (k QuotaKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SendPacket checks and records the outflow of an ICS-20 packet against the
+// quotas before sending it.
+func (k QuotaKeeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		if amount, ok := SentCoin(data); ok {
+			if err := k.CheckAndRecordFlow(ctx, types.FlowOut, packet.GetSourceChannel(), amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// CheckAndRecordFlow values the amount transferred through the local channel
+// at the x/oracle exchange rate, and returns an error if it exceeds any quota
+// of the denom or of the channel over the current window. Otherwise, the value
+// is recorded for each quota. The tokens without exchange rate can't be
+// transferred if their denom has a quota, or is registered in x/leverage or in
+// the x/oracle AcceptList. The other tokens without exchange rate are valued
+// zero against the channel quotas.
+func (k QuotaKeeper) CheckAndRecordFlow(
+	ctx sdk.Context,
+	direction types.FlowDirection,
+	channelID string,
+	amount sdk.Coin,
+) error {
+	params := k.GetParams(ctx)
+	if !params.QuotaEnabled {
+		return nil
+	}
+
+	quotas := params.MatchingQuotas(amount.Denom, channelID)
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	value, err := k.value(ctx, amount)
+	if err != nil {
+		if k.isPricedDenom(ctx, amount.Denom) {
+			return sdkerrors.Wrap(types.ErrNoQuotaPrice, err.Error())
+		}
+		for _, q := range quotas {
+			if q.Denom != "" {
+				return sdkerrors.Wrap(types.ErrNoQuotaPrice, err.Error())
+			}
+		}
+	}
+
+	bucket := k.currentBucket(ctx, params)
+	for _, q := range quotas {
+		limit := q.Limit(direction)
+		if limit.IsPositive() && k.windowFlow(ctx, params, direction, q, bucket).Add(value).GT(limit) {
+			// the event is kept on inflows, the error being acknowledged
+			err := ctx.EventManager().EmitTypedEvent(&types.EventQuotaExceeded{
+				Direction: direction,
+				ChannelId: channelID,
+				Amount:    amount,
+				Quota:     q,
+			})
+			if err != nil {
+				return err
+			}
+			return types.ErrQuotaExceeded.Wrapf("%s of %s through %s", direction, amount, channelID)
+		}
+	}
+
+	for _, q := range quotas {
+		k.pruneFlows(ctx, params, direction, q, bucket)
+		flow := k.getFlow(ctx, direction, q, bucket)
+		flow.Value = flow.Value.Add(value)
+		k.SetFlow(ctx, flow)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventQuotaFlow{
+		Direction: direction,
+		ChannelId: channelID,
+		Amount:    amount,
+		Value:     value,
+	})
+}
+
+// RevertOutflow removes the value of a refunded outflow from the quotas of its
+// denom and channel, starting from the current bucket. The amount is valued at
+// the current exchange rate.
+func (k QuotaKeeper) RevertOutflow(ctx sdk.Context, channelID string, amount sdk.Coin) {
+	params := k.GetParams(ctx)
+	if !params.QuotaEnabled {
+		return
+	}
+
+	quotas := params.MatchingQuotas(amount.Denom, channelID)
+	if len(quotas) == 0 {
+		return
+	}
+
+	value, err := k.value(ctx, amount)
+	if err != nil || !value.IsPositive() {
+		return
+	}
+
+	bucket := k.currentBucket(ctx, params)
+	first := firstBucket(params, bucket)
+	for _, q := range quotas {
+		remaining := value
+		for b := bucket; remaining.IsPositive() && b >= first; b-- {
+			flow := k.getFlow(ctx, types.FlowOut, q, b)
+			reverted := sdk.MinDec(flow.Value, remaining)
+			flow.Value = flow.Value.Sub(reverted)
+			remaining = remaining.Sub(reverted)
+			k.SetFlow(ctx, flow)
+			if b == 0 {
+				break
+			}
+		}
+	}
+}
+
+// QuotaUsage returns the values transferred for the quotas over the current
+// window. The quotas are filtered by denom and channel when not empty.
+func (k QuotaKeeper) QuotaUsage(ctx sdk.Context, denom, channelID string) []types.QuotaUsage {
+	params := k.GetParams(ctx)
+	bucket := k.currentBucket(ctx, params)
+
+	usage := []types.QuotaUsage{}
+	for _, q := range params.Quotas {
+		if (denom != "" && q.Denom != denom) || (channelID != "" && q.ChannelId != channelID) {
+			continue
+		}
+		usage = append(usage, types.QuotaUsage{
+			Quota:   q,
+			Outflow: k.windowFlow(ctx, params, types.FlowOut, q, bucket),
+			Inflow:  k.windowFlow(ctx, params, types.FlowIn, q, bucket),
+		})
+	}
+
+	return usage
+}
+
+// GetAllFlows returns all the recorded flows.
+func (k QuotaKeeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixFlow)
+	defer iter.Close()
+
+	flows := []types.Flow{}
+	for ; iter.Valid(); iter.Next() {
+		flows = append(flows, k.parseFlow(iter.Key(), iter.Value()))
+	}
+
+	return flows
+}
+
+// value returns the USD value of the amount at the x/oracle exchange rate.
+func (k QuotaKeeper) value(ctx sdk.Context, amount sdk.Coin) (sdk.Dec, error) {
+	price, err := k.oracleKeeper.GetExchangeRateBase(ctx, amount.Denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return price.MulInt(amount.Amount), nil
+}
+
+// isPricedDenom returns true if the denom is registered in x/leverage or in
+// the x/oracle AcceptList, and so is expected to have an exchange rate.
+func (k QuotaKeeper) isPricedDenom(ctx sdk.Context, denom string) bool {
+	if _, err := k.leverageKeeper.GetTokenSettings(ctx, denom); err == nil {
+		return true
+	}
+	for _, acceptedDenom := range k.oracleKeeper.GetParams(ctx).AcceptList {
+		if acceptedDenom.BaseDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// currentBucket returns the index of the window bucket of the block time.
+func (k QuotaKeeper) currentBucket(ctx sdk.Context, params types.Params) uint64 {
+	return uint64(ctx.BlockTime().Unix()) / params.BucketLength()
+}
+
+// firstBucket returns the index of the first bucket of the window ending with
+// the bucket.
+func firstBucket(params types.Params, bucket uint64) uint64 {
+	buckets := uint64(params.WindowBuckets)
+	if bucket+1 < buckets {
+		return 0
+	}
+	return bucket + 1 - buckets
+}
+
+// windowFlow returns the sum of the flows of a quota over the window ending
+// with the bucket.
+func (k QuotaKeeper) windowFlow(
+	ctx sdk.Context,
+	params types.Params,
+	direction types.FlowDirection,
+	q types.Quota,
+	bucket uint64,
+) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyFlowTarget(direction, q.Denom, q.ChannelId))
+	defer iter.Close()
+
+	first := firstBucket(params, bucket)
+	total := sdk.ZeroDec()
+	for ; iter.Valid(); iter.Next() {
+		flow := k.parseFlow(iter.Key(), iter.Value())
+		if flow.Bucket >= first && flow.Bucket <= bucket {
+			total = total.Add(flow.Value)
+		}
+	}
+
+	return total
+}
+
+// pruneFlows removes the flows of a quota which are out of the window ending
+// with the bucket.
+func (k QuotaKeeper) pruneFlows(
+	ctx sdk.Context,
+	params types.Params,
+	direction types.FlowDirection,
+	q types.Quota,
+	bucket uint64,
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyFlowTarget(direction, q.Denom, q.ChannelId))
+
+	first := firstBucket(params, bucket)
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		if _, _, _, b := types.ParseFlowKey(iter.Key()); b < first {
+			expired = append(expired, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+func (k QuotaKeeper) getFlow(
+	ctx sdk.Context,
+	direction types.FlowDirection,
+	q types.Quota,
+	bucket uint64,
+) types.Flow {
+	flow := types.Flow{
+		Denom:     q.Denom,
+		ChannelId: q.ChannelId,
+		Direction: direction,
+		Bucket:    bucket,
+		Value:     sdk.ZeroDec(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyFlow(direction, q.Denom, q.ChannelId, bucket))
+	if bz != nil {
+		if err := flow.Value.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+
+	return flow
+}
+
+// SetFlow sets the value of a flow, removing it when zero.
+func (k QuotaKeeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyFlow(flow.Direction, flow.Denom, flow.ChannelId, flow.Bucket)
+	if !flow.Value.IsPositive() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := flow.Value.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func (k QuotaKeeper) parseFlow(key, value []byte) types.Flow {
+	direction, denom, channelID, bucket := types.ParseFlowKey(key)
+	flow := types.Flow{
+		Denom:     denom,
+		ChannelId: channelID,
+		Direction: direction,
+		Bucket:    bucket,
+	}
+	if err := flow.Value.Unmarshal(value); err != nil {
+		panic(err)
+	}
+	return flow
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/x/ibctransfer/keeper"
+	"github.com/umee-network/umee/v3/x/ibctransfer/types"
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+type mockOracleKeeper struct {
+	prices     map[string]sdk.Dec
+	acceptList oracletypes.DenomList
+}
+
+func (m mockOracleKeeper) GetExchangeRateBase(_ sdk.Context, denom string) (sdk.Dec, error) {
+	price, ok := m.prices[denom]
+	if !ok {
+		return sdk.ZeroDec(), oracletypes.ErrUnknownDenom.Wrap(denom)
+	}
+	return price, nil
+}
+
+func (m mockOracleKeeper) GetParams(sdk.Context) oracletypes.Params {
+	return oracletypes.Params{AcceptList: m.acceptList}
+}
+
+type mockLeverageKeeper struct {
+	tokens map[string]leveragetypes.Token
+}
+
+func (m mockLeverageKeeper) GetTokenSettings(_ sdk.Context, denom string) (leveragetypes.Token, error) {
+	token, ok := m.tokens[denom]
+	if !ok {
+		return leveragetypes.Token{}, leveragetypes.ErrNotRegisteredToken.Wrap(denom)
+	}
+	return token, nil
+}
+
+type mockICS4Wrapper struct {
+	sent []ibcexported.PacketI
+}
+
+func (m *mockICS4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	m.sent = append(m.sent, packet)
+	return nil
+}
+
+func setupQuotaKeeper(t *testing.T) (sdk.Context, keeper.QuotaKeeper, mockOracleKeeper, *mockICS4Wrapper) {
+	app := umeeapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	ics4 := &mockICS4Wrapper{}
+	ok := mockOracleKeeper{
+		prices: map[string]sdk.Dec{
+			"uatom": sdk.OneDec(),
+			"uosmo": sdk.MustNewDecFromStr("0.5"),
+		},
+		acceptList: oracletypes.DenomList{
+			{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+			{BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6},
+		},
+	}
+	lk := mockLeverageKeeper{tokens: map[string]leveragetypes.Token{
+		"ujuno": {BaseDenom: "ujuno", SymbolDenom: "JUNO", Exponent: 6},
+	}}
+	qk := keeper.NewQuotaKeeper(
+		app.GetKey(types.StoreKey),
+		app.GetSubspace(types.ModuleName),
+		ok,
+		lk,
+		ics4,
+	)
+	qk.SetParams(ctx, types.Params{
+		QuotaEnabled:  true,
+		QuotaWindow:   100,
+		WindowBuckets: 10,
+		Quotas: []types.Quota{
+			{Denom: "uatom", OutflowLimit: sdk.NewDec(100), InflowLimit: sdk.NewDec(50)},
+			{Denom: "ubar", OutflowLimit: sdk.NewDec(100), InflowLimit: sdk.ZeroDec()},
+			{ChannelId: "channel-0", OutflowLimit: sdk.NewDec(150), InflowLimit: sdk.ZeroDec()},
+		},
+	})
+
+	return ctx, qk, ok, ics4
+}
+
+func TestCheckAndRecordFlow(t *testing.T) {
+	ctx, qk, _, _ := setupQuotaKeeper(t)
+	atom := func(amount int64) sdk.Coin { return sdk.NewInt64Coin("uatom", amount) }
+
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(60)))
+	err := qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(50))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(40)))
+
+	// the directions are independent
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowIn, "channel-1", atom(50)))
+	err = qk.CheckAndRecordFlow(ctx, types.FlowIn, "channel-1", atom(1))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	// the channel quota sums the values of all denoms, and doesn't limit the
+	// inflows
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-0", sdk.NewInt64Coin("uosmo", 200)))
+	err = qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-0", sdk.NewInt64Coin("uosmo", 120))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowIn, "channel-0", sdk.NewInt64Coin("uosmo", 1000)))
+
+	// the tokens without price count for nothing against the channel quotas,
+	// but can't be transferred with a denom quota
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-0", sdk.NewInt64Coin("ufoo", 1000)))
+	err = qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", sdk.NewInt64Coin("ubar", 1))
+	require.ErrorIs(t, err, types.ErrNoQuotaPrice)
+
+	require.Equal(t, []types.QuotaUsage{{
+		Quota:   types.Quota{Denom: "uatom", OutflowLimit: sdk.NewDec(100), InflowLimit: sdk.NewDec(50)},
+		Outflow: sdk.NewDec(100),
+		Inflow:  sdk.NewDec(50),
+	}}, qk.QuotaUsage(ctx, "uatom", ""))
+	usage := qk.QuotaUsage(ctx, "", "channel-0")
+	require.Len(t, usage, 1)
+	require.Equal(t, sdk.NewDec(100), usage[0].Outflow)
+	require.Equal(t, sdk.NewDec(500), usage[0].Inflow)
+	require.Len(t, qk.QuotaUsage(ctx, "", ""), 3)
+
+	// the window rolls one bucket at a time
+	ctx = ctx.WithBlockTime(time.Unix(1050, 0))
+	err = qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(1))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(100)))
+
+	// a refunded outflow frees the quota
+	qk.RevertOutflow(ctx, "channel-1", atom(30))
+	require.Equal(t, sdk.NewDec(70), qk.QuotaUsage(ctx, "uatom", "")[0].Outflow)
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(30)))
+
+	// the expired flows are pruned
+	for _, flow := range qk.GetAllFlows(ctx) {
+		if flow.Denom == "uatom" && flow.Direction == types.FlowOut {
+			require.Equal(t, uint64(110), flow.Bucket)
+		}
+	}
+
+	// the disabled quotas don't limit the transfers
+	params := qk.GetParams(ctx)
+	params.QuotaEnabled = false
+	qk.SetParams(ctx, params)
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", atom(1000)))
+}
+
+func TestQuotaSendPacket(t *testing.T) {
+	ctx, qk, _, ics4 := setupQuotaKeeper(t)
+
+	mkPacket := func(denom, amount string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver")
+		return channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, "channel-1",
+			ibctransfertypes.PortID, "channel-9", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	require.NoError(t, qk.SendPacket(ctx, nil, mkPacket("uatom", "100")))
+	require.Len(t, ics4.sent, 1)
+
+	err := qk.SendPacket(ctx, nil, mkPacket("uatom", "1"))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	require.Len(t, ics4.sent, 1)
+
+	// the vouchers are valued in their local denom
+	voucher := ibctransfertypes.NewFungibleTokenPacketData("transfer/channel-1/uatom", "1000", "sender", "receiver")
+	amount, ok := keeper.SentCoin(voucher)
+	require.True(t, ok)
+	require.Equal(t, ibctransfertypes.ParseDenomTrace(voucher.Denom).IBCDenom(), amount.Denom)
+	require.NoError(t, qk.SendPacket(ctx, nil, mkPacket("transfer/channel-1/uatom", "1000")))
+	require.Len(t, ics4.sent, 2)
+}
+
+func TestCheckAndRecordFlowNoPrices(t *testing.T) {
+	ctx, qk, ok, _ := setupQuotaKeeper(t)
+
+	// the oracle has no exchange rate, e.g. after missing a vote period
+	for denom := range ok.prices {
+		delete(ok.prices, denom)
+	}
+
+	// the denoms registered in x/oracle or x/leverage can't be transferred
+	// without price, even through a channel quota only
+	err := qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-0", sdk.NewInt64Coin("uosmo", 1))
+	require.ErrorIs(t, err, types.ErrNoQuotaPrice)
+	err = qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-0", sdk.NewInt64Coin("ujuno", 1))
+	require.ErrorIs(t, err, types.ErrNoQuotaPrice)
+	err = qk.CheckAndRecordFlow(ctx, types.FlowIn, "channel-0", sdk.NewInt64Coin("uosmo", 1))
+	require.ErrorIs(t, err, types.ErrNoQuotaPrice)
+
+	// the other denoms count for nothing against the channel quotas
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-0", sdk.NewInt64Coin("ufoo", 1000)))
+	require.True(t, qk.QuotaUsage(ctx, "", "channel-0")[0].Outflow.IsZero())
+
+	// the transfers through channels without quota aren't valued
+	require.NoError(t, qk.CheckAndRecordFlow(ctx, types.FlowOut, "channel-1", sdk.NewInt64Coin("uosmo", 1)))
+}
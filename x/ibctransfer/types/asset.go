@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+)
+
+// DenomTrace returns the ICS-20 denom trace of the asset.
+func (a Asset) DenomTrace() ibctransfertypes.DenomTrace {
+	return ibctransfertypes.ParseDenomTrace(a.Trace)
+}
+
+// Validate performs validation on an Asset type returning an error if the
+// asset is invalid.
+func (a Asset) Validate() error {
+	trace := a.DenomTrace()
+	if err := trace.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAsset, err.Error())
+	}
+	if trace.Path == "" {
+		return ErrInvalidAsset.Wrapf("trace of a native token: %s", a.Trace)
+	}
+	if a.IbcDenom != trace.IBCDenom() {
+		return ErrInvalidAsset.Wrapf("ibc denom %s doesn't match the trace %s: %s", a.IbcDenom, a.Trace, trace.IBCDenom())
+	}
+	if err := sdk.ValidateDenom(a.Symbol); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAsset, err.Error())
+	}
+	if strings.TrimSpace(a.OriginChain) == "" {
+		return ErrInvalidAsset.Wrap("empty origin chain")
+	}
+
+	return nil
+}
+
+// Metadata returns the bank metadata of the asset. Its display denom is the
+// lower case symbol, unless the exponent is zero.
+func (a Asset) Metadata() banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("IBC transferred asset from %s", a.OriginChain),
+		Display:     a.IbcDenom,
+		Name:        a.Symbol,
+		Symbol:      a.Symbol,
+		Base:        a.IbcDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    a.IbcDenom,
+				Exponent: 0,
+				Aliases:  []string{a.DenomTrace().BaseDenom},
+			},
+		},
+	}
+
+	if a.Exponent > 0 {
+		display := strings.ToLower(a.Symbol)
+		metadata.Display = display
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: a.Exponent,
+		})
+	}
+
+	return metadata
+}
+
+// ValidateAssets returns an error if an asset is invalid or registered twice.
+func ValidateAssets(assets []Asset) error {
+	denoms := make(map[string]struct{}, len(assets))
+	for _, a := range assets {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if _, ok := denoms[a.IbcDenom]; ok {
+			return ErrInvalidAsset.Wrapf("duplicate asset: %s", a.IbcDenom)
+		}
+		denoms[a.IbcDenom] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetValidate(t *testing.T) {
+	trace := "transfer/channel-1/uatom"
+	valid := Asset{
+		IbcDenom:    ibctransfertypes.ParseDenomTrace(trace).IBCDenom(),
+		Symbol:      "ATOM",
+		Exponent:    6,
+		OriginChain: "cosmoshub-4",
+		Trace:       trace,
+	}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, valid.Metadata().Validate())
+
+	invalid := func(modify func(*Asset)) Asset {
+		a := valid
+		modify(&a)
+		return a
+	}
+	for name, a := range map[string]Asset{
+		"native trace":    invalid(func(a *Asset) { a.Trace = "uatom" }),
+		"invalid trace":   invalid(func(a *Asset) { a.Trace = "transfer/uatom" }),
+		"hash mismatch":   invalid(func(a *Asset) { a.Trace = "transfer/channel-2/uatom" }),
+		"empty symbol":    invalid(func(a *Asset) { a.Symbol = "" }),
+		"no origin chain": invalid(func(a *Asset) { a.OriginChain = " " }),
+	} {
+		require.ErrorIs(t, a.Validate(), ErrInvalidAsset, name)
+	}
+
+	require.ErrorIs(t, ValidateAssets([]Asset{valid, valid}), ErrInvalidAsset)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// uibc sentinel errors
+var (
+	ErrQuotaExceeded = sdkerrors.Register(ModuleName, 1, "IBC transfer quota exceeded")
+	ErrNoQuotaPrice  = sdkerrors.Register(ModuleName, 2, "no price to value the IBC transfer of a denom with a quota")
+	ErrInvalidAsset  = sdkerrors.Register(ModuleName, 3, "invalid IBC asset")
+	ErrAssetNotFound = sdkerrors.Register(ModuleName, 4, "IBC asset not registered")
+	ErrAssetExists   = sdkerrors.Register(ModuleName, 5, "IBC asset already registered")
+	ErrTokenMismatch = sdkerrors.Register(ModuleName, 6, "IBC asset doesn't match the leverage token registry")
+)
@@ -1,8 +1,13 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
 )
 
 // BankKeeper defines the expected x/bank keeper interface.
@@ -11,3 +16,22 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
 }
+
+// OracleKeeper defines the expected x/oracle keeper interface.
+type OracleKeeper interface {
+	GetExchangeRateBase(ctx sdk.Context, denom string) (sdk.Dec, error)
+	GetParams(ctx sdk.Context) oracletypes.Params
+}
+
+// LeverageKeeper defines the expected x/leverage keeper interface.
+type LeverageKeeper interface {
+	GetTokenSettings(ctx sdk.Context, denom string) (leveragetypes.Token, error)
+}
+
+// LeverageMsgServer defines the expected x/leverage message server interface,
+// used to run the memo instructions of the received transfers.
+type LeverageMsgServer interface {
+	Supply(context.Context, *leveragetypes.MsgSupply) (*leveragetypes.MsgSupplyResponse, error)
+	SupplyCollateral(context.Context, *leveragetypes.MsgSupplyCollateral) (*leveragetypes.MsgSupplyCollateralResponse, error)
+	Repay(context.Context, *leveragetypes.MsgRepay) (*leveragetypes.MsgRepayResponse, error)
+}
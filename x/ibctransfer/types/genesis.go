@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, flows []Flow, assets []Asset) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Flows:  flows,
+		Assets: assets,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state for the uibc module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the uibc genesis state.
+func ValidateGenesis(data *GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, flow := range data.Flows {
+		if flow.Direction != FlowOut && flow.Direction != FlowIn {
+			return fmt.Errorf("invalid flow direction: %s", flow.Direction)
+		}
+		if (flow.Denom == "") == (flow.ChannelId == "") {
+			return fmt.Errorf("flow must have exactly one of denom and channel id")
+		}
+		if flow.Value.IsNil() || flow.Value.IsNegative() {
+			return fmt.Errorf("flow value must not be negative: %s", flow.Value)
+		}
+	}
+
+	return ValidateAssets(data.Assets)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/util"
+)
+
+const (
+	// ModuleName defines the module name of the IBC transfer quotas
+	ModuleName = "uibc"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixFlow  = []byte{0x01} // prefix for each key to a quota flow
+	KeyPrefixAsset = []byte{0x02} // prefix for each key to a registered asset
+)
+
+// KeyFlowTarget returns the prefix of the flows of a quota in a direction.
+func KeyFlowTarget(direction FlowDirection, denom, channelID string) []byte {
+	return util.ConcatBytes(0, KeyPrefixFlow, []byte{byte(direction)}, lengthPrefix(denom), lengthPrefix(channelID))
+}
+
+// KeyFlow - stored by *direction*, *denom*, *channel* and *bucket*
+func KeyFlow(direction FlowDirection, denom, channelID string, bucket uint64) []byte {
+	return util.ConcatBytes(0, KeyFlowTarget(direction, denom, channelID), sdk.Uint64ToBigEndian(bucket))
+}
+
+// lengthPrefix prefixes a string with its length, including an empty one.
+func lengthPrefix(s string) []byte {
+	if len(s) > 255 {
+		panic(fmt.Sprintf("%s exceeds the max length of 255 bytes", s))
+	}
+	return util.ConcatBytes(0, []byte{byte(len(s))}, []byte(s))
+}
+
+// KeyAsset - stored by *ibc_denom*
+func KeyAsset(ibcDenom string) []byte {
+	// asset prefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixAsset, []byte(ibcDenom))
+}
+
+// ParseFlowKey returns the direction, denom, channel and bucket of a flow key.
+func ParseFlowKey(key []byte) (direction FlowDirection, denom, channelID string, bucket uint64) {
+	key = key[len(KeyPrefixFlow):]
+	direction = FlowDirection(key[0])
+	key = key[1:]
+	denom, key = string(key[1:1+key[0]]), key[1+key[0]:]
+	channelID, key = string(key[1:1+key[0]]), key[1+key[0]:]
+	return direction, denom, channelID, sdk.BigEndianToUint64(key)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// x/leverage actions of the memo of a received IBC transfer
+const (
+	// MemoActionSupply supplies the received tokens
+	MemoActionSupply = "supply"
+	// MemoActionSupplyCollateral supplies and collateralizes the received tokens
+	MemoActionSupplyCollateral = "supply_collateral"
+	// MemoActionRepay repays the borrow of the received tokens
+	MemoActionRepay = "repay"
+)
+
+// Memo is the JSON memo of an ICS-20 transfer received by the chain. For
+// example, `{"leverage":{"action":"supply_collateral"}}` supplies and
+// collateralizes the received tokens on behalf of the receiver.
+type Memo struct {
+	Leverage *LeverageMemo `json:"leverage,omitempty"`
+}
+
+// LeverageMemo instructs an x/leverage action on behalf of the receiver of a
+// transfer, using the received tokens.
+type LeverageMemo struct {
+	Action string `json:"action"`
+}
+
+// ParseMemo parses the memo of a received transfer. It returns false if the
+// memo has no x/leverage instruction, so the memos meant for other protocols
+// and the plain text memos are ignored.
+func ParseMemo(memo string) (Memo, bool) {
+	var m Memo
+	if memo == "" || json.Unmarshal([]byte(memo), &m) != nil || m.Leverage == nil {
+		return Memo{}, false
+	}
+	return m, true
+}
+
+// Validate returns an error if the action is unknown.
+func (m LeverageMemo) Validate() error {
+	switch m.Action {
+	case MemoActionSupply, MemoActionSupplyCollateral, MemoActionRepay:
+		return nil
+	default:
+		return fmt.Errorf("unknown leverage memo action: %q", m.Action)
+	}
+}
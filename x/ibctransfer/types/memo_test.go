@@ -0,0 +1,23 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemo(t *testing.T) {
+	for _, memo := range []string{"", "plain text", `{"forward":{}}`, `{"leverage":null}`, `["leverage"]`} {
+		_, ok := ParseMemo(memo)
+		require.False(t, ok, memo)
+	}
+
+	m, ok := ParseMemo(`{"leverage":{"action":"supply_collateral"}}`)
+	require.True(t, ok)
+	require.Equal(t, MemoActionSupplyCollateral, m.Leverage.Action)
+	require.NoError(t, m.Leverage.Validate())
+
+	m, ok = ParseMemo(`{"leverage":{}}`)
+	require.True(t, ok)
+	require.Error(t, m.Leverage.Validate())
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/umee-network/umee/v3/util/checkers"
+)
+
+var _ sdk.Msg = &MsgGovUpdateAssets{}
+
+// NewMsgGovUpdateAssets will create a new MsgGovUpdateAssets instance
+func NewMsgGovUpdateAssets(authority, title, description string, addAssets, updateAssets []Asset) *MsgGovUpdateAssets {
+	return &MsgGovUpdateAssets{
+		Authority:    authority,
+		Title:        title,
+		Description:  description,
+		AddAssets:    addAssets,
+		UpdateAssets: updateAssets,
+	}
+}
+
+// Type implements Msg
+func (msg MsgGovUpdateAssets) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// String implements the Stringer interface.
+func (msg MsgGovUpdateAssets) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGovUpdateAssets) ValidateBasic() error {
+	if err := checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority); err != nil {
+		return err
+	}
+
+	if err := ValidateAssets(msg.AddAssets); err != nil {
+		return sdkerrors.Wrap(err, "add assets")
+	}
+
+	if err := ValidateAssets(msg.UpdateAssets); err != nil {
+		return sdkerrors.Wrap(err, "update assets")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgGovUpdateAssets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgGovUpdateAssets) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
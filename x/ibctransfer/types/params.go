@@ -0,0 +1,177 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"gopkg.in/yaml.v3"
+)
+
+// Parameter keys
+var (
+	KeyQuotaEnabled  = []byte("QuotaEnabled")
+	KeyQuotaWindow   = []byte("QuotaWindow")
+	KeyWindowBuckets = []byte("WindowBuckets")
+	KeyQuotas        = []byte("Quotas")
+)
+
+// Default parameter values
+const (
+	// DefaultQuotaWindow defines a window of a day.
+	DefaultQuotaWindow = uint64(24 * 60 * 60)
+	// DefaultWindowBuckets defines hourly buckets.
+	DefaultWindowBuckets = uint32(24)
+)
+
+var _ paramstypes.ParamSet = &Params{}
+
+// DefaultParams creates default uibc module parameters: the quotas are enabled
+// over a day rolling hourly, but no quota is set.
+func DefaultParams() Params {
+	return Params{
+		QuotaEnabled:  true,
+		QuotaWindow:   DefaultQuotaWindow,
+		WindowBuckets: DefaultWindowBuckets,
+		Quotas:        []Quota{},
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of uibc module's parameters.
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyQuotaEnabled, &p.QuotaEnabled, validateBool),
+		paramstypes.NewParamSetPair(KeyQuotaWindow, &p.QuotaWindow, validateQuotaWindow),
+		paramstypes.NewParamSetPair(KeyWindowBuckets, &p.WindowBuckets, validateWindowBuckets),
+		paramstypes.NewParamSetPair(KeyQuotas, &p.Quotas, validateQuotas),
+	}
+}
+
+// String implements fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs basic validation on uibc parameters.
+func (p Params) Validate() error {
+	if err := validateQuotaWindow(p.QuotaWindow); err != nil {
+		return err
+	}
+	if err := validateWindowBuckets(p.WindowBuckets); err != nil {
+		return err
+	}
+	if p.QuotaWindow%uint64(p.WindowBuckets) != 0 {
+		return fmt.Errorf("quota window must be a multiple of the window buckets: %d, %d",
+			p.QuotaWindow, p.WindowBuckets)
+	}
+	return validateQuotas(p.Quotas)
+}
+
+// BucketLength returns the length (in seconds) of a bucket of the quota
+// window.
+func (p Params) BucketLength() uint64 {
+	return p.QuotaWindow / uint64(p.WindowBuckets)
+}
+
+// MatchingQuotas returns the quotas of the denom and of the channel.
+func (p Params) MatchingQuotas(denom, channelID string) []Quota {
+	var quotas []Quota
+	for _, q := range p.Quotas {
+		if (q.Denom != "" && q.Denom == denom) || (q.ChannelId != "" && q.ChannelId == channelID) {
+			quotas = append(quotas, q)
+		}
+	}
+	return quotas
+}
+
+// Limit returns the limit of the quota in the direction, zero for no limit.
+func (q Quota) Limit(direction FlowDirection) sdk.Dec {
+	if direction == FlowIn {
+		return q.InflowLimit
+	}
+	return q.OutflowLimit
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateQuotaWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("quota window must be positive")
+	}
+
+	return nil
+}
+
+func validateWindowBuckets(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("window buckets must be positive")
+	}
+
+	return nil
+}
+
+func validateQuotas(i interface{}) error {
+	v, ok := i.([]Quota)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	targets := make(map[string]struct{}, len(v))
+	for _, q := range v {
+		var target string
+		switch {
+		case q.Denom != "" && q.ChannelId != "":
+			return fmt.Errorf("quota must not have both a denom and a channel id: %s, %s", q.Denom, q.ChannelId)
+		case q.Denom != "":
+			if err := sdk.ValidateDenom(q.Denom); err != nil {
+				return err
+			}
+			target = "denom:" + q.Denom
+		case q.ChannelId != "":
+			if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+				return err
+			}
+			target = "channel:" + q.ChannelId
+		default:
+			return fmt.Errorf("quota must have a denom or a channel id")
+		}
+
+		if _, ok := targets[target]; ok {
+			return fmt.Errorf("duplicate quota: %s", target)
+		}
+		targets[target] = struct{}{}
+
+		if q.OutflowLimit.IsNil() || q.OutflowLimit.IsNegative() {
+			return fmt.Errorf("quota outflow limit must not be negative: %s", target)
+		}
+		if q.InflowLimit.IsNil() || q.InflowLimit.IsNegative() {
+			return fmt.Errorf("quota inflow limit must not be negative: %s", target)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	limit := sdk.NewDec(1000)
+	tcs := []struct {
+		name   string
+		quota  Quota
+		errMsg string
+	}{
+		{"no target", Quota{OutflowLimit: limit, InflowLimit: limit}, "must have a denom or a channel id"},
+		{
+			"both targets",
+			Quota{Denom: "uumee", ChannelId: "channel-0", OutflowLimit: limit, InflowLimit: limit},
+			"must not have both",
+		},
+		{"invalid denom", Quota{Denom: "1", OutflowLimit: limit, InflowLimit: limit}, "invalid denom"},
+		{"invalid channel", Quota{ChannelId: "c", OutflowLimit: limit, InflowLimit: limit}, "invalid"},
+		{"nil limit", Quota{Denom: "uumee", OutflowLimit: limit}, "inflow limit must not be negative"},
+		{
+			"negative limit",
+			Quota{Denom: "uumee", OutflowLimit: limit.Neg(), InflowLimit: limit},
+			"outflow limit must not be negative",
+		},
+	}
+	for _, tc := range tcs {
+		params := DefaultParams()
+		params.Quotas = []Quota{tc.quota}
+		require.ErrorContains(t, params.Validate(), tc.errMsg, tc.name)
+	}
+
+	params := DefaultParams()
+	params.Quotas = []Quota{
+		{Denom: "uumee", OutflowLimit: limit, InflowLimit: limit},
+		{ChannelId: "channel-0", OutflowLimit: limit, InflowLimit: limit},
+	}
+	require.NoError(t, params.Validate())
+	params.Quotas = append(params.Quotas, params.Quotas[1])
+	require.ErrorContains(t, params.Validate(), "duplicate quota")
+
+	params = DefaultParams()
+	params.WindowBuckets = 7
+	require.ErrorContains(t, params.Validate(), "multiple of the window buckets")
+	params.QuotaWindow = 0
+	require.ErrorContains(t, params.Validate(), "quota window must be positive")
+}
+
+func TestParseFlowKey(t *testing.T) {
+	key := KeyFlow(FlowIn, "uumee", "", 42)
+	direction, denom, channelID, bucket := ParseFlowKey(key)
+	require.Equal(t, FlowIn, direction)
+	require.Equal(t, "uumee", denom)
+	require.Equal(t, "", channelID)
+	require.Equal(t, uint64(42), bucket)
+
+	direction, denom, channelID, bucket = ParseFlowKey(KeyFlow(FlowOut, "", "channel-7", 1))
+	require.Equal(t, []interface{}{FlowOut, "", "channel-7", uint64(1)},
+		[]interface{}{direction, denom, channelID, bucket})
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+var (
+	proposalTypeMsgGovUpdateAssets = MsgGovUpdateAssets{}.Type()
+)
+
+func init() {
+	gov.RegisterProposalType(proposalTypeMsgGovUpdateAssets)
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &MsgGovUpdateAssets{}
+
+// GetTitle returns the title of an asset registry update proposal.
+func (msg *MsgGovUpdateAssets) GetTitle() string { return msg.Title }
+
+// GetDescription returns the description of an asset registry update proposal.
+func (msg *MsgGovUpdateAssets) GetDescription() string { return msg.Description }
+
+// ProposalRoute returns the routing key of an asset registry update proposal.
+func (msg *MsgGovUpdateAssets) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an asset registry update proposal.
+func (msg *MsgGovUpdateAssets) ProposalType() string { return proposalTypeMsgGovUpdateAssets }
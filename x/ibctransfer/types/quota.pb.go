@@ -0,0 +1,1446 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: umee/uibc/v1/quota.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FlowDirection defines the direction of an IBC transfer.
+type FlowDirection int32
+
+const (
+	// FLOW_DIRECTION_UNSPECIFIED defines an invalid direction.
+	FlowUnspecified FlowDirection = 0
+	// FLOW_DIRECTION_OUT defines the tokens sent to another chain.
+	FlowOut FlowDirection = 1
+	// FLOW_DIRECTION_IN defines the tokens received from another chain.
+	FlowIn FlowDirection = 2
+)
+
+var FlowDirection_name = map[int32]string{
+	0: "FLOW_DIRECTION_UNSPECIFIED",
+	1: "FLOW_DIRECTION_OUT",
+	2: "FLOW_DIRECTION_IN",
+}
+
+var FlowDirection_value = map[string]int32{
+	"FLOW_DIRECTION_UNSPECIFIED": 0,
+	"FLOW_DIRECTION_OUT":         1,
+	"FLOW_DIRECTION_IN":          2,
+}
+
+func (x FlowDirection) String() string {
+	return proto.EnumName(FlowDirection_name, int32(x))
+}
+
+func (FlowDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_651be1a0280abcb6, []int{0}
+}
+
+// Params defines the parameters of the IBC transfer quotas.
+type Params struct {
+	// quota_enabled enables the quotas. When disabled, the IBC transfers are
+	// neither limited nor recorded.
+	QuotaEnabled bool `protobuf:"varint,1,opt,name=quota_enabled,json=quotaEnabled,proto3" json:"quota_enabled,omitempty" yaml:"quota_enabled"`
+	// quota_window defines the length (in seconds) of the rolling window over
+	// which the transferred values are summed.
+	QuotaWindow uint64 `protobuf:"varint,2,opt,name=quota_window,json=quotaWindow,proto3" json:"quota_window,omitempty" yaml:"quota_window"`
+	// window_buckets defines the number of buckets the quota window is split
+	// into. The window rolls one bucket at a time.
+	WindowBuckets uint32 `protobuf:"varint,3,opt,name=window_buckets,json=windowBuckets,proto3" json:"window_buckets,omitempty" yaml:"window_buckets"`
+	// quotas defines the per denom and per channel quotas. The transfers of the
+	// denoms and channels without a quota are not limited.
+	Quotas []Quota `protobuf:"bytes,4,rep,name=quotas,proto3" json:"quotas" yaml:"quotas"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_651be1a0280abcb6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// Quota defines the USD value which can flow out of and into the chain over
+// the quota window, either for a denom or for a channel.
+type Quota struct {
+	// denom defines the local denom of the quota, e.g. uumee or ibc/... Exactly
+	// one of denom and channel_id must be set.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// channel_id defines the local channel of the quota, e.g. channel-0.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// outflow_limit defines the USD value which can be sent over the quota
+	// window. Zero means no limit.
+	OutflowLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=outflow_limit,json=outflowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"outflow_limit" yaml:"outflow_limit"`
+	// inflow_limit defines the USD value which can be received over the quota
+	// window. Zero means no limit.
+	InflowLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflow_limit,json=inflowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflow_limit" yaml:"inflow_limit"`
+}
+
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_651be1a0280abcb6, []int{1}
+}
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
+}
+func (m *Quota) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quota proto.InternalMessageInfo
+
+// Flow defines the USD value transferred in a direction for a quota during a
+// bucket of the quota window.
+type Flow struct {
+	// denom defines the denom of the quota, empty for a channel quota.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// channel_id defines the channel of the quota, empty for a denom quota.
+	ChannelId string        `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Direction FlowDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=umee.uibc.v1.FlowDirection" json:"direction,omitempty"`
+	// bucket defines the index of the bucket: the block time (in seconds)
+	// divided by the bucket length.
+	Bucket uint64                                 `protobuf:"varint,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Value  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *Flow) Reset()         { *m = Flow{} }
+func (m *Flow) String() string { return proto.CompactTextString(m) }
+func (*Flow) ProtoMessage()    {}
+func (*Flow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_651be1a0280abcb6, []int{2}
+}
+func (m *Flow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Flow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Flow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Flow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Flow.Merge(m, src)
+}
+func (m *Flow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Flow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Flow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Flow proto.InternalMessageInfo
+
+// QuotaUsage defines the USD values transferred for a quota over the current
+// quota window.
+type QuotaUsage struct {
+	Quota   Quota                                  `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota"`
+	Outflow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"outflow"`
+	Inflow  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflow"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_651be1a0280abcb6, []int{3}
+}
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("umee.uibc.v1.FlowDirection", FlowDirection_name, FlowDirection_value)
+	proto.RegisterType((*Params)(nil), "umee.uibc.v1.Params")
+	proto.RegisterType((*Quota)(nil), "umee.uibc.v1.Quota")
+	proto.RegisterType((*Flow)(nil), "umee.uibc.v1.Flow")
+	proto.RegisterType((*QuotaUsage)(nil), "umee.uibc.v1.QuotaUsage")
+}
+
+func init() { proto.RegisterFile("umee/uibc/v1/quota.proto", fileDescriptor_651be1a0280abcb6) }
+
+var fileDescriptor_651be1a0280abcb6 = []byte{
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0xb5, 0x83, 0x13, 0xc8, 0x24, 0xe1, 0x85, 0x01, 0xde, 0x33, 0x79, 0xaa, 0x9d, 0xba, 0x12,
+	0x8a, 0x2a, 0x11, 0x0b, 0xd2, 0x4d, 0xa3, 0x56, 0xaa, 0x4c, 0x82, 0x14, 0x09, 0x11, 0x30, 0x49,
+	0x91, 0xba, 0xb1, 0x1c, 0x7b, 0x08, 0x23, 0x12, 0x0f, 0x8d, 0xed, 0xa4, 0xfc, 0x41, 0xc5, 0xaa,
+	0x6a, 0x37, 0xdd, 0x20, 0x21, 0xf5, 0x17, 0xfa, 0x11, 0x2c, 0x51, 0x57, 0x55, 0x17, 0x56, 0x1b,
+	0x36, 0x95, 0xba, 0xcb, 0x17, 0x54, 0x9e, 0x31, 0x25, 0xa1, 0x62, 0x81, 0xc4, 0x2a, 0x39, 0xf7,
+	0xdc, 0x7b, 0xee, 0xdc, 0x73, 0xaf, 0x0c, 0x44, 0xbf, 0x8b, 0x90, 0xea, 0xe3, 0x96, 0xa5, 0xf6,
+	0x57, 0xd5, 0xd7, 0x3e, 0xf1, 0xcc, 0xe2, 0x51, 0x8f, 0x78, 0x04, 0xa6, 0x43, 0xa6, 0x18, 0x32,
+	0xc5, 0xfe, 0x6a, 0x6e, 0xc9, 0x22, 0x6e, 0x97, 0xb8, 0x06, 0xe5, 0x54, 0x06, 0x58, 0x62, 0x6e,
+	0xa1, 0x4d, 0xda, 0x84, 0xc5, 0xc3, 0x7f, 0x2c, 0xaa, 0x7c, 0x88, 0x81, 0xc4, 0xb6, 0xd9, 0x33,
+	0xbb, 0x2e, 0x7c, 0x0e, 0x32, 0x54, 0xd8, 0x40, 0x8e, 0xd9, 0xea, 0x20, 0x5b, 0xe4, 0xf3, 0x7c,
+	0x61, 0x46, 0x13, 0x47, 0x81, 0xbc, 0x70, 0x6c, 0x76, 0x3b, 0x65, 0x65, 0x82, 0x56, 0xf4, 0x34,
+	0xc5, 0x55, 0x06, 0x61, 0x19, 0x30, 0x6c, 0x0c, 0xb0, 0x63, 0x93, 0x81, 0x18, 0xcb, 0xf3, 0x05,
+	0x41, 0xfb, 0x6f, 0x14, 0xc8, 0xf3, 0xe3, 0xd5, 0x8c, 0x55, 0xf4, 0x14, 0x85, 0x7b, 0x14, 0xc1,
+	0x17, 0x60, 0x96, 0xc5, 0x8d, 0x96, 0x6f, 0x1d, 0x22, 0xcf, 0x15, 0xa7, 0xf2, 0x7c, 0x21, 0xa3,
+	0x2d, 0x8d, 0x02, 0x79, 0x91, 0x55, 0x4f, 0xf2, 0x8a, 0x9e, 0x61, 0x01, 0x8d, 0x61, 0xa8, 0x81,
+	0x04, 0x15, 0x74, 0x45, 0x21, 0x3f, 0x55, 0x48, 0xad, 0xcd, 0x17, 0xc7, 0x7d, 0x29, 0xee, 0x84,
+	0x9c, 0xb6, 0x78, 0x1e, 0xc8, 0xdc, 0x28, 0x90, 0x33, 0x63, 0x0f, 0x72, 0x15, 0x3d, 0xaa, 0x2c,
+	0xcf, 0x7c, 0x3c, 0x93, 0xb9, 0x9f, 0x67, 0x32, 0xaf, 0x04, 0x31, 0x10, 0xa7, 0x25, 0x70, 0x19,
+	0xc4, 0x6d, 0xe4, 0x90, 0x2e, 0x35, 0x23, 0xa9, 0x65, 0x47, 0x81, 0x9c, 0x66, 0xd5, 0x34, 0xac,
+	0xe8, 0x8c, 0x86, 0x4f, 0x00, 0xb0, 0x0e, 0x4c, 0xc7, 0x41, 0x1d, 0x03, 0xdb, 0x74, 0xf6, 0xa4,
+	0xb6, 0x38, 0x0a, 0xe4, 0x39, 0x96, 0x7c, 0xcd, 0x29, 0x7a, 0x32, 0x02, 0x35, 0x1b, 0x1e, 0x83,
+	0x0c, 0xf1, 0xbd, 0xfd, 0x0e, 0x19, 0x18, 0x1d, 0xdc, 0xc5, 0x1e, 0x1d, 0x3b, 0xa9, 0x35, 0xc2,
+	0x77, 0x7e, 0x0b, 0xe4, 0xe5, 0x36, 0xf6, 0x0e, 0xfc, 0x56, 0xd1, 0x22, 0xdd, 0x68, 0x97, 0xd1,
+	0xcf, 0x8a, 0x6b, 0x1f, 0xaa, 0xde, 0xf1, 0x11, 0x72, 0x8b, 0x15, 0x64, 0x5d, 0x2f, 0x68, 0x42,
+	0x4c, 0xf9, 0xf2, 0x79, 0x05, 0x44, 0x27, 0x50, 0x41, 0x96, 0x9e, 0x8e, 0xd8, 0xcd, 0x90, 0x84,
+	0x7d, 0x90, 0xc6, 0xce, 0x58, 0x67, 0x81, 0x76, 0xde, 0xbd, 0x73, 0xe7, 0x68, 0xb9, 0xd8, 0xb9,
+	0xbd, 0x71, 0x0a, 0x3b, 0x7f, 0xfa, 0x96, 0x05, 0x6a, 0xf0, 0x90, 0x07, 0xc2, 0x46, 0x87, 0x0c,
+	0xe0, 0xc2, 0x84, 0xbf, 0x57, 0x6e, 0x3e, 0xf8, 0xdb, 0xcd, 0x71, 0xdb, 0x9e, 0x82, 0xa4, 0x8d,
+	0x7b, 0xc8, 0xf2, 0x30, 0x71, 0xa8, 0x65, 0xb3, 0x6b, 0xff, 0x4f, 0xee, 0x3b, 0xd4, 0xae, 0x5c,
+	0xa5, 0xe8, 0xd7, 0xd9, 0xf0, 0x5f, 0x90, 0x60, 0x27, 0x44, 0x07, 0x16, 0xf4, 0x08, 0x41, 0x1d,
+	0xc4, 0xfb, 0x66, 0xc7, 0x47, 0x62, 0x9c, 0xfa, 0xf0, 0xec, 0x6e, 0x3e, 0xdc, 0x18, 0x98, 0x49,
+	0x29, 0xbf, 0x78, 0x00, 0xe8, 0x15, 0x35, 0x5d, 0xb3, 0x8d, 0xa0, 0x0a, 0xe2, 0xf4, 0xd0, 0xe8,
+	0xa8, 0xb7, 0x5c, 0xa8, 0x10, 0xf6, 0xd5, 0x59, 0x1e, 0x7c, 0x09, 0xa6, 0xa3, 0x95, 0x89, 0xb1,
+	0x7b, 0x78, 0xd5, 0x95, 0x18, 0x6c, 0x80, 0x04, 0xdb, 0x88, 0x38, 0x75, 0x0f, 0xb2, 0x91, 0xd6,
+	0xe3, 0xf7, 0x3c, 0xc8, 0x4c, 0xd8, 0x0e, 0x4b, 0x20, 0xb7, 0xb1, 0x59, 0xdf, 0x33, 0x2a, 0x35,
+	0xbd, 0xba, 0xde, 0xa8, 0xd5, 0xb7, 0x8c, 0xe6, 0xd6, 0xee, 0x76, 0x75, 0xbd, 0xb6, 0x51, 0xab,
+	0x56, 0xb2, 0x5c, 0x6e, 0xfe, 0xe4, 0x34, 0xff, 0x4f, 0x58, 0xd2, 0x74, 0xdc, 0x23, 0x64, 0xe1,
+	0x7d, 0x8c, 0x6c, 0xf8, 0x08, 0xc0, 0x1b, 0x45, 0xf5, 0x66, 0x23, 0xcb, 0xe7, 0x52, 0x27, 0xa7,
+	0xf9, 0xe9, 0x30, 0xb9, 0xee, 0x7b, 0xf0, 0x21, 0x98, 0xbb, 0x91, 0x54, 0xdb, 0xca, 0xc6, 0x72,
+	0xe0, 0xe4, 0x34, 0x9f, 0x08, 0x73, 0x6a, 0x4e, 0x4e, 0x78, 0xfb, 0x49, 0xe2, 0xb4, 0x9d, 0xf3,
+	0x1f, 0x12, 0x77, 0x3e, 0x94, 0xf8, 0x8b, 0xa1, 0xc4, 0x7f, 0x1f, 0x4a, 0xfc, 0xbb, 0x4b, 0x89,
+	0xbb, 0xb8, 0x94, 0xb8, 0xaf, 0x97, 0x12, 0xf7, 0xaa, 0x34, 0x36, 0x70, 0xb8, 0x8c, 0x15, 0x07,
+	0x79, 0x03, 0xd2, 0x3b, 0xa4, 0x40, 0xed, 0x97, 0xd4, 0x37, 0x2a, 0x6e, 0x59, 0x5e, 0xcf, 0x74,
+	0xdc, 0x7d, 0xd4, 0x63, 0x0e, 0xb4, 0x12, 0xf4, 0xc3, 0x59, 0xfa, 0x3d, 0x00, 0xe9, 0x96, 0xb2,
+	0xb6, 0x93, 0x05, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.QuotaEnabled != that1.QuotaEnabled {
+		return false
+	}
+	if this.QuotaWindow != that1.QuotaWindow {
+		return false
+	}
+	if this.WindowBuckets != that1.WindowBuckets {
+		return false
+	}
+	if len(this.Quotas) != len(that1.Quotas) {
+		return false
+	}
+	for i := range this.Quotas {
+		if !this.Quotas[i].Equal(&that1.Quotas[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Quota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Quota)
+	if !ok {
+		that2, ok := that.(Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if !this.OutflowLimit.Equal(that1.OutflowLimit) {
+		return false
+	}
+	if !this.InflowLimit.Equal(that1.InflowLimit) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuota(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WindowBuckets != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.WindowBuckets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuotaWindow != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.QuotaWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QuotaEnabled {
+		i--
+		if m.QuotaEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflowLimit.Size()
+		i -= size
+		if _, err := m.InflowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OutflowLimit.Size()
+		i -= size
+		if _, err := m.OutflowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Flow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Flow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Bucket != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Direction != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuotaEnabled {
+		n += 2
+	}
+	if m.QuotaWindow != 0 {
+		n += 1 + sovQuota(uint64(m.QuotaWindow))
+	}
+	if m.WindowBuckets != 0 {
+		n += 1 + sovQuota(uint64(m.WindowBuckets))
+	}
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovQuota(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = m.OutflowLimit.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.InflowLimit.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	return n
+}
+
+func (m *Flow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuota(uint64(m.Direction))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovQuota(uint64(m.Bucket))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quota.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.Inflow.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	return n
+}
+
+func sovQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuota(x uint64) (n int) {
+	return sovQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuotaEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaWindow", wireType)
+			}
+			m.QuotaWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBuckets", wireType)
+			}
+			m.WindowBuckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBuckets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, Quota{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Flow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= FlowDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuota = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,788 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: umee/uibc/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgGovUpdateAssets defines the Msg/GovUpdateAssets request type.
+type MsgGovUpdateAssets struct {
+	// authority is the address of the governance account.
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// add_assets defines new assets.
+	AddAssets []Asset `protobuf:"bytes,4,rep,name=add_assets,json=addAssets,proto3" json:"add_assets"`
+	// update_assets defines the new metadata of registered assets.
+	UpdateAssets []Asset `protobuf:"bytes,5,rep,name=update_assets,json=updateAssets,proto3" json:"update_assets"`
+}
+
+func (m *MsgGovUpdateAssets) Reset()      { *m = MsgGovUpdateAssets{} }
+func (*MsgGovUpdateAssets) ProtoMessage() {}
+func (*MsgGovUpdateAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1982abc7d531f4dc, []int{0}
+}
+func (m *MsgGovUpdateAssets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateAssets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateAssets.Merge(m, src)
+}
+func (m *MsgGovUpdateAssets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateAssets proto.InternalMessageInfo
+
+func (*MsgGovUpdateAssets) XXX_MessageName() string {
+	return "umee.uibc.v1.MsgGovUpdateAssets"
+}
+
+// MsgGovUpdateAssetsResponse defines the Msg/GovUpdateAssets response type.
+type MsgGovUpdateAssetsResponse struct {
+}
+
+func (m *MsgGovUpdateAssetsResponse) Reset()         { *m = MsgGovUpdateAssetsResponse{} }
+func (m *MsgGovUpdateAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateAssetsResponse) ProtoMessage()    {}
+func (*MsgGovUpdateAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1982abc7d531f4dc, []int{1}
+}
+func (m *MsgGovUpdateAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGovUpdateAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateAssetsResponse.Merge(m, src)
+}
+func (m *MsgGovUpdateAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateAssetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateAssetsResponse proto.InternalMessageInfo
+
+func (*MsgGovUpdateAssetsResponse) XXX_MessageName() string {
+	return "umee.uibc.v1.MsgGovUpdateAssetsResponse"
+}
+func init() {
+	proto.RegisterType((*MsgGovUpdateAssets)(nil), "umee.uibc.v1.MsgGovUpdateAssets")
+	proto.RegisterType((*MsgGovUpdateAssetsResponse)(nil), "umee.uibc.v1.MsgGovUpdateAssetsResponse")
+}
+
+func init() { proto.RegisterFile("umee/uibc/v1/tx.proto", fileDescriptor_1982abc7d531f4dc) }
+
+var fileDescriptor_1982abc7d531f4dc = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0x4e, 0xb6, 0xbb, 0x42, 0x67, 0x57, 0x84, 0x58, 0x35, 0x06, 0x99, 0x86, 0x3d, 0x15, 0x61,
+	0x33, 0xec, 0x16, 0x44, 0x3c, 0x08, 0xdb, 0x8b, 0xa7, 0xbd, 0x44, 0x7a, 0x11, 0xa4, 0x24, 0x99,
+	0x71, 0x3a, 0x68, 0x32, 0x61, 0xde, 0x49, 0x6c, 0xaf, 0xfe, 0x02, 0x4f, 0xe2, 0xb1, 0x3f, 0xc1,
+	0x83, 0x3f, 0x22, 0xc7, 0xe2, 0xc9, 0x93, 0x68, 0x73, 0xd0, 0x9f, 0x21, 0xf9, 0x28, 0x6d, 0xb7,
+	0x87, 0xde, 0xf2, 0x7c, 0xbc, 0xf3, 0x3c, 0xef, 0x1b, 0xf4, 0x20, 0x8b, 0x19, 0x23, 0x99, 0x08,
+	0x23, 0x92, 0x5f, 0x12, 0x3d, 0xf3, 0x52, 0x25, 0xb5, 0xb4, 0xce, 0x2a, 0xda, 0xab, 0x68, 0x2f,
+	0xbf, 0x74, 0x1e, 0x45, 0x12, 0x62, 0x09, 0x24, 0x06, 0x5e, 0xb9, 0x62, 0xe0, 0x8d, 0xcd, 0x79,
+	0xdc, 0x08, 0x93, 0x1a, 0x91, 0x06, 0xb4, 0x52, 0x8f, 0x4b, 0x2e, 0x1b, 0xbe, 0xfa, 0x6a, 0x59,
+	0x7b, 0x27, 0x2e, 0x00, 0x60, 0xba, 0x51, 0xce, 0xbf, 0x1c, 0x21, 0xeb, 0x06, 0xf8, 0x2b, 0x99,
+	0x8f, 0x53, 0x1a, 0x68, 0x76, 0x5d, 0x69, 0x60, 0x3d, 0x43, 0xdd, 0x20, 0xd3, 0x53, 0xa9, 0x84,
+	0x9e, 0xdb, 0xa6, 0x6b, 0x0e, 0xba, 0x23, 0xfb, 0xc7, 0xf7, 0x8b, 0x5e, 0x9b, 0x75, 0x4d, 0xa9,
+	0x62, 0x00, 0xaf, 0xb5, 0x12, 0x09, 0xf7, 0x37, 0x56, 0xab, 0x87, 0x4e, 0xb4, 0xd0, 0x1f, 0x98,
+	0x7d, 0x54, 0xcd, 0xf8, 0x0d, 0xb0, 0x5c, 0x74, 0x4a, 0x19, 0x44, 0x4a, 0xa4, 0x5a, 0xc8, 0xc4,
+	0xee, 0xd4, 0xda, 0x36, 0x65, 0x3d, 0x47, 0x28, 0xa0, 0x74, 0x52, 0x37, 0x03, 0xfb, 0xd8, 0xed,
+	0x0c, 0x4e, 0xaf, 0xee, 0x7b, 0xdb, 0xd7, 0xf0, 0xea, 0x66, 0xa3, 0xe3, 0xe2, 0x57, 0xdf, 0xf0,
+	0xbb, 0x01, 0xa5, 0x6d, 0xd3, 0x97, 0xe8, 0x6e, 0x56, 0x37, 0x5f, 0x0f, 0x9f, 0x1c, 0x1a, 0x3e,
+	0xcb, 0xb6, 0x36, 0x7d, 0xf1, 0xf0, 0xeb, 0xa2, 0x6f, 0xfc, 0x5b, 0xf4, 0xcd, 0x4f, 0x7f, 0xbf,
+	0x3d, 0xdd, 0x6c, 0x72, 0xfe, 0x04, 0x39, 0xfb, 0x77, 0xf1, 0x19, 0xa4, 0x32, 0x01, 0x76, 0x45,
+	0x51, 0xe7, 0x06, 0xb8, 0xf5, 0x16, 0xdd, 0xbb, 0x7d, 0x39, 0x77, 0x37, 0x78, 0xff, 0x0d, 0x67,
+	0x70, 0xc8, 0xb1, 0x4e, 0x19, 0x8d, 0x8b, 0x3f, 0xd8, 0x28, 0x56, 0xd8, 0x5c, 0xae, 0xb0, 0xf9,
+	0x7b, 0x85, 0xcd, 0xcf, 0x25, 0x36, 0x8a, 0x12, 0x9b, 0xcb, 0x12, 0x1b, 0x3f, 0x4b, 0x6c, 0xbc,
+	0x19, 0x72, 0xa1, 0xa7, 0x59, 0xe8, 0x45, 0x32, 0x26, 0xd5, 0xab, 0x17, 0x09, 0xd3, 0x1f, 0xa5,
+	0x7a, 0x5f, 0x03, 0x92, 0x0f, 0xc9, 0x8c, 0x88, 0x30, 0xd2, 0x2a, 0x48, 0xe0, 0x1d, 0x53, 0x44,
+	0xcf, 0x53, 0x06, 0xe1, 0x9d, 0xfa, 0xd7, 0x0f, 0xff, 0x0f, 0x00, 0xc4, 0xa8, 0xeb, 0x8a, 0x85,
+	0x02, 0x00, 0x00,
+}
+
+func (this *MsgGovUpdateAssets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGovUpdateAssets)
+	if !ok {
+		that2, ok := that.(MsgGovUpdateAssets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.AddAssets) != len(that1.AddAssets) {
+		return false
+	}
+	for i := range this.AddAssets {
+		if !this.AddAssets[i].Equal(&that1.AddAssets[i]) {
+			return false
+		}
+	}
+	if len(this.UpdateAssets) != len(that1.UpdateAssets) {
+		return false
+	}
+	for i := range this.UpdateAssets {
+		if !this.UpdateAssets[i].Equal(&that1.UpdateAssets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// GovUpdateAssets adds and updates the assets of the IBC asset registry.
+	GovUpdateAssets(ctx context.Context, in *MsgGovUpdateAssets, opts ...grpc.CallOption) (*MsgGovUpdateAssetsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) GovUpdateAssets(ctx context.Context, in *MsgGovUpdateAssets, opts ...grpc.CallOption) (*MsgGovUpdateAssetsResponse, error) {
+	out := new(MsgGovUpdateAssetsResponse)
+	err := c.cc.Invoke(ctx, "/umee.uibc.v1.Msg/GovUpdateAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// GovUpdateAssets adds and updates the assets of the IBC asset registry.
+	GovUpdateAssets(context.Context, *MsgGovUpdateAssets) (*MsgGovUpdateAssetsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) GovUpdateAssets(ctx context.Context, req *MsgGovUpdateAssets) (*MsgGovUpdateAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateAssets not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_GovUpdateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateAssets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.uibc.v1.Msg/GovUpdateAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateAssets(ctx, req.(*MsgGovUpdateAssets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "umee.uibc.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GovUpdateAssets",
+			Handler:    _Msg_GovUpdateAssets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "umee/uibc/v1/tx.proto",
+}
+
+func (m *MsgGovUpdateAssets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateAssets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateAssets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateAssets) > 0 {
+		for iNdEx := len(m.UpdateAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddAssets) > 0 {
+		for iNdEx := len(m.AddAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGovUpdateAssets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddAssets) > 0 {
+		for _, e := range m.AddAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UpdateAssets) > 0 {
+		for _, e := range m.UpdateAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovUpdateAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGovUpdateAssets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateAssets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateAssets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAssets = append(m.AddAssets, Asset{})
+			if err := m.AddAssets[len(m.AddAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateAssets = append(m.UpdateAssets, Asset{})
+			if err := m.UpdateAssets[len(m.UpdateAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovUpdateAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v3/util/cli"
+	"github.com/umee-network/umee/v3/x/incentive"
+)
+
+// GetQueryCmd returns the CLI query commands for the x/incentive module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        incentive.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", incentive.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryPendingRewards(),
+		GetCmdQueryBonded(),
+		GetCmdQueryUnbondings(),
+		GetCmdQueryTotalBonded(),
+		GetCmdQueryUpcomingPrograms(),
+		GetCmdQueryOngoingPrograms(),
+		GetCmdQueryCompletedPrograms(),
+		GetCmdQueryProgram(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams creates a Cobra command to query for the x/incentive
+// module parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the x/incentive module parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &incentive.QueryParams{})
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingRewards creates a Cobra command to query for the
+// unclaimed rewards of an account.
+func GetCmdQueryPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the unclaimed incentive rewards of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			req := &incentive.QueryPendingRewards{
+				Address: args[0],
+			}
+			resp, err := queryClient.PendingRewards(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBonded creates a Cobra command to query for the bonded uTokens
+// of an account.
+func GetCmdQueryBonded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bonded [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the uToken collateral bonded by an address, by tier",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			req := &incentive.QueryBonded{
+				Address: args[0],
+			}
+			resp, err := queryClient.Bonded(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnbondings creates a Cobra command to query for the ongoing
+// unbondings of an account.
+func GetCmdQueryUnbondings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbondings [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the ongoing uToken unbondings of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			req := &incentive.QueryUnbondings{
+				Address: args[0],
+			}
+			resp, err := queryClient.Unbondings(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalBonded creates a Cobra command to query for the total
+// bonded uTokens of all accounts.
+func GetCmdQueryTotalBonded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-bonded",
+		Args:  cobra.NoArgs,
+		Short: "Query for the total uToken collateral bonded, by tier",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			resp, err := queryClient.TotalBonded(cmd.Context(), &incentive.QueryTotalBonded{})
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpcomingPrograms creates a Cobra command to query for the
+// incentive programs passed by governance but not yet started.
+func GetCmdQueryUpcomingPrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-programs",
+		Args:  cobra.NoArgs,
+		Short: "Query for the incentive programs passed by governance but not yet started",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			resp, err := queryClient.UpcomingIncentivePrograms(cmd.Context(), &incentive.QueryUpcomingIncentivePrograms{})
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOngoingPrograms creates a Cobra command to query for the
+// funded incentive programs which started but are not yet completed.
+func GetCmdQueryOngoingPrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ongoing-programs",
+		Args:  cobra.NoArgs,
+		Short: "Query for the funded incentive programs which started but are not yet completed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			resp, err := queryClient.OngoingIncentivePrograms(cmd.Context(), &incentive.QueryOngoingIncentivePrograms{})
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCompletedPrograms creates a Cobra command to query for the
+// incentive programs which ran to completion or expired unfunded.
+func GetCmdQueryCompletedPrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completed-programs",
+		Args:  cobra.NoArgs,
+		Short: "Query for the incentive programs which ran to completion or expired unfunded",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			req := &incentive.QueryCompletedIncentivePrograms{
+				Pagination: pageReq,
+			}
+			resp, err := queryClient.CompletedIncentivePrograms(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "completed-programs")
+
+	return cmd
+}
+
+// GetCmdQueryProgram creates a Cobra command to query for a single incentive
+// program by ID.
+func GetCmdQueryProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "program [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for a single incentive program by ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid program id %s: %w", args[0], err)
+			}
+
+			queryClient := incentive.NewQueryClient(clientCtx)
+			req := &incentive.QueryIncentiveProgram{
+				Id: uint32(id),
+			}
+			resp, err := queryClient.IncentiveProgram(cmd.Context(), req)
+			return cli.PrintOrErr(resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,270 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v3/x/incentive"
+)
+
+// Flag constants
+const (
+	FlagTier              = "tier"
+	FlagFromCommunityFund = "from-community-fund"
+)
+
+// GetTxCmd returns the CLI transaction commands for the x/incentive module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        incentive.ModuleName,
+		Short:                      fmt.Sprintf("Transaction commands for the %s module", incentive.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdClaim(),
+		GetCmdBond(),
+		GetCmdBeginUnbonding(),
+		GetCmdSponsor(),
+		GetCmdGovCreateProgram(),
+	)
+
+	return cmd
+}
+
+// GetCmdClaim creates a Cobra command to generate or broadcast a
+// transaction with a MsgClaim message.
+func GetCmdClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Args:  cobra.NoArgs,
+		Short: "Claim all the pending incentive rewards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := incentive.NewMsgClaim(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBond creates a Cobra command to generate or broadcast a
+// transaction with a MsgBond message.
+func GetCmdBond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Bond a specified amount of uToken collateral to an unbonding tier",
+		Example: fmt.Sprintf(
+			"umeed tx %s bond 1000u/uumee --%s 1 --from mykey", incentive.ModuleName, FlagTier,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asset, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			tier, err := cmd.Flags().GetUint32(FlagTier)
+			if err != nil {
+				return err
+			}
+
+			msg := incentive.NewMsgBond(clientCtx.GetFromAddress(), tier, asset)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTierFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBeginUnbonding creates a Cobra command to generate or broadcast a
+// transaction with a MsgBeginUnbonding message.
+func GetCmdBeginUnbonding() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-unbonding [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Begin unbonding a specified amount of bonded uToken collateral from an unbonding tier",
+		Example: fmt.Sprintf(
+			"umeed tx %s begin-unbonding 1000u/uumee --%s 1 --from mykey", incentive.ModuleName, FlagTier,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asset, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			tier, err := cmd.Flags().GetUint32(FlagTier)
+			if err != nil {
+				return err
+			}
+
+			msg := incentive.NewMsgBeginUnbonding(clientCtx.GetFromAddress(), tier, asset)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTierFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSponsor creates a Cobra command to generate or broadcast a
+// transaction with a MsgSponsor message.
+func GetCmdSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor [program-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Fund the total rewards of an upcoming incentive program passed by governance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			programID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid program id %s: %w", args[0], err)
+			}
+			asset, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := incentive.NewMsgSponsor(clientCtx.GetFromAddress(), uint32(programID), asset)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdGovCreateProgram creates a Cobra command to generate or broadcast a
+// transaction submitting a governance proposal with a MsgGovCreateProgram
+// message, the incentive program being read from a JSON file.
+func GetCmdGovCreateProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-create-program [program-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to create an incentive program",
+		Long: fmt.Sprintf(`Submit a governance proposal to create an incentive program.
+The program is funded from the community fund when --%s is set,
+or by a sponsor otherwise. Where program.json contains:
+
+{
+  "start_time": "1680000000",
+  "duration": "2592000",
+  "denom": "u/uumee",
+  "total_rewards": {"denom": "uumee", "amount": "1000000000"},
+  "funded_rewards": {"denom": "uumee", "amount": "0"},
+  "remaining_rewards": {"denom": "uumee", "amount": "0"}
+}
+`, FlagFromCommunityFund),
+		Example: fmt.Sprintf(
+			"umeed tx %s gov-create-program program.json --%s=\"Incentive program\" --%s=\"Rewards u/uumee\" --%s=10000000uumee --from mykey",
+			incentive.ModuleName, govcli.FlagTitle, govcli.FlagDescription, govcli.FlagDeposit,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var program incentive.IncentiveProgram
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &program); err != nil {
+				return fmt.Errorf("failed to parse incentive program: %w", err)
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			fromCommunityFund, err := cmd.Flags().GetBool(FlagFromCommunityFund)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := incentive.NewMsgCreateProgram(authority, title, description, program)
+			msg.FromCommunityFund = fromCommunityFund
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "",
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().Bool(FlagFromCommunityFund, false, "Fund the program from the community fund")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addTierFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagTier, 0, "The unbonding tier (1, 2 or 3)")
+	_ = cmd.MarkFlagRequired(FlagTier)
+}
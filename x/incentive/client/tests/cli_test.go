@@ -0,0 +1,68 @@
+//go:build norace
+// +build norace
+
+package tests
+
+import (
+	"testing"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/x/incentive"
+)
+
+func TestIntegrationTestSuite(t *testing.T) {
+	cfg := umeeapp.IntegrationTestNetworkConfig()
+	cfg.NumValidators = 2
+	cfg.Mnemonics = []string{
+		"empower ridge mystery shrimp predict alarm swear brick across funny vendor essay antique vote place lava proof gaze crush head east arch twin lady",
+		"clean target advice dirt onion correct original vibrant actor upon waste eternal color barely shrimp aspect fall material wait repeat bench demise length seven",
+	}
+
+	var gravityGenState gravitytypes.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[gravitytypes.ModuleName], &gravityGenState))
+
+	// the orchestrators are the validator accounts, which are derived from
+	// the mnemonics with the configured address prefixes
+	gravityGenState.DelegateKeys = []gravitytypes.MsgSetOrchestratorAddress{
+		delegateKey(t, cfg.Mnemonics[0], "0x9fc56f2e851e1ab2b4c0fc4f6344800f29652ffe"),
+		delegateKey(t, cfg.Mnemonics[1], "0xddfda961410b2815b48679377baa0009ace173a2"),
+	}
+
+	bz, err := cfg.Codec.MarshalJSON(&gravityGenState)
+	require.NoError(t, err)
+
+	cfg.GenesisState[gravitytypes.ModuleName] = bz
+
+	// x/incentive isn't an app module yet, so like umeed the validator clients
+	// use their own codec with its messages registered
+	clientEncCfg := umeeapp.MakeEncodingConfig()
+	incentive.RegisterLegacyAminoCodec(clientEncCfg.Amino)
+	incentive.RegisterInterfaces(clientEncCfg.InterfaceRegistry)
+	cfg.Codec = clientEncCfg.Codec
+	cfg.TxConfig = clientEncCfg.TxConfig
+	cfg.LegacyAmino = clientEncCfg.Amino
+	cfg.InterfaceRegistry = clientEncCfg.InterfaceRegistry
+
+	suite.Run(t, NewIntegrationTestSuite(cfg))
+}
+
+// delegateKey returns the Gravity delegate keys of the validator created from
+// a mnemonic by the test network.
+func delegateKey(t *testing.T, mnemonic, ethAddress string) gravitytypes.MsgSetOrchestratorAddress {
+	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullBIP44Path())
+	require.NoError(t, err)
+	addr := sdk.AccAddress(hd.Secp256k1.Generate()(derivedPriv).PubKey().Address())
+
+	return gravitytypes.MsgSetOrchestratorAddress{
+		Validator:    sdk.ValAddress(addr).String(),
+		Orchestrator: addr.String(),
+		EthAddress:   ethAddress,
+	}
+}
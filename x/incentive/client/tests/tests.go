@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/umee-network/umee/v3/x/incentive"
+	"github.com/umee-network/umee/v3/x/incentive/client/cli"
+)
+
+// The valid queries and broadcast transactions require the incentive module to
+// be registered in the app, so only the invalid arguments and generated
+// transactions are covered for now.
+
+func (s *IntegrationTestSuite) TestInvalidQueries() {
+	invalidQueries := []testQuery{
+		{
+			"query pending rewards - invalid address",
+			cli.GetCmdQueryPendingRewards(),
+			[]string{
+				"xyz",
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"query bonded - invalid address",
+			cli.GetCmdQueryBonded(),
+			[]string{
+				"xyz",
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"query unbondings - invalid address",
+			cli.GetCmdQueryUnbondings(),
+			[]string{
+				"xyz",
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"query program - invalid program id",
+			cli.GetCmdQueryProgram(),
+			[]string{
+				"abc",
+			},
+			true,
+			nil,
+			nil,
+		},
+	}
+
+	s.runTestQueries(invalidQueries...)
+}
+
+func (s *IntegrationTestSuite) TestInvalidTransactions() {
+	funded := s.writeProgramFile("funded.json", `{
+  "start_time": "1680000000",
+  "duration": "2592000",
+  "denom": "u/uumee",
+  "total_rewards": {"denom": "uumee", "amount": "1000000"},
+  "funded_rewards": {"denom": "uumee", "amount": "1000"},
+  "remaining_rewards": {"denom": "uumee", "amount": "0"}
+}`)
+	withID := s.writeProgramFile("with-id.json", `{
+  "id": 3,
+  "start_time": "1680000000",
+  "duration": "2592000",
+  "denom": "u/uumee",
+  "total_rewards": {"denom": "uumee", "amount": "1000000"},
+  "funded_rewards": {"denom": "uumee", "amount": "0"},
+  "remaining_rewards": {"denom": "uumee", "amount": "0"}
+}`)
+	proposalFlags := []string{
+		fmt.Sprintf("--%s=%s", govcli.FlagTitle, "title"),
+		fmt.Sprintf("--%s=%s", govcli.FlagDescription, "description"),
+		fmt.Sprintf("--%s=%s", govcli.FlagDeposit, "10000000uumee"),
+	}
+
+	invalidTxs := []testTransaction{
+		{
+			"bond - tier 0",
+			cli.GetCmdBond(),
+			[]string{
+				"1000u/uumee",
+				fmt.Sprintf("--%s=0", cli.FlagTier),
+			},
+			incentive.ErrInvalidTier,
+		},
+		{
+			"bond - tier 4",
+			cli.GetCmdBond(),
+			[]string{
+				"1000u/uumee",
+				fmt.Sprintf("--%s=4", cli.FlagTier),
+			},
+			incentive.ErrInvalidTier,
+		},
+		{
+			"begin unbonding - tier 4",
+			cli.GetCmdBeginUnbonding(),
+			[]string{
+				"1000u/uumee",
+				fmt.Sprintf("--%s=4", cli.FlagTier),
+			},
+			incentive.ErrInvalidTier,
+		},
+		{
+			"sponsor - program 0",
+			cli.GetCmdSponsor(),
+			[]string{
+				"0",
+				"1000000uumee",
+			},
+			incentive.ErrInvalidProgramID,
+		},
+		{
+			"gov create program - nonzero funded rewards",
+			cli.GetCmdGovCreateProgram(),
+			append([]string{funded}, proposalFlags...),
+			incentive.ErrNonzeroFundedRewards,
+		},
+		{
+			"gov create program - nonzero program id",
+			cli.GetCmdGovCreateProgram(),
+			append([]string{withID}, proposalFlags...),
+			incentive.ErrInvalidProgramID,
+		},
+	}
+
+	s.runTestTransactions(invalidTxs...)
+}
+
+func (s *IntegrationTestSuite) TestGenerateTransactions() {
+	val := s.network.Validators[0]
+
+	programFile := s.writeProgramFile("program.json", `{
+  "start_time": "1680000000",
+  "duration": "2592000",
+  "denom": "u/uumee",
+  "total_rewards": {"denom": "uumee", "amount": "1000000"},
+  "funded_rewards": {"denom": "uumee", "amount": "0"},
+  "remaining_rewards": {"denom": "uumee", "amount": "0"}
+}`)
+	program := incentive.IncentiveProgram{
+		StartTime:        1680000000,
+		Duration:         2592000,
+		Denom:            "u/uumee",
+		TotalRewards:     sdk.NewInt64Coin("uumee", 1000000),
+		FundedRewards:    sdk.NewInt64Coin("uumee", 0),
+		RemainingRewards: sdk.NewInt64Coin("uumee", 0),
+	}
+	createProgram := incentive.NewMsgCreateProgram(
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), "title", "description", program,
+	)
+	createProgram.FromCommunityFund = true
+	proposal, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{createProgram}, sdk.NewCoins(sdk.NewInt64Coin("uumee", 10000000)), val.Address.String(), "",
+	)
+	s.Require().NoError(err)
+
+	generateTxs := []testGenerateTransaction{
+		{
+			"claim",
+			cli.GetCmdClaim(),
+			[]string{},
+			incentive.NewMsgClaim(val.Address),
+		},
+		{
+			"bond",
+			cli.GetCmdBond(),
+			[]string{
+				"1000u/uumee",
+				fmt.Sprintf("--%s=2", cli.FlagTier),
+			},
+			incentive.NewMsgBond(val.Address, 2, sdk.NewInt64Coin("u/uumee", 1000)),
+		},
+		{
+			"begin unbonding",
+			cli.GetCmdBeginUnbonding(),
+			[]string{
+				"1000u/uumee",
+				fmt.Sprintf("--%s=3", cli.FlagTier),
+			},
+			incentive.NewMsgBeginUnbonding(val.Address, 3, sdk.NewInt64Coin("u/uumee", 1000)),
+		},
+		{
+			"sponsor",
+			cli.GetCmdSponsor(),
+			[]string{
+				"1",
+				"1000000uumee",
+			},
+			incentive.NewMsgSponsor(val.Address, 1, sdk.NewInt64Coin("uumee", 1000000)),
+		},
+		{
+			"gov create program",
+			cli.GetCmdGovCreateProgram(),
+			[]string{
+				programFile,
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "title"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "description"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, "10000000uumee"),
+				fmt.Sprintf("--%s=true", cli.FlagFromCommunityFund),
+			},
+			proposal,
+		},
+	}
+
+	s.runTestGenerateTransactions(generateTxs...)
+}
+
+// writeProgramFile writes an incentive program JSON file to a temporary
+// directory and returns its path.
+func (s *IntegrationTestSuite) writeProgramFile(name, content string) string {
+	path := filepath.Join(s.T().TempDir(), name)
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
@@ -26,6 +26,7 @@ func NewTestKeeper(
 	paramSpace paramtypes.Subspace,
 	bk types.BankKeeper,
 	ok types.OracleKeeper,
+	ar types.AssetRegistry,
 	authority string,
 	enableLiquidatorQuery bool,
 ) (Keeper, TestKeeper) {
@@ -35,6 +36,7 @@ func NewTestKeeper(
 		paramSpace,
 		bk,
 		ok,
+		ar,
 		authority,
 		enableLiquidatorQuery,
 	)
@@ -21,6 +21,7 @@ type Keeper struct {
 	hooks                  types.Hooks
 	bankKeeper             types.BankKeeper
 	oracleKeeper           types.OracleKeeper
+	assetRegistry          types.AssetRegistry
 	authority              string // the gov module account
 	liquidatorQueryEnabled bool
 }
@@ -31,6 +32,7 @@ func NewKeeper(
 	paramSpace paramtypes.Subspace,
 	bk types.BankKeeper,
 	ok types.OracleKeeper,
+	ar types.AssetRegistry,
 	authority string,
 	enableLiquidatorQuery bool,
 ) (Keeper, error) {
@@ -45,6 +47,7 @@ func NewKeeper(
 		paramSpace:             paramSpace,
 		bankKeeper:             bk,
 		oracleKeeper:           ok,
+		assetRegistry:          ar,
 		authority:              authority,
 		liquidatorQueryEnabled: enableLiquidatorQuery,
 	}, nil
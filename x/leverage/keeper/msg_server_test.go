@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+
+	uibctypes "github.com/umee-network/umee/v3/x/ibctransfer/types"
 	"github.com/umee-network/umee/v3/x/leverage/fixtures"
 	"github.com/umee-network/umee/v3/x/leverage/types"
 )
@@ -13,6 +16,16 @@ func (s *IntegrationTestSuite) TestAddTokensToRegistry() {
 	registeredUmee := fixtures.Token("uumee", "UMEE", 6)
 	newTokens := fixtures.Token("uabcd", "ABCD", 6)
 
+	osmoTrace := "transfer/channel-1/uosmo"
+	osmoDenom := ibctransfertypes.ParseDenomTrace(osmoTrace).IBCDenom()
+	s.Require().NoError(s.app.UIBCAssetKeeper.SetAsset(s.ctx, uibctypes.Asset{
+		IbcDenom:    osmoDenom,
+		Symbol:      "OSMO",
+		Exponent:    6,
+		OriginChain: "osmosis-1",
+		Trace:       osmoTrace,
+	}))
+
 	testCases := []struct {
 		name      string
 		req       *types.MsgGovUpdateRegistry
@@ -45,6 +58,19 @@ func (s *IntegrationTestSuite) TestAddTokensToRegistry() {
 			true,
 			"expected gov account as only signer for proposal message",
 		},
+		{
+			"token mismatching the IBC asset registry",
+			&types.MsgGovUpdateRegistry{
+				Authority:   govAccAddr,
+				Title:       "test",
+				Description: "test",
+				AddTokens: []types.Token{
+					fixtures.Token(osmoDenom, "OSMO", 18),
+				},
+			},
+			true,
+			"token doesn't match the IBC asset registry",
+		},
 		{
 			"already registered token",
 			&types.MsgGovUpdateRegistry{
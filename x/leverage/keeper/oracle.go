@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -107,16 +109,56 @@ func (k Keeper) TotalTokenValue(ctx sdk.Context, coins sdk.Coins) (sdk.Dec, erro
 	return total, nil
 }
 
+// TokenMedianPrice returns the latest x/oracle historic median of the USD value
+// of a token's symbol denom, e.g. UMEE. Like TokenDefaultDenomPrice, the input
+// denom must be the base denomination, the price is guaranteed to be positive
+// when error is nil, and the token's exponent is also returned.
+func (k Keeper) TokenMedianPrice(ctx sdk.Context, baseDenom string) (sdk.Dec, uint32, error) {
+	t, err := k.GetTokenSettings(ctx, baseDenom)
+	if err != nil {
+		return sdk.ZeroDec(), 0, err
+	}
+
+	if t.Blacklist {
+		return sdk.ZeroDec(), t.Exponent, types.ErrBlacklisted
+	}
+
+	price, err := k.oracleKeeper.MedianOfHistoricMedians(ctx, strings.ToUpper(t.SymbolDenom), 1)
+	if err != nil {
+		return sdk.ZeroDec(), t.Exponent, sdkerrors.Wrap(err, "oracle")
+	}
+
+	if price.IsNil() || !price.IsPositive() {
+		return sdk.ZeroDec(), t.Exponent, sdkerrors.Wrap(types.ErrInvalidOraclePrice, baseDenom)
+	}
+
+	return price, t.Exponent, nil
+}
+
 // PriceRatio computed the ratio of the USD prices of two base tokens, as sdk.Dec(fromPrice/toPrice).
 // Will return an error if either token price is not positive, and guarantees a positive output.
 // Computation uses price of token's default denom to avoid rounding errors for exponent >= 18 tokens,
 // but returns in terms of base tokens.
 func (k Keeper) PriceRatio(ctx sdk.Context, fromDenom, toDenom string) (sdk.Dec, error) {
-	p1, e1, err := k.TokenDefaultDenomPrice(ctx, fromDenom)
+	return k.priceRatio(ctx, fromDenom, toDenom, k.TokenDefaultDenomPrice)
+}
+
+// MedianPriceRatio is the PriceRatio of two base tokens computed with their latest
+// x/oracle historic medians instead of their current exchange rates.
+func (k Keeper) MedianPriceRatio(ctx sdk.Context, fromDenom, toDenom string) (sdk.Dec, error) {
+	return k.priceRatio(ctx, fromDenom, toDenom, k.TokenMedianPrice)
+}
+
+func (k Keeper) priceRatio(
+	ctx sdk.Context,
+	fromDenom, toDenom string,
+	price func(sdk.Context, string) (sdk.Dec, uint32, error),
+) (sdk.Dec, error) {
+	p1, e1, err := price(ctx, fromDenom)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
-	p2, e2, err := k.TokenDefaultDenomPrice(ctx, toDenom)
+	p2, e2, err := price(ctx, toDenom)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
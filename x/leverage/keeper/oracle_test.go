@@ -12,12 +12,14 @@ import (
 type mockOracleKeeper struct {
 	baseExchangeRates   map[string]sdk.Dec
 	symbolExchangeRates map[string]sdk.Dec
+	symbolMedians       map[string]sdk.Dec
 }
 
 func newMockOracleKeeper() *mockOracleKeeper {
 	m := &mockOracleKeeper{
 		baseExchangeRates:   make(map[string]sdk.Dec),
 		symbolExchangeRates: make(map[string]sdk.Dec),
+		symbolMedians:       make(map[string]sdk.Dec),
 	}
 	m.Reset()
 
@@ -42,6 +44,15 @@ func (m *mockOracleKeeper) GetExchangeRateBase(ctx sdk.Context, denom string) (s
 	return p, nil
 }
 
+func (m *mockOracleKeeper) MedianOfHistoricMedians(_ sdk.Context, denom string, _ uint64) (sdk.Dec, error) {
+	p, ok := m.symbolMedians[denom]
+	if !ok {
+		return sdk.ZeroDec(), fmt.Errorf("no medians for denom: %s", denom)
+	}
+
+	return p, nil
+}
+
 func (m *mockOracleKeeper) Reset() {
 	m.symbolExchangeRates = map[string]sdk.Dec{
 		"UMEE": sdk.MustNewDecFromStr("4.21"),
@@ -53,6 +64,10 @@ func (m *mockOracleKeeper) Reset() {
 		atomDenom:           sdk.MustNewDecFromStr("0.00003938"),
 		daiDenom:            sdk.MustNewDecFromStr("0.000000000000000001"),
 	}
+	m.symbolMedians = map[string]sdk.Dec{
+		"UMEE": sdk.MustNewDecFromStr("4.00"),
+		"ATOM": sdk.MustNewDecFromStr("40.00"),
+	}
 }
 
 func (s *IntegrationTestSuite) TestOracle_TokenBasePrice() {
@@ -154,3 +169,23 @@ func (s *IntegrationTestSuite) TestOracle_PriceRatio() {
 	_, err = app.LeverageKeeper.PriceRatio(ctx, appparams.BondDenom, "foo")
 	require.ErrorIs(err, types.ErrNotRegisteredToken)
 }
+
+func (s *IntegrationTestSuite) TestOracle_MedianPriceRatio() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	r, err := app.LeverageKeeper.MedianPriceRatio(ctx, appparams.BondDenom, atomDenom)
+	require.NoError(err)
+	// $4.00 / $40.00 at same exponent, regardless of the current exchange rates
+	require.Equal(sdk.MustNewDecFromStr("0.1"), r)
+
+	r, err = app.LeverageKeeper.MedianPriceRatio(ctx, atomDenom, appparams.BondDenom)
+	require.NoError(err)
+	require.Equal(sdk.NewDec(10), r)
+
+	// no historic medians
+	_, err = app.LeverageKeeper.MedianPriceRatio(ctx, appparams.BondDenom, daiDenom)
+	require.Error(err)
+
+	_, err = app.LeverageKeeper.MedianPriceRatio(ctx, "foo", atomDenom)
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
@@ -62,6 +62,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 		app.GetSubspace(types.ModuleName),
 		app.BankKeeper,
 		newMockOracleKeeper(),
+		app.UIBCAssetKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		true,
 	)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/umee-network/umee/v3/x/leverage/types"
@@ -48,6 +50,9 @@ func (k Keeper) SaveOrUpdateTokenSettingsToRegistry(
 		if err := token.Validate(); err != nil {
 			return err
 		}
+		if err := k.validateTokenAsset(ctx, token); err != nil {
+			return err
+		}
 	}
 
 	for _, token := range tokens {
@@ -70,3 +75,21 @@ func (k Keeper) SaveOrUpdateTokenSettingsToRegistry(
 
 	return nil
 }
+
+// validateTokenAsset returns an error if the symbol or the exponent of a token
+// registered in the IBC asset registry don't match the registry.
+func (k Keeper) validateTokenAsset(ctx sdk.Context, token types.Token) error {
+	symbol, exponent, ok := k.assetRegistry.GetAssetDisplay(ctx, token.BaseDenom)
+	if !ok {
+		return nil
+	}
+
+	if !strings.EqualFold(token.SymbolDenom, symbol) || token.Exponent != exponent {
+		return types.ErrAssetMismatch.Wrapf(
+			"%s: token symbol %s and exponent %d, asset symbol %s and exponent %d",
+			token.BaseDenom, token.SymbolDenom, token.Exponent, symbol, exponent,
+		)
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ var (
 	ErrDuplicateToken       = sdkerrors.Register(ModuleName, 207, "duplicate token")
 	ErrCollateralWeightZero = sdkerrors.Register(ModuleName, 206,
 		"collateral weight of Token is zero: can't be used as a collateral")
+	ErrAssetMismatch = sdkerrors.Register(ModuleName, 208, "token doesn't match the IBC asset registry")
 
 	// 3XX = User Positions
 	ErrInsufficientBalance    = sdkerrors.Register(ModuleName, 300, "insufficient balance")
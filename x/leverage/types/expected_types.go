@@ -33,4 +33,10 @@ type BankKeeper interface {
 type OracleKeeper interface {
 	GetExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error)
 	GetExchangeRateBase(ctx sdk.Context, denom string) (sdk.Dec, error)
+	MedianOfHistoricMedians(ctx sdk.Context, denom string, numStamps uint64) (sdk.Dec, error)
+}
+
+// AssetRegistry defines the expected registry of the IBC assets.
+type AssetRegistry interface {
+	GetAssetDisplay(ctx sdk.Context, ibcDenom string) (symbol string, exponent uint32, found bool)
 }
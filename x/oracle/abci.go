@@ -16,7 +16,7 @@ func isPeriodLastBlock(ctx sdk.Context, blocksPerPeriod uint64) bool {
 }
 
 // EndBlocker is called at the end of every block
-func EndBlocker(ctx sdk.Context, k keeper.Keeper, experimental bool) error {
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	params := k.GetParams(ctx)
@@ -57,7 +57,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper, experimental bool) error {
 				return err
 			}
 
-			if experimental {
+			if params.HistoricPricingEnabled {
 				if isPeriodLastBlock(ctx, params.HistoricStampPeriod) {
 					k.AddHistoricPrice(ctx, ballotDenom.Denom, exchangeRate)
 				}
@@ -71,10 +71,22 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper, experimental bool) error {
 			}
 		}
 
+		// Derive the exchange rates of denoms which were not voted directly
+		// from the exchange rates voted above.
+		if err := k.DeriveExchangeRates(ctx); err != nil {
+			return err
+		}
+
 		// update miss counting & slashing
 		voteTargetsLen := len(voteTargets)
 		claimSlice := types.ClaimMapToSlice(validatorClaimMap)
 		for _, claim := range claimSlice {
+			// Increase inaccurate vote counter of validators who voted outside
+			// of the reward band for at least one token.
+			if claim.InaccurateVotes > 0 {
+				k.SetInaccurateVoteCounter(ctx, claim.Validator, k.GetInaccurateVoteCounter(ctx, claim.Validator)+1)
+			}
+
 			// Skip valid voters
 			// in MsgAggregateExchangeRateVote we filter tokens from the AcceptList.
 			if int(claim.TokensVoted) == voteTargetsLen {
@@ -98,21 +110,22 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper, experimental bool) error {
 		k.ClearVotes(ctx, params.VotePeriod)
 	}
 
-	// Slash oracle providers who missed voting over the threshold and
-	// reset miss counters of all validators at the last block of slash window
+	// Slash oracle providers who missed voting or voted inaccurately over the
+	// thresholds and reset miss and inaccurate vote counters of all validators
+	// at the last block of slash window
 	if isPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+		k.SlashAndResetInaccurateVoteCounters(ctx)
 	}
 
-	// Prune historic prices and medians outside pruning period determined by
-	// the stamp period multiplied by the max stamps.
-	if experimental && isPeriodLastBlock(ctx, params.HistoricStampPeriod) {
-		pruneHistoricPeriod := params.HistoricStampPeriod*(params.MaximumPriceStamps) - params.VotePeriod
-		pruneMedianPeriod := params.MedianStampPeriod*(params.MaximumMedianStamps) - params.VotePeriod
-		for _, v := range params.AcceptList {
-			k.DeleteHistoricPrice(ctx, v.SymbolDenom, uint64(ctx.BlockHeight())-pruneHistoricPeriod)
-			k.DeleteHistoricMedian(ctx, v.SymbolDenom, uint64(ctx.BlockHeight())-pruneMedianPeriod)
-			k.DeleteHistoricMedianDeviation(ctx, v.SymbolDenom, uint64(ctx.BlockHeight())-pruneMedianPeriod)
+	// Prune the oldest historic prices and medians, so that no more than the
+	// maximum amount of stamps is kept for each denom.
+	if params.HistoricPricingEnabled {
+		if isPeriodLastBlock(ctx, params.HistoricStampPeriod) {
+			k.PruneHistoricPrices(ctx)
+		}
+		if isPeriodLastBlock(ctx, params.MedianStampPeriod) {
+			k.PruneHistoricMedians(ctx)
 		}
 	}
 
@@ -121,7 +134,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper, experimental bool) error {
 
 // Tally calculates and returns the median. It sets the set of voters to be
 // rewarded, i.e. voted within a reasonable spread from the weighted median to
-// the store. Note, the ballot is sorted by ExchangeRate.
+// the store, and records the voters who voted outside of that spread as
+// inaccurate. Note, the ballot is sorted by ExchangeRate.
 func Tally(
 	ballot types.ExchangeRateBallot,
 	rewardBand sdk.Dec,
@@ -153,6 +167,12 @@ func Tally(
 			claim.Weight += tallyVote.Power
 			claim.TokensVoted++
 			validatorClaimMap[key] = claim
+		} else {
+			key := tallyVote.Voter.String()
+			claim := validatorClaimMap[key]
+
+			claim.InaccurateVotes++
+			validatorClaimMap[key] = claim
 		}
 	}
 
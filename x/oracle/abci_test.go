@@ -9,6 +9,7 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
@@ -16,8 +17,8 @@ import (
 
 	umeeapp "github.com/umee-network/umee/v3/app"
 	appparams "github.com/umee-network/umee/v3/app/params"
-	"github.com/umee-network/umee/v3/x/oracle/keeper"
 	"github.com/umee-network/umee/v3/x/oracle"
+	"github.com/umee-network/umee/v3/x/oracle/keeper"
 	"github.com/umee-network/umee/v3/x/oracle/types"
 )
 
@@ -42,12 +43,13 @@ func clearHistoricPrices(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	denom string,
-	) {
-	stampPeriod	:= int(k.HistoricStampPeriod(ctx))
-	numStamps := int(k.MaximumPriceStamps(ctx))
-	for i := 0; i < numStamps; i++  {
-		k.DeleteHistoricPrice(ctx, denom, uint64(ctx.BlockHeight()) - uint64(i*stampPeriod))
-	}
+) {
+	k.IterateAllHistoricPrices(ctx, func(historicPrice types.Price) bool {
+		if historicPrice.ExchangeRateTuple.Denom == denom {
+			k.DeleteHistoricPrice(ctx, denom, historicPrice.BlockNum)
+		}
+		return false
+	})
 }
 
 // clearHistoricMedians deletes all historic medians of a given denom in the store.
@@ -55,12 +57,13 @@ func clearHistoricMedians(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	denom string,
-	) {
-	stampPeriod	:= int(k.MedianStampPeriod(ctx))
-	numStamps := int(k.MaximumMedianStamps(ctx))
-	for i := 0; i < numStamps; i++ {
-		k.DeleteHistoricMedian(ctx, denom, uint64(ctx.BlockHeight()) - uint64(i*stampPeriod))
-	}
+) {
+	k.IterateAllMedianPrices(ctx, func(median types.Price) bool {
+		if median.ExchangeRateTuple.Denom == denom {
+			k.DeleteHistoricMedian(ctx, denom, median.BlockNum)
+		}
+		return false
+	})
 }
 
 // clearHistoricMedianDeviations deletes all historic median deviations of a given
@@ -69,12 +72,13 @@ func clearHistoricMedianDeviations(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	denom string,
-	) {
-	stampPeriod	:= int(k.MedianStampPeriod(ctx))
-	numStamps := int(k.MaximumMedianStamps(ctx))
-	for i := 0; i < numStamps; i++  {
-		k.DeleteHistoricMedianDeviation(ctx, denom, uint64(ctx.BlockHeight()) - uint64(i*stampPeriod))
-	}
+) {
+	k.IterateAllMedianDeviationPrices(ctx, func(medianDeviation types.Price) bool {
+		if medianDeviation.ExchangeRateTuple.Denom == denom {
+			k.DeleteHistoricMedianDeviation(ctx, denom, medianDeviation.BlockNum)
+		}
+		return false
+	})
 }
 
 func (s *IntegrationTestSuite) SetupTest() {
@@ -179,38 +183,44 @@ var historacleTestCases = []struct {
 	},
 }
 
-func (s *IntegrationTestSuite) TestEndblockerExperimentalFlag() {
+func (s *IntegrationTestSuite) TestEndblockerHistoricPricingEnabled() {
 	app, ctx := s.app, s.ctx
 
-	// add historic price and calcSet median stats
-	app.OracleKeeper.AddHistoricPrice(s.ctx, displayDenom, sdk.MustNewDecFromStr("1.0"))
-	app.OracleKeeper.CalcAndSetHistoricMedian(s.ctx, displayDenom)
-	medianPruneBlock := ctx.BlockHeight() + int64(types.DefaultMaximumMedianStamps*types.DefaultMedianStampPeriod)
-	ctx = ctx.WithBlockHeight(medianPruneBlock)
-
-	// with experimental flag off median doesn't get deleted
-	oracle.EndBlocker(ctx, app.OracleKeeper, false)
-	medians := []types.Price{}
-	app.OracleKeeper.IterateAllMedianPrices(
-		ctx,
-		func(median types.Price) bool {
-			medians = append(medians, median)
+	// add more historic prices and medians than allowed by the params
+	app.OracleKeeper.SetMaximumPriceStamps(ctx, 1)
+	app.OracleKeeper.SetMaximumMedianStamps(ctx, 1)
+	for block := uint64(1); block <= 2; block++ {
+		app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, block, sdk.OneDec())
+		app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, block, sdk.OneDec())
+	}
+	pruneBlock := int64(app.OracleKeeper.MedianStampPeriod(ctx)) - 1
+	ctx = ctx.WithBlockHeight(pruneBlock)
+
+	countStamps := func() (historicPrices, medians int) {
+		app.OracleKeeper.IterateAllHistoricPrices(ctx, func(types.Price) bool {
+			historicPrices++
 			return false
-		},
-	)
-	s.Require().Equal(1, len(medians))
-
-	// with experimental flag on median gets deleted
-	oracle.EndBlocker(ctx, app.OracleKeeper, true)
-	experimentalMedians := []types.Price{}
-	app.OracleKeeper.IterateAllMedianPrices(
-		ctx,
-		func(median types.Price) bool {
-			medians = append(experimentalMedians, median)
+		})
+		app.OracleKeeper.IterateAllMedianPrices(ctx, func(types.Price) bool {
+			medians++
 			return false
-		},
-	)
-	s.Require().Equal(0, len(experimentalMedians))
+		})
+		return historicPrices, medians
+	}
+
+	// with historic pricing disabled nothing gets pruned
+	app.OracleKeeper.SetHistoricPricingEnabled(ctx, false)
+	s.Require().NoError(oracle.EndBlocker(ctx, app.OracleKeeper))
+	historicPrices, medians := countStamps()
+	s.Require().Equal(2, historicPrices)
+	s.Require().Equal(2, medians)
+
+	// with historic pricing enabled the oldest stamps get pruned
+	app.OracleKeeper.SetHistoricPricingEnabled(ctx, true)
+	s.Require().NoError(oracle.EndBlocker(ctx, app.OracleKeeper))
+	historicPrices, medians = countStamps()
+	s.Require().Equal(1, historicPrices)
+	s.Require().Equal(1, medians)
 }
 
 func (s *IntegrationTestSuite) TestEndblockerHistoracle() {
@@ -240,7 +250,7 @@ func (s *IntegrationTestSuite) TestEndblockerHistoracle() {
 				SubmitBlock: uint64(ctx.BlockHeight()),
 			}
 			app.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr, prevote)
-			oracle.EndBlocker(ctx, app.OracleKeeper, true)
+			oracle.EndBlocker(ctx, app.OracleKeeper)
 
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(app.OracleKeeper.VotePeriod(ctx)))
 			vote := types.AggregateExchangeRateVote{
@@ -248,7 +258,7 @@ func (s *IntegrationTestSuite) TestEndblockerHistoracle() {
 				Voter:              valAddr.String(),
 			}
 			app.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr, vote)
-			oracle.EndBlocker(ctx, app.OracleKeeper, true)
+			oracle.EndBlocker(ctx, app.OracleKeeper)
 		}
 
 		for _, denom := range app.OracleKeeper.AcceptList(ctx) {
@@ -286,6 +296,36 @@ func (s *IntegrationTestSuite) TestEndblockerHistoracle() {
 	}
 }
 
+func TestTallyInaccurateVotes(t *testing.T) {
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	claimMap := map[string]types.Claim{}
+	for _, v := range valAddrs {
+		claimMap[v.String()] = types.NewClaim(10, 0, 0, v)
+	}
+
+	ballot := types.ExchangeRateBallot{
+		types.NewVoteForTally(sdk.MustNewDecFromStr("1.0"), types.UmeeSymbol, valAddrs[0], 10),
+		types.NewVoteForTally(sdk.MustNewDecFromStr("1.0"), types.UmeeSymbol, valAddrs[1], 10),
+		types.NewVoteForTally(sdk.MustNewDecFromStr("10.0"), types.UmeeSymbol, valAddrs[2], 10),
+	}
+
+	median, err := oracle.Tally(ballot, types.DefaultRewardBand, claimMap)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), median)
+
+	for _, v := range valAddrs[:2] {
+		require.Equal(t, int64(1), claimMap[v.String()].TokensVoted)
+		require.Equal(t, int64(0), claimMap[v.String()].InaccurateVotes)
+	}
+	require.Equal(t, int64(0), claimMap[valAddrs[2].String()].TokensVoted)
+	require.Equal(t, int64(1), claimMap[valAddrs[2].String()].InaccurateVotes)
+}
+
 func TestOracleTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
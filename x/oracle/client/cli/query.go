@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,9 +30,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryExchangeRates(),
 		GetCmdQueryExchangeRate(),
+		GetCmdQueryExchangeRateSources(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryMissCounter(),
+		GetCmdQueryInaccurateVoteCounter(),
 		GetCmdQuerySlashWindow(),
+		GetCmdQueryMedianOfHistoricMedians(),
+		GetCmdQueryAverageOfHistoricMedians(),
+		GetCmdQueryTWAP(),
+		GetCmdQueryHistoricPrices(),
 	)
 
 	return cmd
@@ -172,6 +179,45 @@ $ umeed query oracle exchange-rates
 	return cmd
 }
 
+// GetCmdQueryExchangeRateSources implements the query exchange rate sources
+// command.
+func GetCmdQueryExchangeRateSources() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-sources [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query whether the exchange rates were voted or derived",
+		Long: strings.TrimSpace(`
+Query whether the current exchange rates of assets were voted by validators
+or derived from the exchange rates of other assets.
+
+$ umeed query oracle exchange-rate-sources
+
+Or, you can filter with denom
+
+$ umeed query oracle exchange-rate-sources WATOM
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			query := types.QueryExchangeRateSources{}
+
+			if len(args) > 0 {
+				query.Denom = args[0]
+			}
+
+			res, err := queryClient.ExchangeRateSources(cmd.Context(), &query)
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryExchangeRates implements the query rate command.
 func GetCmdQueryExchangeRate() *cobra.Command {
 	cmd := &cobra.Command{
@@ -257,6 +303,34 @@ func GetCmdQueryMissCounter() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryInaccurateVoteCounter implements the inaccurate vote counter
+// query command.
+func GetCmdQueryInaccurateVoteCounter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inaccurate-vote-counter [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the current inaccurate vote counter for a given validator address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err = sdk.ValAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			res, err := queryClient.InaccurateVoteCounter(cmd.Context(), &types.QueryInaccurateVoteCounter{
+				ValidatorAddr: args[0],
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQuerySlashWindow implements the slash window query command.
 func GetCmdQuerySlashWindow() *cobra.Command {
 	cmd := &cobra.Command{
@@ -277,3 +351,138 @@ func GetCmdQuerySlashWindow() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryMedianOfHistoricMedians implements the median of historic
+// medians query command.
+func GetCmdQueryMedianOfHistoricMedians() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "median-of-medians [denom] [num-stamps]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the median of the last num-stamps historic medians of a denom",
+		Long: strings.TrimSpace(`
+Query the median of the last num-stamps historic medians of an asset.
+
+$ umeed query oracle median-of-medians ATOM 4
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numStamps, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.MedianOfHistoricMedians(cmd.Context(), &types.QueryMedianOfHistoricMedians{
+				Denom:     args[0],
+				NumStamps: numStamps,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAverageOfHistoricMedians implements the average of historic
+// medians query command.
+func GetCmdQueryAverageOfHistoricMedians() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "average-of-medians [denom] [num-stamps]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the average of the last num-stamps historic medians of a denom",
+		Long: strings.TrimSpace(`
+Query the average of the last num-stamps historic medians of an asset.
+
+$ umeed query oracle average-of-medians ATOM 4
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numStamps, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AverageOfHistoricMedians(cmd.Context(), &types.QueryAverageOfHistoricMedians{
+				Denom:     args[0],
+				NumStamps: numStamps,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTWAP implements the time weighted average price query command.
+func GetCmdQueryTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time weighted average price of a denom over the last window blocks",
+		Long: strings.TrimSpace(`
+Query the time weighted average price of an asset over the last window blocks,
+computed from the stored historic price stamps.
+
+$ umeed query oracle twap ATOM 3600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.TWAP(cmd.Context(), &types.QueryTWAP{
+				Denom:  args[0],
+				Window: window,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryHistoricPrices implements the historic prices query command.
+func GetCmdQueryHistoricPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historic-prices [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the historic price stamps of a denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.HistoricPrices(cmd.Context(), &types.QueryHistoricPrices{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			return cli.PrintOrErr(res, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historic-prices")
+	return cmd
+}
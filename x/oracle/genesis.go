@@ -39,6 +39,15 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 		keeper.SetMissCounter(ctx, operator, mc.MissCounter)
 	}
 
+	for _, ic := range genState.InaccurateVoteCounters {
+		operator, err := sdk.ValAddressFromBech32(ic.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetInaccurateVoteCounter(ctx, operator, ic.InaccurateVoteCounter)
+	}
+
 	for _, ap := range genState.AggregateExchangeRatePrevotes {
 		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
 		if err != nil {
@@ -117,6 +126,16 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	inaccurateVoteCounters := []types.InaccurateVoteCounter{}
+	keeper.IterateInaccurateVoteCounters(ctx, func(operator sdk.ValAddress, inaccurateVoteCounter uint64) (stop bool) {
+		inaccurateVoteCounters = append(inaccurateVoteCounters, types.InaccurateVoteCounter{
+			ValidatorAddress:      operator.String(),
+			InaccurateVoteCounter: inaccurateVoteCounter,
+		})
+
+		return false
+	})
+
 	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
 	keeper.IterateAggregateExchangeRatePrevotes(
 		ctx,
@@ -172,5 +191,6 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		historicPrices,
 		medianPrices,
 		medianDeviationPrices,
+		inaccurateVoteCounters,
 	)
 }
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// DeriveExchangeRates sets the exchange rates of the denoms in CrossRates
+// which have no voted exchange rate, as the product of their ratio and the
+// voted exchange rates of the denoms in their path. Denoms with a path denom
+// that has no voted exchange rate are skipped.
+func (k Keeper) DeriveExchangeRates(ctx sdk.Context) error {
+	for _, crossRate := range k.CrossRates(ctx) {
+		symbol := crossRate.Denom.SymbolDenom
+		if _, err := k.GetExchangeRate(ctx, symbol); err == nil {
+			continue
+		}
+
+		exchangeRate, ok := k.deriveExchangeRate(ctx, crossRate)
+		if !ok {
+			continue
+		}
+
+		if err := k.SetExchangeRateWithEvent(ctx, symbol, exchangeRate); err != nil {
+			return err
+		}
+		k.setDerivedExchangeRate(ctx, symbol)
+	}
+
+	return nil
+}
+
+// deriveExchangeRate returns the exchange rate of a cross rate, and false
+// if any of the denoms in its path has no voted exchange rate.
+func (k Keeper) deriveExchangeRate(ctx sdk.Context, crossRate types.CrossRate) (sdk.Dec, bool) {
+	exchangeRate := crossRate.Ratio
+	for _, denom := range crossRate.Path {
+		rate, err := k.GetExchangeRate(ctx, denom)
+		if err != nil || k.IsDerivedExchangeRate(ctx, denom) {
+			return sdk.ZeroDec(), false
+		}
+		exchangeRate = exchangeRate.Mul(rate)
+	}
+
+	return exchangeRate, true
+}
+
+// IsDerivedExchangeRate returns whether the current exchange rate of a given
+// symbol denom was derived rather than voted.
+func (k Keeper) IsDerivedExchangeRate(ctx sdk.Context, symbol string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyDerivedExchangeRate(strings.ToUpper(symbol)))
+}
+
+// setDerivedExchangeRate marks the current exchange rate of a given symbol
+// denom as derived.
+func (k Keeper) setDerivedExchangeRate(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDerivedExchangeRate(strings.ToUpper(symbol)), []byte{1})
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestDeriveExchangeRates() {
+	app, ctx := s.app, s.ctx
+
+	app.OracleKeeper.SetCrossRates(ctx, []types.CrossRate{
+		{
+			Denom: types.Denom{BaseDenom: "ibc/WATOM", SymbolDenom: "WATOM", Exponent: 6},
+			Path:  []string{types.AtomSymbol},
+			Ratio: sdk.MustNewDecFromStr("1.05"),
+		},
+		{
+			// has no voted exchange rate for OSMO
+			Denom: types.Denom{BaseDenom: "ibc/WOSMO", SymbolDenom: "WOSMO", Exponent: 6},
+			Path:  []string{types.AtomSymbol, "OSMO"},
+			Ratio: sdk.OneDec(),
+		},
+		{
+			// has a voted exchange rate
+			Denom: types.Denom{BaseDenom: types.UmeeDenom, SymbolDenom: types.UmeeSymbol, Exponent: 6},
+			Path:  []string{types.AtomSymbol},
+			Ratio: sdk.OneDec(),
+		},
+	})
+	app.OracleKeeper.SetExchangeRate(ctx, types.AtomSymbol, sdk.NewDec(10))
+	app.OracleKeeper.SetExchangeRate(ctx, types.UmeeSymbol, sdk.OneDec())
+
+	s.Require().NoError(app.OracleKeeper.DeriveExchangeRates(ctx))
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "WATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("10.5"), rate)
+	s.Require().True(app.OracleKeeper.IsDerivedExchangeRate(ctx, "wAtom"))
+
+	rate, err = app.OracleKeeper.GetExchangeRateBase(ctx, "ibc/WATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.0000105"), rate)
+
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, "WOSMO")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+	s.Require().False(app.OracleKeeper.IsDerivedExchangeRate(ctx, "WOSMO"))
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, types.UmeeSymbol)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+	s.Require().False(app.OracleKeeper.IsDerivedExchangeRate(ctx, types.UmeeSymbol))
+
+	app.OracleKeeper.ClearExchangeRates(ctx)
+	s.Require().False(app.OracleKeeper.IsDerivedExchangeRate(ctx, "WATOM"))
+}
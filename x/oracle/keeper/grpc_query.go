@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/umee-network/umee/v3/util"
 	"github.com/umee-network/umee/v3/x/oracle/types"
 )
 
@@ -69,6 +73,45 @@ func (q querier) ExchangeRates(
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
+// ExchangeRateSources queries whether the exchange rates of all denoms, or,
+// if specified, of a single denom, were voted or derived.
+func (q querier) ExchangeRateSources(
+	goCtx context.Context,
+	req *types.QueryExchangeRateSources,
+) (*types.QueryExchangeRateSourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var sources []types.ExchangeRateSource
+
+	if len(req.Denom) > 0 {
+		exchangeRate, err := q.GetExchangeRate(ctx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, types.ExchangeRateSource{
+			Denom:        req.Denom,
+			ExchangeRate: exchangeRate,
+			Derived:      q.IsDerivedExchangeRate(ctx, req.Denom),
+		})
+	} else {
+		q.IterateExchangeRates(ctx, func(denom string, rate sdk.Dec) (stop bool) {
+			sources = append(sources, types.ExchangeRateSource{
+				Denom:        denom,
+				ExchangeRate: rate,
+				Derived:      q.IsDerivedExchangeRate(ctx, denom),
+			})
+			return false
+		})
+	}
+
+	return &types.QueryExchangeRateSourcesResponse{ExchangeRateSources: sources}, nil
+}
+
 // ActiveExchangeRates queries all denoms for which exchange rates exist.
 func (q querier) ActiveExchangeRates(
 	goCtx context.Context,
@@ -136,6 +179,27 @@ func (q querier) MissCounter(
 	}, nil
 }
 
+// InaccurateVoteCounter queries oracle inaccurate vote counter of a validator.
+func (q querier) InaccurateVoteCounter(
+	goCtx context.Context,
+	req *types.QueryInaccurateVoteCounter,
+) (*types.QueryInaccurateVoteCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryInaccurateVoteCounterResponse{
+		InaccurateVoteCounter: q.GetInaccurateVoteCounter(ctx, valAddr),
+	}, nil
+}
+
 // SlashWindow queries the current slash window progress of the oracle.
 func (q querier) SlashWindow(
 	goCtx context.Context,
@@ -315,3 +379,115 @@ func (q querier) MedianDeviations(
 
 	return &types.QueryMedianDeviationsResponse{MedianDeviations: medians}, nil
 }
+
+// MedianOfHistoricMedians queries the median of the last num_stamps historic
+// medians of a denom.
+func (q querier) MedianOfHistoricMedians(
+	goCtx context.Context,
+	req *types.QueryMedianOfHistoricMedians,
+) (*types.QueryMedianOfHistoricMediansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	median, err := q.Keeper.MedianOfHistoricMedians(ctx, req.Denom, req.NumStamps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMedianOfHistoricMediansResponse{Median: median}, nil
+}
+
+// AverageOfHistoricMedians queries the average of the last num_stamps
+// historic medians of a denom.
+func (q querier) AverageOfHistoricMedians(
+	goCtx context.Context,
+	req *types.QueryAverageOfHistoricMedians,
+) (*types.QueryAverageOfHistoricMediansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	average, err := q.Keeper.AverageOfHistoricMedians(ctx, req.Denom, req.NumStamps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAverageOfHistoricMediansResponse{Average: average}, nil
+}
+
+// TWAP queries the time weighted average price of a denom over the last
+// window blocks.
+func (q querier) TWAP(
+	goCtx context.Context,
+	req *types.QueryTWAP,
+) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	twap, err := q.Keeper.TWAP(ctx, req.Denom, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
+
+// HistoricPrices queries the raw historic price stamps of a denom.
+func (q querier) HistoricPrices(
+	goCtx context.Context,
+	req *types.QueryHistoricPrices,
+) (*types.QueryHistoricPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// make sure we have one zero byte to correctly separate denoms
+	store := prefix.NewStore(
+		ctx.KVStore(q.storeKey),
+		util.ConcatBytes(1, types.KeyPrefixHistoricPrice, []byte(req.Denom)),
+	)
+
+	var historicPrices []types.Price
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var decProto sdk.DecProto
+		if err := q.cdc.Unmarshal(value, &decProto); err != nil {
+			return err
+		}
+
+		historicPrices = append(historicPrices, types.Price{
+			ExchangeRateTuple: types.ExchangeRateTuple{Denom: req.Denom, ExchangeRate: decProto.Dec},
+			BlockNum:          binary.BigEndian.Uint64(key),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricPricesResponse{
+		HistoricPrices: historicPrices,
+		Pagination:     pageRes,
+	}, nil
+}
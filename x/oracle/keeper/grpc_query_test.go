@@ -4,6 +4,7 @@ import (
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	appparams "github.com/umee-network/umee/v3/app/params"
@@ -35,6 +36,31 @@ func (s *IntegrationTestSuite) TestQuerier_ExchangeRates() {
 	}, res.ExchangeRates)
 }
 
+func (s *IntegrationTestSuite) TestQuerier_ExchangeRateSources() {
+	s.app.OracleKeeper.SetCrossRates(s.ctx, []types.CrossRate{{
+		Denom: types.Denom{BaseDenom: "ibc/WATOM", SymbolDenom: "WATOM", Exponent: 6},
+		Path:  []string{displayDenom},
+		Ratio: sdk.MustNewDecFromStr("2.0"),
+	}})
+	s.app.OracleKeeper.SetExchangeRate(s.ctx, displayDenom, sdk.OneDec())
+	s.Require().NoError(s.app.OracleKeeper.DeriveExchangeRates(s.ctx))
+
+	res, err := s.queryClient.ExchangeRateSources(s.ctx.Context(), &types.QueryExchangeRateSources{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ExchangeRateSource{
+		{Denom: displayDenom, ExchangeRate: sdk.OneDec(), Derived: false},
+		{Denom: "WATOM", ExchangeRate: sdk.NewDec(2), Derived: true},
+	}, res.ExchangeRateSources)
+
+	res, err = s.queryClient.ExchangeRateSources(s.ctx.Context(), &types.QueryExchangeRateSources{
+		Denom: "WATOM",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ExchangeRateSource{
+		{Denom: "WATOM", ExchangeRate: sdk.NewDec(2), Derived: true},
+	}, res.ExchangeRateSources)
+}
+
 func (s *IntegrationTestSuite) TestQuerier_FeeederDelegation() {
 	feederAddr := sdk.AccAddress([]byte("addr________________"))
 	feederAcc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, feederAddr)
@@ -79,6 +105,24 @@ func (s *IntegrationTestSuite) TestQuerier_MissCounter() {
 	s.Require().Equal(res.MissCounter, missCounter)
 }
 
+func (s *IntegrationTestSuite) TestQuerier_InaccurateVoteCounter() {
+	inaccurateVoteCounter := uint64(rand.Intn(100))
+
+	res, err := s.queryClient.InaccurateVoteCounter(s.ctx.Context(), &types.QueryInaccurateVoteCounter{
+		ValidatorAddr: valAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.InaccurateVoteCounter, uint64(0))
+
+	s.app.OracleKeeper.SetInaccurateVoteCounter(s.ctx, valAddr, inaccurateVoteCounter)
+
+	res, err = s.queryClient.InaccurateVoteCounter(s.ctx.Context(), &types.QueryInaccurateVoteCounter{
+		ValidatorAddr: valAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.InaccurateVoteCounter, inaccurateVoteCounter)
+}
+
 func (s *IntegrationTestSuite) TestQuerier_SlashWindow() {
 	res, err := s.queryClient.SlashWindow(s.ctx.Context(), &types.QuerySlashWindow{})
 	s.Require().NoError(err)
@@ -240,6 +284,73 @@ func (s *IntegrationTestSuite) TestQuerier_MedianDeviations() {
 	s.Require().Equal(res.MedianDeviations, sdk.NewDecCoins(atomMedianDeviation))
 }
 
+func (s *IntegrationTestSuite) TestQuerier_HistoricMedianStats() {
+	app, ctx := s.app, s.ctx
+
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, uint64(ctx.BlockHeight()-3), sdk.MustNewDecFromStr("1.0"))
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, uint64(ctx.BlockHeight()-2), sdk.MustNewDecFromStr("2.0"))
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, uint64(ctx.BlockHeight()-1), sdk.MustNewDecFromStr("6.0"))
+
+	medianRes, err := s.queryClient.MedianOfHistoricMedians(ctx.Context(), &types.QueryMedianOfHistoricMedians{
+		Denom:     displayDenom,
+		NumStamps: 3,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("2.0"), medianRes.Median)
+
+	averageRes, err := s.queryClient.AverageOfHistoricMedians(ctx.Context(), &types.QueryAverageOfHistoricMedians{
+		Denom:     displayDenom,
+		NumStamps: 3,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("3.0"), averageRes.Average)
+
+	_, err = s.queryClient.MedianOfHistoricMedians(ctx.Context(), &types.QueryMedianOfHistoricMedians{
+		Denom:     "foo",
+		NumStamps: 3,
+	})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_TWAP() {
+	app, ctx := s.app, s.ctx
+
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, uint64(ctx.BlockHeight()-1), sdk.MustNewDecFromStr("1.0"))
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, uint64(ctx.BlockHeight()), sdk.MustNewDecFromStr("2.0"))
+
+	res, err := s.queryClient.TWAP(ctx.Context(), &types.QueryTWAP{Denom: displayDenom, Window: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("1.5"), res.Twap)
+
+	_, err = s.queryClient.TWAP(ctx.Context(), &types.QueryTWAP{Denom: displayDenom, Window: 0})
+	s.Require().ErrorIs(err, types.ErrInvalidWindow)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_HistoricPrices() {
+	app, ctx := s.app, s.ctx
+
+	for i := uint64(1); i <= 5; i++ {
+		app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, i, sdk.NewDec(int64(i)))
+	}
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom+"test", 1, sdk.OneDec())
+
+	res, err := s.queryClient.HistoricPrices(ctx.Context(), &types.QueryHistoricPrices{Denom: displayDenom})
+	s.Require().NoError(err)
+	s.Require().Len(res.HistoricPrices, 5)
+	for _, p := range res.HistoricPrices {
+		s.Require().Equal(displayDenom, p.ExchangeRateTuple.Denom)
+		s.Require().Equal(sdk.NewDec(int64(p.BlockNum)), p.ExchangeRateTuple.ExchangeRate)
+	}
+
+	res, err = s.queryClient.HistoricPrices(ctx.Context(), &types.QueryHistoricPrices{
+		Denom:      displayDenom,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.HistoricPrices, 2)
+	s.Require().Equal(uint64(5), res.Pagination.Total)
+}
+
 func (s *IntegrationTestSuite) TestEmptyRequest() {
 	q := keeper.NewQuerier(keeper.Keeper{})
 	const emptyRequestErrorMsg = "empty request"
@@ -252,6 +363,10 @@ func (s *IntegrationTestSuite) TestEmptyRequest() {
 	s.Require().Nil(resExchangeRate)
 	s.Require().ErrorContains(err, emptyRequestErrorMsg)
 
+	resExchangeRateSources, err := q.ExchangeRateSources(s.ctx.Context(), nil)
+	s.Require().Nil(resExchangeRateSources)
+	s.Require().ErrorContains(err, emptyRequestErrorMsg)
+
 	resActiveExchangeRates, err := q.ActiveExchangeRates(s.ctx.Context(), nil)
 	s.Require().Nil(resActiveExchangeRates)
 	s.Require().ErrorContains(err, emptyRequestErrorMsg)
@@ -264,6 +379,10 @@ func (s *IntegrationTestSuite) TestEmptyRequest() {
 	s.Require().Nil(resMissCounter)
 	s.Require().ErrorContains(err, emptyRequestErrorMsg)
 
+	resInaccurateVoteCounter, err := q.InaccurateVoteCounter(s.ctx.Context(), nil)
+	s.Require().Nil(resInaccurateVoteCounter)
+	s.Require().ErrorContains(err, emptyRequestErrorMsg)
+
 	resAggregatePrevote, err := q.AggregatePrevote(s.ctx.Context(), nil)
 	s.Require().Nil(resAggregatePrevote)
 	s.Require().ErrorContains(err, emptyRequestErrorMsg)
@@ -293,6 +412,10 @@ func (s *IntegrationTestSuite) TestInvalidBechAddress() {
 	s.Require().Nil(resMissCounter)
 	s.Require().ErrorContains(err, invalidAddressMsg)
 
+	resInaccurateVoteCounter, err := q.InaccurateVoteCounter(s.ctx.Context(), &types.QueryInaccurateVoteCounter{})
+	s.Require().Nil(resInaccurateVoteCounter)
+	s.Require().ErrorContains(err, invalidAddressMsg)
+
 	resAggregatePrevote, err := q.AggregatePrevote(s.ctx.Context(), &types.QueryAggregatePrevote{})
 	s.Require().Nil(resAggregatePrevote)
 	s.Require().ErrorContains(err, invalidAddressMsg)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -193,6 +194,95 @@ func (k Keeper) MinOfHistoricMedians(
 	return min, nil
 }
 
+// TWAP calculates and returns the time weighted average price of a given
+// denom over the last window blocks, using the stored historic price stamps.
+// Each stamp is weighted by the number of blocks in the window for which it
+// was the latest recorded price. If the stored stamps do not cover the whole
+// window, only the covered blocks are used.
+func (k Keeper) TWAP(
+	ctx sdk.Context,
+	denom string,
+	window uint64,
+) (sdk.Dec, error) {
+	if window == 0 {
+		return sdk.ZeroDec(), types.ErrInvalidWindow
+	}
+
+	stamps := k.historicPriceStamps(ctx, denom)
+	if len(stamps) == 0 {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNoHistoricPrice, fmt.Sprintf("denom: %s", denom))
+	}
+
+	var (
+		height      = ctx.BlockHeight()
+		windowStart = height - int64(window) + 1
+		// the end block (exclusive) of the price period of the current stamp
+		end         = height + 1
+		weightedSum = sdk.ZeroDec()
+		totalWeight = int64(0)
+	)
+
+	// iterate from the most recent stamp backwards
+	for i := len(stamps) - 1; i >= 0; i-- {
+		stampBlock := int64(stamps[i].BlockNum)
+		if stampBlock > height {
+			continue
+		}
+
+		start := stampBlock
+		if start < windowStart {
+			start = windowStart
+		}
+
+		if weight := end - start; weight > 0 {
+			weightedSum = weightedSum.Add(stamps[i].ExchangeRateTuple.ExchangeRate.MulInt64(weight))
+			totalWeight += weight
+		}
+
+		if stampBlock <= windowStart {
+			break
+		}
+		end = stampBlock
+	}
+
+	if totalWeight == 0 {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrNoHistoricPrice, fmt.Sprintf("denom: %s", denom))
+	}
+
+	return weightedSum.QuoInt64(totalWeight), nil
+}
+
+// historicPriceStamps returns all the historic price stamps of a given denom
+// sorted by block number in ascending order.
+func (k Keeper) historicPriceStamps(
+	ctx sdk.Context,
+	denom string,
+) []types.Price {
+	store := ctx.KVStore(k.storeKey)
+
+	// make sure we have one zero byte to correctly separate denoms
+	prefix := util.ConcatBytes(1, types.KeyPrefixHistoricPrice, []byte(denom))
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	stamps := []types.Price{}
+	for ; iter.Valid(); iter.Next() {
+		decProto := sdk.DecProto{}
+		k.cdc.MustUnmarshal(iter.Value(), &decProto)
+		_, blockNum := types.ParseDenomAndBlockFromKey(iter.Key(), types.KeyPrefixHistoricPrice)
+		stamps = append(stamps, types.Price{
+			ExchangeRateTuple: types.ExchangeRateTuple{ExchangeRate: decProto.Dec, Denom: denom},
+			BlockNum:          blockNum,
+		})
+	}
+
+	sort.Slice(stamps, func(i, j int) bool {
+		return stamps[i].BlockNum < stamps[j].BlockNum
+	})
+
+	return stamps
+}
+
 // historicPrices returns all the historic prices of a given denom.
 func (k Keeper) historicPrices(
 	ctx sdk.Context,
@@ -282,6 +372,46 @@ func (k Keeper) SetHistoricPrice(
 	store.Set(types.KeyHistoricPrice(denom, blockNum), bz)
 }
 
+// PruneHistoricPrices deletes the oldest historic prices of every denom in
+// the store, so that at most MaximumPriceStamps prices are kept per denom.
+func (k Keeper) PruneHistoricPrices(ctx sdk.Context) {
+	k.pruneStamps(ctx, types.KeyPrefixHistoricPrice, k.MaximumPriceStamps(ctx))
+}
+
+// PruneHistoricMedians deletes the oldest medians and median deviations of
+// every denom in the store, so that at most MaximumMedianStamps of each are
+// kept per denom.
+func (k Keeper) PruneHistoricMedians(ctx sdk.Context) {
+	maxStamps := k.MaximumMedianStamps(ctx)
+	k.pruneStamps(ctx, types.KeyPrefixMedian, maxStamps)
+	k.pruneStamps(ctx, types.KeyPrefixMedianDeviation, maxStamps)
+}
+
+// pruneStamps deletes all but the latest maxStamps entries of every denom
+// stored under the given prefix. Keys under the prefix must be built from a
+// denom and a block number, as in KeyHistoricPrice.
+func (k Keeper) pruneStamps(ctx sdk.Context, prefix []byte, maxStamps uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var (
+		stamps = map[string]uint64{}
+		pruned [][]byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		denom, _ := types.ParseDenomAndBlockFromKey(iter.Key(), prefix)
+		stamps[denom]++
+		if stamps[denom] > maxStamps {
+			pruned = append(pruned, iter.Key())
+		}
+	}
+
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
 // DeleteHistoricPrice deletes the historic price of a denom at a
 // given block.
 func (k Keeper) DeleteHistoricPrice(
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
 )
 
 func (s *IntegrationTestSuite) TestSetHistoraclePricing() {
@@ -87,3 +89,70 @@ func (s *IntegrationTestSuite) TestSetHistoraclePricing() {
 	s.Require().Equal(medians[0], sdk.MustNewDecFromStr("1.2"))
 	s.Require().Equal(medians[1], sdk.MustNewDecFromStr("1.125"))
 }
+
+func (s *IntegrationTestSuite) TestTWAP() {
+	app, ctx := s.app, s.ctx
+
+	_, err := app.OracleKeeper.TWAP(ctx, displayDenom, 10)
+	s.Require().ErrorIs(err, types.ErrNoHistoricPrice)
+
+	// stamps at blocks 10, 12 and 16
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, 10, sdk.MustNewDecFromStr("1.0"))
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, 12, sdk.MustNewDecFromStr("2.0"))
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, 16, sdk.MustNewDecFromStr("4.0"))
+	ctx = ctx.WithBlockHeight(19)
+
+	// blocks 16-19 at 4.0
+	twap, err := app.OracleKeeper.TWAP(ctx, displayDenom, 4)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("4.0"), twap)
+
+	// blocks 11 at 1.0, 12-15 at 2.0, 16-19 at 4.0
+	twap, err = app.OracleKeeper.TWAP(ctx, displayDenom, 9)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("25").QuoInt64(9), twap)
+
+	// window exceeding the stored stamps only uses blocks 10-19
+	twap, err = app.OracleKeeper.TWAP(ctx, displayDenom, 100)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("2.6"), twap)
+
+	_, err = app.OracleKeeper.TWAP(ctx, displayDenom, 0)
+	s.Require().ErrorIs(err, types.ErrInvalidWindow)
+}
+
+func (s *IntegrationTestSuite) TestPruneHistoricPrices() {
+	app, ctx := s.app, s.ctx
+
+	app.OracleKeeper.SetMaximumPriceStamps(ctx, 3)
+	app.OracleKeeper.SetMaximumMedianStamps(ctx, 2)
+
+	// stamp across a byte boundary of the block number to make sure the
+	// oldest stamps, rather than the smallest keys, get pruned
+	for block := int64(250); block < 262; block++ {
+		ctx = ctx.WithBlockHeight(block)
+		app.OracleKeeper.AddHistoricPrice(ctx, displayDenom, sdk.NewDec(block))
+		app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, uint64(block), sdk.NewDec(block))
+		app.OracleKeeper.SetHistoricMedianDeviation(ctx, displayDenom, uint64(block), sdk.NewDec(block))
+	}
+
+	app.OracleKeeper.PruneHistoricPrices(ctx)
+	app.OracleKeeper.PruneHistoricMedians(ctx)
+
+	stamps := []uint64{}
+	app.OracleKeeper.IterateAllHistoricPrices(ctx, func(price types.Price) bool {
+		stamps = append(stamps, price.BlockNum)
+		return false
+	})
+	s.Require().Equal([]uint64{259, 260, 261}, stamps)
+
+	medians := app.OracleKeeper.HistoricMedians(ctx, displayDenom, 5)
+	s.Require().Equal([]sdk.Dec{sdk.NewDec(261), sdk.NewDec(260)}, medians)
+
+	deviations := []uint64{}
+	app.OracleKeeper.IterateAllMedianDeviationPrices(ctx, func(price types.Price) bool {
+		deviations = append(deviations, price.BlockNum)
+		return false
+	})
+	s.Require().Equal([]uint64{260, 261}, deviations)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+const (
+	routeHistoricPriceStamps  = "historic-price-stamps"
+	routeHistoricMedianStamps = "historic-median-stamps"
+)
+
+// RegisterInvariants registers the oracle module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, routeHistoricPriceStamps, HistoricPriceStampsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, routeHistoricMedianStamps, HistoricMedianStampsInvariant(k))
+}
+
+// HistoricPriceStampsInvariant checks that no denom has more historic prices
+// stored than MaximumPriceStamps.
+func HistoricPriceStampsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stamps := map[string]uint64{}
+		k.IterateAllHistoricPrices(ctx, func(historicPrice types.Price) bool {
+			stamps[historicPrice.ExchangeRateTuple.Denom]++
+			return false
+		})
+
+		msg, count := exceededStamps("historic prices", stamps, k.MaximumPriceStamps(ctx))
+
+		return sdk.FormatInvariant(
+			types.ModuleName, routeHistoricPriceStamps,
+			fmt.Sprintf("number of denoms exceeding the maximum price stamps found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// HistoricMedianStampsInvariant checks that no denom has more medians or
+// median deviations stored than MaximumMedianStamps.
+func HistoricMedianStampsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		medians := map[string]uint64{}
+		k.IterateAllMedianPrices(ctx, func(median types.Price) bool {
+			medians[median.ExchangeRateTuple.Denom]++
+			return false
+		})
+
+		medianDeviations := map[string]uint64{}
+		k.IterateAllMedianDeviationPrices(ctx, func(medianDeviation types.Price) bool {
+			medianDeviations[medianDeviation.ExchangeRateTuple.Denom]++
+			return false
+		})
+
+		maxStamps := k.MaximumMedianStamps(ctx)
+		medianMsg, medianCount := exceededStamps("medians", medians, maxStamps)
+		deviationMsg, deviationCount := exceededStamps("median deviations", medianDeviations, maxStamps)
+		count := medianCount + deviationCount
+
+		return sdk.FormatInvariant(
+			types.ModuleName, routeHistoricMedianStamps,
+			fmt.Sprintf("number of denoms exceeding the maximum median stamps found %d\n%s%s",
+				count, medianMsg, deviationMsg),
+		), count != 0
+	}
+}
+
+// exceededStamps returns a description of, and the number of, the denoms
+// which have more than maxStamps stamps.
+func exceededStamps(name string, stamps map[string]uint64, maxStamps uint64) (msg string, count int) {
+	denoms := make([]string, 0, len(stamps))
+	for denom, numStamps := range stamps {
+		if numStamps > maxStamps {
+			denoms = append(denoms, denom)
+		}
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		msg += fmt.Sprintf("\t%s has %d %s, maximum is %d\n", denom, stamps[denom], name, maxStamps)
+	}
+	return msg, len(denoms)
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/keeper"
+)
+
+func (s *IntegrationTestSuite) TestHistoricPriceStampsInvariant() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	app.OracleKeeper.SetMaximumPriceStamps(ctx, 2)
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, 1, sdk.OneDec())
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, 2, sdk.OneDec())
+
+	_, broken := keeper.HistoricPriceStampsInvariant(app.OracleKeeper)(ctx)
+	require.False(broken)
+
+	app.OracleKeeper.SetHistoricPrice(ctx, displayDenom, 3, sdk.OneDec())
+	msg, broken := keeper.HistoricPriceStampsInvariant(app.OracleKeeper)(ctx)
+	require.True(broken)
+	require.Contains(msg, displayDenom+" has 3 historic prices, maximum is 2")
+
+	app.OracleKeeper.PruneHistoricPrices(ctx)
+	_, broken = keeper.HistoricPriceStampsInvariant(app.OracleKeeper)(ctx)
+	require.False(broken)
+}
+
+func (s *IntegrationTestSuite) TestHistoricMedianStampsInvariant() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	app.OracleKeeper.SetMaximumMedianStamps(ctx, 1)
+	app.OracleKeeper.SetHistoricMedian(ctx, displayDenom, 1, sdk.OneDec())
+	app.OracleKeeper.SetHistoricMedianDeviation(ctx, displayDenom, 1, sdk.OneDec())
+
+	_, broken := keeper.HistoricMedianStampsInvariant(app.OracleKeeper)(ctx)
+	require.False(broken)
+
+	app.OracleKeeper.SetHistoricMedianDeviation(ctx, displayDenom, 2, sdk.OneDec())
+	msg, broken := keeper.HistoricMedianStampsInvariant(app.OracleKeeper)(ctx)
+	require.True(broken)
+	require.Contains(msg, displayDenom+" has 2 median deviations, maximum is 1")
+
+	app.OracleKeeper.PruneHistoricMedians(ctx)
+	_, broken = keeper.HistoricMedianStampsInvariant(app.OracleKeeper)(ctx)
+	require.False(broken)
+}
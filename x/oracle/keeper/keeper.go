@@ -22,6 +22,7 @@ var ten = sdk.MustNewDecFromStr("10")
 type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
+	tStoreKey  storetypes.StoreKey
 	paramSpace paramstypes.Subspace
 
 	accountKeeper types.AccountKeeper
@@ -36,6 +37,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	paramspace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -56,6 +58,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
+		tStoreKey:     tStoreKey,
 		paramSpace:    paramspace,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
@@ -100,6 +103,15 @@ func (k Keeper) GetExchangeRateBase(ctx sdk.Context, denom string) (sdk.Dec, err
 			break
 		}
 	}
+	if len(symbol) == 0 {
+		for _, crossRate := range params.CrossRates {
+			if crossRate.Denom.BaseDenom == denom {
+				symbol = crossRate.Denom.SymbolDenom
+				exponent = uint64(crossRate.Denom.Exponent)
+				break
+			}
+		}
+	}
 	if len(symbol) == 0 {
 		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrUnknownDenom, denom)
 	}
@@ -149,12 +161,16 @@ func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(string, sdk.D
 	}
 }
 
+// ClearExchangeRates deletes all the voted and derived exchange rates from
+// the store.
 func (k Keeper) ClearExchangeRates(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixExchangeRate)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		store.Delete(iter.Key())
+	for _, prefix := range [][]byte{types.KeyPrefixExchangeRate, types.KeyPrefixDerivedExchangeRate} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			store.Delete(iter.Key())
+		}
+		iter.Close()
 	}
 }
 
@@ -253,6 +269,57 @@ func (k Keeper) IterateMissCounters(ctx sdk.Context, handler func(sdk.ValAddress
 	}
 }
 
+// GetInaccurateVoteCounter retrieves the # of vote periods in this oracle
+// slash window during which the validator voted outside of the reward band.
+func (k Keeper) GetInaccurateVoteCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyInaccurateVoteCounter(operator))
+	if bz == nil {
+		// by default the counter is zero
+		return 0
+	}
+
+	var inaccurateVoteCounter gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &inaccurateVoteCounter)
+
+	return inaccurateVoteCounter.Value
+}
+
+// SetInaccurateVoteCounter updates the # of vote periods in this oracle slash
+// window during which the validator voted outside of the reward band.
+func (k Keeper) SetInaccurateVoteCounter(ctx sdk.Context, operator sdk.ValAddress, inaccurateVoteCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: inaccurateVoteCounter})
+	store.Set(types.KeyInaccurateVoteCounter(operator), bz)
+}
+
+// DeleteInaccurateVoteCounter removes inaccurate vote counter for the validator.
+func (k Keeper) DeleteInaccurateVoteCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyInaccurateVoteCounter(operator))
+}
+
+// IterateInaccurateVoteCounters iterates over the inaccurate vote counters and
+// performs a callback function.
+func (k Keeper) IterateInaccurateVoteCounters(ctx sdk.Context, handler func(sdk.ValAddress, uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixInaccurateVoteCounter)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var inaccurateVoteCounter gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &inaccurateVoteCounter)
+
+		if handler(operator, inaccurateVoteCounter.Value) {
+			break
+		}
+	}
+}
+
 // GetAggregateExchangeRatePrevote retrieves an oracle prevote from the store.
 func (k Keeper) GetAggregateExchangeRatePrevote(
 	ctx sdk.Context,
@@ -150,6 +150,18 @@ func (s *IntegrationTestSuite) TestMissCounter() {
 	s.Require().Equal(app.OracleKeeper.GetMissCounter(ctx, valAddr), uint64(0))
 }
 
+func (s *IntegrationTestSuite) TestInaccurateVoteCounter() {
+	app, ctx := s.app, s.ctx
+	inaccurateVoteCounter := uint64(rand.Intn(100))
+
+	s.Require().Equal(app.OracleKeeper.GetInaccurateVoteCounter(ctx, valAddr), uint64(0))
+	app.OracleKeeper.SetInaccurateVoteCounter(ctx, valAddr, inaccurateVoteCounter)
+	s.Require().Equal(app.OracleKeeper.GetInaccurateVoteCounter(ctx, valAddr), inaccurateVoteCounter)
+
+	app.OracleKeeper.DeleteInaccurateVoteCounter(ctx, valAddr)
+	s.Require().Equal(app.OracleKeeper.GetInaccurateVoteCounter(ctx, valAddr), uint64(0))
+}
+
 func (s *IntegrationTestSuite) TestAggregateExchangeRatePrevote() {
 	app, ctx := s.app, s.ctx
 
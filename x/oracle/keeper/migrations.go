@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,3 +26,56 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	m.keeper.SetMaximumMedianStamps(ctx, 1)
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3. It sets the parameters introduced
+// in version 3 which are not in the store yet, leaving the existing stamp
+// parameters untouched, and re-encodes the block numbers of the stored historic
+// prices, medians and median deviations in big endian, so that they are
+// iterated in chronological order. Stamps are not pruned here: the EndBlocker
+// prunes them using the governance set maximums.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.KeyMaxInaccuratePerWindow) {
+		m.keeper.SetMaxInaccuratePerWindow(ctx, types.DefaultMaxInaccuratePerWindow)
+	}
+	if !m.keeper.paramSpace.Has(ctx, types.KeyInaccurateSlashFraction) {
+		m.keeper.SetInaccurateSlashFraction(ctx, types.DefaultInaccurateSlashFraction)
+	}
+	if !m.keeper.paramSpace.Has(ctx, types.KeyHistoricPricingEnabled) {
+		m.keeper.SetHistoricPricingEnabled(ctx, types.DefaultHistoricPricingEnabled)
+	}
+	if !m.keeper.paramSpace.Has(ctx, types.KeyCrossRates) {
+		m.keeper.SetCrossRates(ctx, nil)
+	}
+
+	m.migrateStampKeys(ctx, types.KeyPrefixHistoricPrice, types.KeyHistoricPrice)
+	m.migrateStampKeys(ctx, types.KeyPrefixMedian, types.KeyMedian)
+	m.migrateStampKeys(ctx, types.KeyPrefixMedianDeviation, types.KeyMedianDeviation)
+	return nil
+}
+
+// migrateStampKeys re-keys all the entries under the given prefix, whose keys
+// end with a little endian block number, using the provided key function.
+func (m Migrator) migrateStampKeys(
+	ctx sdk.Context,
+	prefix []byte,
+	key func(denom string, blockNum uint64) []byte,
+) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+
+	var oldKeys, values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		oldKeys = append(oldKeys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	for _, oldKey := range oldKeys {
+		store.Delete(oldKey)
+	}
+	for i, oldKey := range oldKeys {
+		denom := string(oldKey[len(prefix) : len(oldKey)-9])
+		blockNum := binary.LittleEndian.Uint64(oldKey[len(oldKey)-8:])
+		store.Set(key(denom, blockNum), values[i])
+	}
+}
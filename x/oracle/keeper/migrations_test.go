@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/umee-network/umee/v3/util"
+	"github.com/umee-network/umee/v3/x/oracle/keeper"
+	"github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestMigrate2to3() {
+	app, ctx := s.app, s.ctx
+
+	// store historic prices with little endian encoded block numbers, as
+	// they were stored before the migration
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, block := range []uint64{255, 256, 511} {
+		blockBz := make([]byte, 9)
+		binary.LittleEndian.PutUint64(blockBz[1:], block)
+		key := util.ConcatBytes(0, types.KeyPrefixHistoricPrice, []byte(displayDenom), blockBz)
+		store.Set(key, app.AppCodec().MustMarshal(&sdk.DecProto{Dec: sdk.NewDec(int64(block))}))
+	}
+	// the parameters set by governance before the upgrade must be kept, and
+	// the ones introduced by the upgrade must only be set when absent
+	app.OracleKeeper.SetMaximumPriceStamps(ctx, 2)
+	app.OracleKeeper.SetInaccurateSlashFraction(ctx, sdk.MustNewDecFromStr("0.02"))
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyHistoricPricingEnabled)
+
+	m := keeper.NewMigrator(&app.OracleKeeper)
+	s.Require().NoError(m.Migrate2to3(ctx))
+
+	s.Require().Equal(types.DefaultHistoricPricingEnabled, app.OracleKeeper.HistoricPricingEnabled(ctx))
+	s.Require().Equal(uint64(2), app.OracleKeeper.MaximumPriceStamps(ctx))
+	s.Require().Equal(sdk.MustNewDecFromStr("0.02"), app.OracleKeeper.InaccurateSlashFraction(ctx))
+
+	stamps := []types.Price{}
+	app.OracleKeeper.IterateAllHistoricPrices(ctx, func(price types.Price) bool {
+		stamps = append(stamps, price)
+		return false
+	})
+	// the stamps above the maximum are pruned by the EndBlocker, not by the
+	// migration
+	s.Require().Len(stamps, 3)
+	for i, block := range []uint64{255, 256, 511} {
+		s.Require().Equal(displayDenom, stamps[i].ExchangeRateTuple.Denom)
+		s.Require().Equal(block, stamps[i].BlockNum)
+		s.Require().Equal(sdk.NewDec(int64(block)), stamps[i].ExchangeRateTuple.ExchangeRate)
+	}
+}
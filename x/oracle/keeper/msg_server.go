@@ -132,7 +132,12 @@ func (ms msgServer) DelegateFeedConsent(
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
 	}
 
+	if err := ms.ValidateFeederDelegationChange(ctx, operatorAddr); err != nil {
+		return nil, err
+	}
+
 	ms.SetFeederDelegation(ctx, operatorAddr, delegateAddr)
+	ms.SetFeederDelegationHeight(ctx, operatorAddr, ctx.BlockHeight())
 	err = ctx.EventManager().EmitTypedEvent(&types.EventDelegateFeedConsent{
 		Operator: msg.Operator, Delegate: msg.Delegate})
 
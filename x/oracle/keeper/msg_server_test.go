@@ -153,4 +153,13 @@ func (s *IntegrationTestSuite) TestMsgServer_DelegateFeedConsent() {
 		Delegate: feederAddr.String(),
 	})
 	s.Require().NoError(err)
+
+	// the feeder can't change again in the same vote period
+	msg := &types.MsgDelegateFeedConsent{Operator: valAddr.String(), Delegate: sdk.AccAddress(valAddr2).String()}
+	votePeriod := int64(app.OracleKeeper.VotePeriod(ctx))
+	_, err = s.msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx.WithBlockHeight(ctx.BlockHeight()+votePeriod-1)), msg)
+	s.Require().ErrorIs(err, types.ErrFeederChangeTooSoon)
+
+	_, err = s.msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx.WithBlockHeight(ctx.BlockHeight()+votePeriod)), msg)
+	s.Require().NoError(err)
 }
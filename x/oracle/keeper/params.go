@@ -63,6 +63,30 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// MaxInaccuratePerWindow returns the maximum fraction of vote periods per
+// slash window in which a validator may vote outside of the reward band.
+func (k Keeper) MaxInaccuratePerWindow(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxInaccuratePerWindow, &res)
+	return
+}
+
+// SetMaxInaccuratePerWindow updates the maximum fraction of vote periods per
+// slash window in which a validator may vote outside of the reward band.
+func (k Keeper) SetMaxInaccuratePerWindow(ctx sdk.Context, maxInaccuratePerWindow sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyMaxInaccuratePerWindow, maxInaccuratePerWindow)
+}
+
+// InaccurateSlashFraction returns oracle inaccurate voting penalty rate
+func (k Keeper) InaccurateSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyInaccurateSlashFraction, &res)
+	return
+}
+
+// SetInaccurateSlashFraction updates oracle inaccurate voting penalty rate
+func (k Keeper) SetInaccurateSlashFraction(ctx sdk.Context, inaccurateSlashFraction sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyInaccurateSlashFraction, inaccurateSlashFraction)
+}
+
 // HistoricStampPeriod returns the amount of blocks the oracle module waits
 // before recording a new historic price.
 func (k Keeper) HistoricStampPeriod(ctx sdk.Context) (res uint64) {
@@ -115,6 +139,32 @@ func (k Keeper) SetMaximumMedianStamps(ctx sdk.Context, maximumMedianStamps uint
 	k.paramSpace.Set(ctx, types.KeyMaximumMedianStamps, maximumMedianStamps)
 }
 
+// HistoricPricingEnabled returns whether historic prices, medians and median
+// deviations are stamped and pruned by the oracle module.
+func (k Keeper) HistoricPricingEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyHistoricPricingEnabled, &res)
+	return
+}
+
+// SetHistoricPricingEnabled updates whether historic prices, medians and
+// median deviations are stamped and pruned by the oracle module.
+func (k Keeper) SetHistoricPricingEnabled(ctx sdk.Context, enabled bool) {
+	k.paramSpace.Set(ctx, types.KeyHistoricPricingEnabled, enabled)
+}
+
+// CrossRates returns the denoms whose exchange rates are derived from the
+// exchange rates of voted denoms.
+func (k Keeper) CrossRates(ctx sdk.Context) (res []types.CrossRate) {
+	k.paramSpace.Get(ctx, types.KeyCrossRates, &res)
+	return
+}
+
+// SetCrossRates updates the denoms whose exchange rates are derived from
+// the exchange rates of voted denoms.
+func (k Keeper) SetCrossRates(ctx sdk.Context, crossRates []types.CrossRate) {
+	k.paramSpace.Set(ctx, types.KeyCrossRates, crossRates)
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
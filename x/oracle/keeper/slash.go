@@ -11,19 +11,10 @@ import (
 // If the valid vote rate is below the minValidPerWindow, the validator will be
 // slashed and jailed.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
-	height := ctx.BlockHeight()
-	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
-
-	var (
-		slashWindow          = int64(k.SlashWindow(ctx))
-		votePeriod           = int64(k.VotePeriod(ctx))
-		votePeriodsPerWindow = sdk.NewDec(slashWindow).QuoInt64(votePeriod).TruncateInt64()
-	)
-
 	var (
-		minValidPerWindow = k.MinValidPerWindow(ctx)
-		slashFraction     = k.SlashFraction(ctx)
-		powerReduction    = k.StakingKeeper.PowerReduction(ctx)
+		votePeriodsPerWindow = k.votePeriodsPerWindow(ctx)
+		minValidPerWindow    = k.MinValidPerWindow(ctx)
+		slashFraction        = k.SlashFraction(ctx)
 	)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
@@ -33,25 +24,71 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 		// Slash and jail the validator if their valid vote rate is smaller than the
 		// minimum threshold.
 		if validVoteRate.LT(minValidPerWindow) {
-			validator := k.StakingKeeper.Validator(ctx, operator)
-			if validator.IsBonded() && !validator.IsJailed() {
-				consAddr, err := validator.GetConsAddr()
-				if err != nil {
-					panic(err)
-				}
-
-				k.StakingKeeper.Slash(
-					ctx,
-					consAddr,
-					distributionHeight,
-					validator.GetConsensusPower(powerReduction), slashFraction,
-				)
-
-				k.StakingKeeper.Jail(ctx, consAddr)
-			}
+			k.slashAndJail(ctx, operator, slashFraction)
 		}
 
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
 }
+
+// SlashAndResetInaccurateVoteCounters iterates over all the current inaccurate
+// vote counters and calculates the "inaccurate vote rate" as:
+// inaccurateVoteCounter/votePeriodsPerWindow.
+//
+// If the inaccurate vote rate is above the maxInaccuratePerWindow, the
+// validator will be slashed and jailed.
+func (k Keeper) SlashAndResetInaccurateVoteCounters(ctx sdk.Context) {
+	var (
+		votePeriodsPerWindow    = k.votePeriodsPerWindow(ctx)
+		maxInaccuratePerWindow  = k.MaxInaccuratePerWindow(ctx)
+		inaccurateSlashFraction = k.InaccurateSlashFraction(ctx)
+	)
+
+	k.IterateInaccurateVoteCounters(ctx, func(operator sdk.ValAddress, inaccurateVoteCounter uint64) bool {
+		inaccurateVoteRate := sdk.NewDec(int64(inaccurateVoteCounter)).QuoInt64(votePeriodsPerWindow)
+
+		// Slash and jail the validator if their inaccurate vote rate is larger
+		// than the maximum threshold.
+		if inaccurateVoteRate.GT(maxInaccuratePerWindow) {
+			k.slashAndJail(ctx, operator, inaccurateSlashFraction)
+		}
+
+		k.DeleteInaccurateVoteCounter(ctx, operator)
+		return false
+	})
+}
+
+// votePeriodsPerWindow returns the number of vote periods in a slash window.
+func (k Keeper) votePeriodsPerWindow(ctx sdk.Context) int64 {
+	var (
+		slashWindow = int64(k.SlashWindow(ctx))
+		votePeriod  = int64(k.VotePeriod(ctx))
+	)
+
+	return sdk.NewDec(slashWindow).QuoInt64(votePeriod).TruncateInt64()
+}
+
+// slashAndJail slashes the given fraction of a validator's stake and jails
+// it. Validators that are not bonded or are already jailed are skipped.
+func (k Keeper) slashAndJail(ctx sdk.Context, operator sdk.ValAddress, slashFraction sdk.Dec) {
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+
+	validator := k.StakingKeeper.Validator(ctx, operator)
+	if validator.IsBonded() && !validator.IsJailed() {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+
+		k.StakingKeeper.Slash(
+			ctx,
+			consAddr,
+			distributionHeight,
+			validator.GetConsensusPower(powerReduction), slashFraction,
+		)
+
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+}
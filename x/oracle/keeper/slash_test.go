@@ -57,3 +57,42 @@ func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
 	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	s.Require().Equal(amt, validator.Tokens)
 }
+
+func (s *IntegrationTestSuite) TestSlashAndResetInaccurateVoteCounters() {
+	// initial setup
+	addr, addr2 := valAddr, valAddr2
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	s.Require().Equal(amt, s.app.StakingKeeper.Validator(s.ctx, addr).GetBondedTokens())
+	s.Require().Equal(amt, s.app.StakingKeeper.Validator(s.ctx, addr2).GetBondedTokens())
+
+	votePeriodsPerWindow := sdk.NewDec(int64(s.app.OracleKeeper.SlashWindow(s.ctx))).QuoInt64(int64(s.app.OracleKeeper.VotePeriod(s.ctx))).TruncateInt64()
+	slashFraction := s.app.OracleKeeper.InaccurateSlashFraction(s.ctx)
+	maxInaccurateVotes := s.app.OracleKeeper.MaxInaccuratePerWindow(s.ctx).MulInt64(votePeriodsPerWindow).TruncateInt64()
+	// Case 1, no slash
+	s.app.OracleKeeper.SetInaccurateVoteCounter(s.ctx, valAddr, uint64(maxInaccurateVotes))
+	s.app.OracleKeeper.SlashAndResetInaccurateVoteCounters(s.ctx)
+	staking.EndBlocker(s.ctx, *s.app.StakingKeeper)
+
+	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().Equal(amt, validator.GetBondedTokens())
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetInaccurateVoteCounter(s.ctx, valAddr))
+
+	// Case 2, slash
+	s.app.OracleKeeper.SetInaccurateVoteCounter(s.ctx, valAddr, uint64(maxInaccurateVotes+1))
+	s.app.OracleKeeper.SlashAndResetInaccurateVoteCounters(s.ctx)
+	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().Equal(amt.Sub(slashFraction.MulInt(amt).TruncateInt()), validator.GetBondedTokens())
+	s.Require().True(validator.Jailed)
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetInaccurateVoteCounter(s.ctx, valAddr))
+
+	// Case 3, slash jailed validator
+	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	validator.Tokens = amt
+	s.app.StakingKeeper.SetValidator(s.ctx, validator)
+
+	s.app.OracleKeeper.SetInaccurateVoteCounter(s.ctx, valAddr, uint64(maxInaccurateVotes+1))
+	s.app.OracleKeeper.SlashAndResetInaccurateVoteCounters(s.ctx)
+	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().Equal(amt, validator.Tokens)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// MarkSubmittedMsg records that the validator submitted a message of the given
+// type URL at the current height. It returns an error if the validator already
+// did. The records are kept in the transient store, so they are part of the
+// consensus state of the block and are cleared at its end.
+func (k Keeper) MarkSubmittedMsg(ctx sdk.Context, operator sdk.ValAddress, typeURL string) error {
+	store := ctx.TransientStore(k.tStoreKey)
+	key := types.KeySubmittedMsg(operator, typeURL)
+	if store.Has(key) {
+		return types.ErrExistingMsg.Wrapf("%s from %s", typeURL, operator)
+	}
+
+	store.Set(key, []byte{1})
+	return nil
+}
+
+// GetFeederDelegationHeight returns the height at which the validator operator
+// last changed its feeder delegation, and false if it never did.
+func (k Keeper) GetFeederDelegationHeight(ctx sdk.Context, operator sdk.ValAddress) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyFeederDelegationHeight(operator))
+	if bz == nil {
+		return 0, false
+	}
+
+	var height gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &height)
+
+	return height.Value, true
+}
+
+// SetFeederDelegationHeight sets the height at which the validator operator
+// last changed its feeder delegation.
+func (k Keeper) SetFeederDelegationHeight(ctx sdk.Context, operator sdk.ValAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: height})
+	store.Set(types.KeyFeederDelegationHeight(operator), bz)
+}
+
+// ValidateFeederDelegationChange returns an error if the validator operator
+// already changed its feeder delegation in the last vote period, limiting the
+// feeder churn to one change per vote period.
+func (k Keeper) ValidateFeederDelegationChange(ctx sdk.Context, operator sdk.ValAddress) error {
+	height, ok := k.GetFeederDelegationHeight(ctx, operator)
+	if !ok {
+		return nil
+	}
+
+	votePeriod := int64(k.VotePeriod(ctx))
+	if ctx.BlockHeight() < height+votePeriod {
+		return types.ErrFeederChangeTooSoon.Wrapf(
+			"%s changed its feeder at height %d, next change allowed at height %d",
+			operator, height, height+votePeriod)
+	}
+
+	return nil
+}
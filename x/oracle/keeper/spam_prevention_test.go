@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestMarkSubmittedMsg() {
+	app := s.app
+	ctx, _ := s.ctx.CacheContext()
+	vote := sdk.MsgTypeURL(&types.MsgAggregateExchangeRateVote{})
+	prevote := sdk.MsgTypeURL(&types.MsgAggregateExchangeRatePrevote{})
+
+	s.Require().NoError(app.OracleKeeper.MarkSubmittedMsg(ctx, valAddr, vote))
+	s.Require().ErrorIs(app.OracleKeeper.MarkSubmittedMsg(ctx, valAddr, vote), types.ErrExistingMsg)
+
+	// the other messages and validators are independent
+	s.Require().NoError(app.OracleKeeper.MarkSubmittedMsg(ctx, valAddr, prevote))
+	s.Require().NoError(app.OracleKeeper.MarkSubmittedMsg(ctx, valAddr2, vote))
+}
+
+func (s *IntegrationTestSuite) TestValidateFeederDelegationChange() {
+	app, ctx := s.app, s.ctx
+
+	_, ok := app.OracleKeeper.GetFeederDelegationHeight(ctx, valAddr)
+	s.Require().False(ok)
+	s.Require().NoError(app.OracleKeeper.ValidateFeederDelegationChange(ctx, valAddr))
+
+	app.OracleKeeper.SetFeederDelegationHeight(ctx, valAddr, ctx.BlockHeight())
+	height, ok := app.OracleKeeper.GetFeederDelegationHeight(ctx, valAddr)
+	s.Require().True(ok)
+	s.Require().Equal(ctx.BlockHeight(), height)
+
+	votePeriod := int64(app.OracleKeeper.VotePeriod(ctx))
+	err := app.OracleKeeper.ValidateFeederDelegationChange(ctx.WithBlockHeight(height+votePeriod-1), valAddr)
+	s.Require().ErrorIs(err, types.ErrFeederChangeTooSoon)
+	s.Require().NoError(app.OracleKeeper.ValidateFeederDelegationChange(ctx.WithBlockHeight(height+votePeriod), valAddr))
+}
@@ -50,7 +50,7 @@ func (AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-func (AppModuleBasic) ConsensusVersion() uint64 { return 2 }
+func (AppModuleBasic) ConsensusVersion() uint64 { return 3 }
 
 // RegisterInterfaces registers the x/oracle module's interface types.
 func (AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
@@ -73,7 +73,7 @@ func (AppModuleBasic) ValidateGenesis(
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 
-	return nil
+	return types.ValidateGenesis(&genState)
 }
 
 // Deprecated: RegisterRESTRoutes performs a no-op. Querying is delegated to the
@@ -105,7 +105,6 @@ type AppModule struct {
 	keeper        keeper.Keeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    bankkeeper.Keeper
-	experimental  bool
 }
 
 func NewAppModule(
@@ -113,14 +112,12 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
-	experimental bool,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
-		experimental:   experimental,
 	}
 }
 
@@ -153,10 +150,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/oracle from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/oracle from version 2 to 3: %v", err))
+	}
 }
 
 // RegisterInvariants registers the x/oracle module's invariants.
-func (am AppModule) RegisterInvariants(sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the x/oracle module's genesis initialization. It returns
 // no validator updates.
@@ -182,7 +184,7 @@ func (am AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 // EndBlock executes all ABCI EndBlock logic respective to the x/oracle module.
 // It returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	if err := EndBlocker(ctx, am.keeper, am.experimental); err != nil {
+	if err := EndBlocker(ctx, am.keeper); err != nil {
 		panic(err)
 	}
 
@@ -33,6 +33,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &counterB)
 			return fmt.Sprintf("%v\n%v", counterA.Value, counterB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixInaccurateVoteCounter):
+			var counterA, counterB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &counterA)
+			cdc.MustUnmarshal(kvB.Value, &counterB)
+			return fmt.Sprintf("%v\n%v", counterA.Value, counterB.Value)
+
 		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAggregateExchangeRatePrevote):
 			var prevoteA, prevoteB types.AggregateExchangeRatePrevote
 			cdc.MustUnmarshal(kvA.Value, &prevoteA)
@@ -45,6 +51,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixDerivedExchangeRate):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -23,6 +23,9 @@ const (
 	medianStampPeriodKey        = "median_stamp_period"
 	maximumPriceStampsKey       = "maximum_price_stamps"
 	maximumMedianStampsKey      = "maximum_median_stamps"
+	maxInaccuratePerWindowKey   = "max_inaccurate_per_window"
+	inaccurateSlashFractionKey  = "inaccurate_slash_fraction"
+	historicPricingEnabledKey   = "historic_pricing_enabled"
 )
 
 // GenVotePeriod produces a randomized VotePeriod in the range of [5, 100]
@@ -80,6 +83,21 @@ func GenMaximumMedianStamps(r *rand.Rand) uint64 {
 	return uint64(11 + r.Intn(100))
 }
 
+// GenMaxInaccuratePerWindow produces a randomized MaxInaccuratePerWindow in the range of [0.500, 1.000]
+func GenMaxInaccuratePerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(500, 3).Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
+}
+
+// GenInaccurateSlashFraction produces a randomized InaccurateSlashFraction in the range of [0.000, 0.100]
+func GenInaccurateSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
+}
+
+// GenHistoricPricingEnabled produces a randomized HistoricPricingEnabled
+func GenHistoricPricingEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -148,6 +166,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maximumMedianStamps = GenMaximumMedianStamps(r) },
 	)
 
+	var maxInaccuratePerWindow sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxInaccuratePerWindowKey, &maxInaccuratePerWindow, simState.Rand,
+		func(r *rand.Rand) { maxInaccuratePerWindow = GenMaxInaccuratePerWindow(r) },
+	)
+
+	var inaccurateSlashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, inaccurateSlashFractionKey, &inaccurateSlashFraction, simState.Rand,
+		func(r *rand.Rand) { inaccurateSlashFraction = GenInaccurateSlashFraction(r) },
+	)
+
+	var historicPricingEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, historicPricingEnabledKey, &historicPricingEnabled, simState.Rand,
+		func(r *rand.Rand) { historicPricingEnabled = GenHistoricPricingEnabled(r) },
+	)
+
 	oracleGenesis := types.DefaultGenesisState()
 	oracleGenesis.Params = types.Params{
 		VotePeriod:               votePeriod,
@@ -164,6 +200,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		MedianStampPeriod:   medianStampPeriod,
 		MaximumPriceStamps:  historicStampPeriod,
 		MaximumMedianStamps: historicStampPeriod,
+
+		MaxInaccuratePerWindow:  maxInaccuratePerWindow,
+		InaccurateSlashFraction: inaccurateSlashFraction,
+		HistoricPricingEnabled:  historicPricingEnabled,
 	}
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
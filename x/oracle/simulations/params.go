@@ -44,5 +44,20 @@ func ParamChanges() []simtypes.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenSlashWindow(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxInaccuratePerWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxInaccuratePerWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyInaccurateSlashFraction),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInaccurateSlashFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyHistoricPricingEnabled),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenHistoricPricingEnabled(r))
+			},
+		),
 	}
 }
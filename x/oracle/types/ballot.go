@@ -150,7 +150,10 @@ type Claim struct {
 	Power       int64
 	Weight      int64
 	TokensVoted int64
-	Validator   sdk.ValAddress
+	// InaccurateVotes counts the tokens for which the validator voted
+	// outside of the reward band in the current vote period.
+	InaccurateVotes int64
+	Validator       sdk.ValAddress
 }
 
 // NewClaim generates a Claim instance.
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// String implements fmt.Stringer interface
+func (dr CrossRate) String() string {
+	out, _ := yaml.Marshal(dr)
+	return string(out)
+}
+
+// Validate performs basic validation of a cross rate.
+func (dr CrossRate) Validate() error {
+	if len(dr.Denom.BaseDenom) == 0 {
+		return fmt.Errorf("cross rate denom must have BaseDenom")
+	}
+	if len(dr.Denom.SymbolDenom) == 0 {
+		return fmt.Errorf("cross rate denom must have SymbolDenom")
+	}
+	if len(dr.Path) == 0 {
+		return fmt.Errorf("cross rate of %s must have a path", dr.Denom.SymbolDenom)
+	}
+	for _, denom := range dr.Path {
+		if len(denom) == 0 {
+			return fmt.Errorf("cross rate path of %s must not contain empty denoms", dr.Denom.SymbolDenom)
+		}
+		if strings.EqualFold(denom, dr.Denom.SymbolDenom) {
+			return fmt.Errorf("cross rate path of %s must not contain itself", dr.Denom.SymbolDenom)
+		}
+	}
+	if dr.Ratio.IsNil() || !dr.Ratio.IsPositive() {
+		return fmt.Errorf("cross rate ratio of %s must be positive: %s", dr.Denom.SymbolDenom, dr.Ratio)
+	}
+
+	return nil
+}
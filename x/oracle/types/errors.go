@@ -29,4 +29,7 @@ var (
 	ErrNoHistoricPrice       = sdkerrors.Register(ModuleName, 18, "no historic price for this denom at this block")
 	ErrNoMedian              = sdkerrors.Register(ModuleName, 19, "no median for this denom at this block")
 	ErrNoMedianDeviation     = sdkerrors.Register(ModuleName, 20, "no median deviation for this denom at this block")
+	ErrInvalidWindow         = sdkerrors.Register(ModuleName, 21, "invalid window; should be positive")
+	ErrExistingMsg           = sdkerrors.Register(ModuleName, 22, "message already submitted at the current height")
+	ErrFeederChangeTooSoon   = sdkerrors.Register(ModuleName, 23, "feeder delegation already changed in this voting period") //nolint: lll
 )
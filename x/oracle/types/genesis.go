@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -17,6 +18,7 @@ func NewGenesisState(
 	historicPrices []Price,
 	medianPrices []Price,
 	medianDeviationPrices []Price,
+	inaccurateVoteCounters []InaccurateVoteCounter,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -28,6 +30,7 @@ func NewGenesisState(
 		HistoricPrices:                historicPrices,
 		Medians:                       medianPrices,
 		MedianDeviations:              medianDeviationPrices,
+		InaccurateVoteCounters:        inaccurateVoteCounters,
 	}
 }
 
@@ -44,12 +47,49 @@ func DefaultGenesisState() *GenesisState {
 		HistoricPrices:                []Price{},
 		Medians:                       []Price{},
 		MedianDeviations:              []Price{},
+		InaccurateVoteCounters:        []InaccurateVoteCounter{},
 	}
 }
 
 // ValidateGenesis validates the oracle genesis state.
 func ValidateGenesis(data *GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := validatePriceStamps(data.HistoricPrices, data.Params.MaximumPriceStamps); err != nil {
+		return fmt.Errorf("invalid historic prices: %w", err)
+	}
+	if err := validatePriceStamps(data.Medians, data.Params.MaximumMedianStamps); err != nil {
+		return fmt.Errorf("invalid medians: %w", err)
+	}
+	if err := validatePriceStamps(data.MedianDeviations, data.Params.MaximumMedianStamps); err != nil {
+		return fmt.Errorf("invalid median deviations: %w", err)
+	}
+
+	return nil
+}
+
+// validatePriceStamps checks that every stamp has a denom and a non-negative
+// value, and that no denom has more than maxStamps stamps.
+func validatePriceStamps(stamps []Price, maxStamps uint64) error {
+	numStamps := map[string]uint64{}
+	for _, stamp := range stamps {
+		denom, rate := stamp.ExchangeRateTuple.Denom, stamp.ExchangeRateTuple.ExchangeRate
+		if len(denom) == 0 {
+			return fmt.Errorf("empty denom at block %d", stamp.BlockNum)
+		}
+		if rate.IsNil() || rate.IsNegative() {
+			return fmt.Errorf("invalid value %s of %s at block %d", rate, denom, stamp.BlockNum)
+		}
+
+		numStamps[denom]++
+		if numStamps[denom] > maxStamps {
+			return fmt.Errorf("%s has more than %d stamps", denom, maxStamps)
+		}
+	}
+
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application
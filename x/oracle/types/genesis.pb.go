@@ -34,6 +34,7 @@ type GenesisState struct {
 	Medians                       []Price                        `protobuf:"bytes,7,rep,name=medians,proto3" json:"medians"`
 	HistoricPrices                []Price                        `protobuf:"bytes,8,rep,name=historic_prices,json=historicPrices,proto3" json:"historic_prices"`
 	MedianDeviations              []Price                        `protobuf:"bytes,9,rep,name=medianDeviations,proto3" json:"medianDeviations"`
+	InaccurateVoteCounters        []InaccurateVoteCounter        `protobuf:"bytes,10,rep,name=inaccurate_vote_counters,json=inaccurateVoteCounters,proto3" json:"inaccurate_vote_counters"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +151,46 @@ func (m *MissCounter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MissCounter proto.InternalMessageInfo
 
+// InaccurateVoteCounter defines an inaccurate vote counter and validator
+// address pair used in oracle module's genesis state
+type InaccurateVoteCounter struct {
+	ValidatorAddress      string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	InaccurateVoteCounter uint64 `protobuf:"varint,2,opt,name=inaccurate_vote_counter,json=inaccurateVoteCounter,proto3" json:"inaccurate_vote_counter,omitempty"`
+}
+
+func (m *InaccurateVoteCounter) Reset()         { *m = InaccurateVoteCounter{} }
+func (m *InaccurateVoteCounter) String() string { return proto.CompactTextString(m) }
+func (*InaccurateVoteCounter) ProtoMessage()    {}
+func (*InaccurateVoteCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c99b4af40468acc1, []int{3}
+}
+func (m *InaccurateVoteCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InaccurateVoteCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InaccurateVoteCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InaccurateVoteCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InaccurateVoteCounter.Merge(m, src)
+}
+func (m *InaccurateVoteCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *InaccurateVoteCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_InaccurateVoteCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InaccurateVoteCounter proto.InternalMessageInfo
+
 // Price is an instance of a price "stamp"
 type Price struct {
 	ExchangeRateTuple ExchangeRateTuple `protobuf:"bytes,1,opt,name=exchange_rate_tuple,json=exchangeRateTuple,proto3" json:"exchange_rate_tuple"`
@@ -160,7 +201,7 @@ func (m *Price) Reset()         { *m = Price{} }
 func (m *Price) String() string { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()    {}
 func (*Price) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c99b4af40468acc1, []int{3}
+	return fileDescriptor_c99b4af40468acc1, []int{4}
 }
 func (m *Price) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,50 +234,53 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "umee.oracle.v1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "umee.oracle.v1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "umee.oracle.v1.MissCounter")
+	proto.RegisterType((*InaccurateVoteCounter)(nil), "umee.oracle.v1.InaccurateVoteCounter")
 	proto.RegisterType((*Price)(nil), "umee.oracle.v1.Price")
 }
 
 func init() { proto.RegisterFile("umee/oracle/v1/genesis.proto", fileDescriptor_c99b4af40468acc1) }
 
 var fileDescriptor_c99b4af40468acc1 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8c, 0xfb, 0x93, 0xb6, 0x9b, 0x36, 0x5f, 0xba, 0x1f, 0x20, 0x2b, 0xa1, 0x6e, 0x1a, 0x09,
-	0xa9, 0x08, 0xb0, 0xd5, 0x16, 0x1e, 0xa0, 0x21, 0xb4, 0x37, 0x80, 0xaa, 0xf0, 0x27, 0x21, 0x21,
-	0x6b, 0x63, 0x9f, 0x38, 0x56, 0x63, 0xaf, 0xb5, 0xbb, 0x36, 0x45, 0x08, 0x89, 0x47, 0xe0, 0x39,
-	0x78, 0x92, 0x5c, 0xf6, 0x92, 0x2b, 0x7e, 0x92, 0x17, 0x41, 0x5e, 0x6f, 0x9a, 0xc4, 0x69, 0x69,
-	0xef, 0x92, 0x33, 0x73, 0x66, 0x26, 0xca, 0x9c, 0x45, 0x77, 0xe3, 0x00, 0xc0, 0xa2, 0x8c, 0x38,
-	0x7d, 0xb0, 0x92, 0x3d, 0xcb, 0x83, 0x10, 0xb8, 0xcf, 0xcd, 0x88, 0x51, 0x41, 0x71, 0x39, 0x45,
-	0xcd, 0x0c, 0x35, 0x93, 0xbd, 0xea, 0x2d, 0x8f, 0x7a, 0x54, 0x42, 0x56, 0xfa, 0x29, 0x63, 0x55,
-	0x6b, 0x39, 0x0d, 0xc5, 0x97, 0x60, 0xe3, 0x6b, 0x11, 0xad, 0x1f, 0x67, 0xa2, 0xaf, 0x04, 0x11,
-	0x80, 0x1f, 0xa3, 0x62, 0x44, 0x18, 0x09, 0xb8, 0xae, 0xd5, 0xb5, 0xdd, 0xd2, 0xfe, 0x1d, 0x73,
-	0xd6, 0xc4, 0x3c, 0x91, 0x68, 0x73, 0x69, 0xf0, 0x73, 0xbb, 0xd0, 0x56, 0x5c, 0xfc, 0x06, 0xe1,
-	0x2e, 0x80, 0x0b, 0xcc, 0x76, 0xa1, 0x0f, 0x1e, 0x11, 0x3e, 0x0d, 0xb9, 0xbe, 0x50, 0x5f, 0xdc,
-	0x2d, 0xed, 0xd7, 0xf3, 0x0a, 0x47, 0x92, 0xd9, 0xba, 0x20, 0x2a, 0xad, 0xcd, 0x6e, 0x6e, 0xce,
-	0xb1, 0x8b, 0xca, 0x70, 0xe6, 0xf4, 0x48, 0xe8, 0x81, 0xcd, 0x88, 0x00, 0xae, 0x2f, 0x4a, 0xc9,
-	0x9d, 0xbc, 0xe4, 0x33, 0xc5, 0x6a, 0x13, 0x01, 0xaf, 0xe3, 0xa8, 0x0f, 0xcd, 0x6a, 0xaa, 0xf9,
-	0xfd, 0xd7, 0x36, 0x9e, 0x83, 0x78, 0x7b, 0x03, 0xa6, 0x66, 0x1c, 0x1f, 0xa1, 0x8d, 0xc0, 0xe7,
-	0xdc, 0x76, 0x68, 0x1c, 0x0a, 0x60, 0x5c, 0x5f, 0x92, 0x26, 0xb5, 0xbc, 0xc9, 0x0b, 0x9f, 0xf3,
-	0xa7, 0x19, 0x47, 0x45, 0x5e, 0x0f, 0x26, 0x23, 0x8e, 0x3f, 0xa3, 0x3a, 0xf1, 0x3c, 0x96, 0xa6,
-	0x07, 0x7b, 0x26, 0xb7, 0x1d, 0x31, 0x48, 0x68, 0x9a, 0x7f, 0x59, 0x4a, 0x3f, 0xcc, 0x4b, 0x1f,
-	0x8e, 0xf7, 0xa6, 0xd3, 0x9e, 0x64, 0x4b, 0xca, 0x6b, 0x8b, 0xfc, 0x83, 0xc3, 0x31, 0x43, 0x5b,
-	0x57, 0x99, 0x67, 0xce, 0x45, 0xe9, 0x7c, 0xff, 0x46, 0xce, 0x6f, 0x27, 0xb6, 0x55, 0x72, 0x15,
-	0x81, 0xe3, 0x27, 0x68, 0x25, 0x00, 0xd7, 0x27, 0x21, 0xd7, 0x57, 0xa4, 0xfa, 0xed, 0xb9, 0xb2,
-	0x30, 0xdf, 0x19, 0x2b, 0x8d, 0xb9, 0xb8, 0x85, 0xfe, 0xeb, 0xf9, 0x5c, 0x50, 0xe6, 0x3b, 0x76,
-	0x94, 0x12, 0xb8, 0xbe, 0x7a, 0xfd, 0x7a, 0x79, 0xbc, 0x23, 0x87, 0x1c, 0x1f, 0xa3, 0x4a, 0x26,
-	0xd8, 0x82, 0xc4, 0x57, 0x85, 0x5b, 0xbb, 0x5e, 0x66, 0x6e, 0xa9, 0xd1, 0x45, 0x95, 0x7c, 0x23,
-	0xf1, 0x3d, 0x54, 0x56, 0x7d, 0x26, 0xae, 0xcb, 0x80, 0x67, 0xd7, 0xb0, 0xd6, 0xde, 0xc8, 0xa6,
-	0x87, 0xd9, 0x10, 0x3f, 0x40, 0x9b, 0x09, 0xe9, 0xfb, 0x2e, 0x11, 0x74, 0xc2, 0x5c, 0x90, 0xcc,
-	0xca, 0x05, 0xa0, 0xc8, 0x8d, 0x0f, 0xa8, 0x34, 0xd5, 0xa0, 0xcb, 0x77, 0xb5, 0xcb, 0x77, 0xf1,
-	0x0e, 0x5a, 0x9f, 0xae, 0xa8, 0xf4, 0x58, 0x6a, 0x97, 0xa6, 0xea, 0xd7, 0xf8, 0x82, 0x96, 0xe5,
-	0xef, 0xc4, 0xef, 0xd0, 0xff, 0xb3, 0xff, 0xbf, 0x48, 0x5b, 0xaf, 0xce, 0xf9, 0x06, 0x97, 0xa3,
-	0xae, 0x11, 0xf2, 0x00, 0xae, 0xa1, 0xb5, 0x4e, 0x9f, 0x3a, 0xa7, 0x76, 0x18, 0x07, 0x2a, 0xc1,
-	0xaa, 0x1c, 0xbc, 0x8c, 0x83, 0xe6, 0xf3, 0xc1, 0x1f, 0xa3, 0x30, 0x18, 0x1a, 0xda, 0xf9, 0xd0,
-	0xd0, 0x7e, 0x0f, 0x0d, 0xed, 0xdb, 0xc8, 0x28, 0x9c, 0x8f, 0x8c, 0xc2, 0x8f, 0x91, 0x51, 0x78,
-	0x6f, 0x7a, 0xbe, 0xe8, 0xc5, 0x1d, 0xd3, 0xa1, 0x81, 0x95, 0x06, 0x78, 0x14, 0x82, 0xf8, 0x48,
-	0xd9, 0xa9, 0xfc, 0x62, 0x25, 0x07, 0xd6, 0xd9, 0xf8, 0x81, 0x12, 0x9f, 0x22, 0xe0, 0x9d, 0xa2,
-	0x7c, 0x9d, 0x0e, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x25, 0xbb, 0xa8, 0x00, 0x05, 0x00,
-	0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x4f, 0xd4, 0x4c,
+	0x14, 0xde, 0xf2, 0xb1, 0xc0, 0x2c, 0xec, 0x0b, 0xf3, 0x0a, 0x36, 0x8b, 0x94, 0x65, 0x13, 0x12,
+	0x8c, 0xda, 0x06, 0x50, 0xef, 0x41, 0x84, 0x98, 0xa8, 0x21, 0xeb, 0x57, 0x62, 0x62, 0x9a, 0xa1,
+	0x3d, 0x94, 0x09, 0xdb, 0x4e, 0x33, 0x33, 0xad, 0x18, 0xf5, 0x3f, 0xf8, 0x3b, 0xfc, 0x25, 0x5c,
+	0x72, 0xe9, 0x95, 0x1f, 0x70, 0xe1, 0xdf, 0x30, 0x9d, 0xce, 0x7e, 0x95, 0x5d, 0xc1, 0xbb, 0xdd,
+	0xf3, 0x3c, 0xe7, 0x79, 0xce, 0xce, 0x3e, 0xe7, 0xa0, 0x5b, 0x49, 0x08, 0xe0, 0x30, 0x4e, 0xbc,
+	0x16, 0x38, 0xe9, 0xba, 0x13, 0x40, 0x04, 0x82, 0x0a, 0x3b, 0xe6, 0x4c, 0x32, 0x5c, 0xcd, 0x50,
+	0x3b, 0x47, 0xed, 0x74, 0xbd, 0x76, 0x23, 0x60, 0x01, 0x53, 0x90, 0x93, 0x7d, 0xca, 0x59, 0xb5,
+	0xc5, 0x82, 0x86, 0xe6, 0x2b, 0xb0, 0xf1, 0xbb, 0x8c, 0xa6, 0xf7, 0x72, 0xd1, 0x17, 0x92, 0x48,
+	0xc0, 0xf7, 0x51, 0x39, 0x26, 0x9c, 0x84, 0xc2, 0x34, 0xea, 0xc6, 0x5a, 0x65, 0x63, 0xc1, 0xee,
+	0x37, 0xb1, 0xf7, 0x15, 0xba, 0x3d, 0x76, 0xfa, 0x7d, 0xb9, 0xd4, 0xd4, 0x5c, 0xfc, 0x0a, 0xe1,
+	0x43, 0x00, 0x1f, 0xb8, 0xeb, 0x43, 0x0b, 0x02, 0x22, 0x29, 0x8b, 0x84, 0x39, 0x52, 0x1f, 0x5d,
+	0xab, 0x6c, 0xd4, 0x8b, 0x0a, 0xbb, 0x8a, 0xb9, 0xd3, 0x21, 0x6a, 0xad, 0xb9, 0xc3, 0x42, 0x5d,
+	0x60, 0x1f, 0x55, 0xe1, 0xc4, 0x3b, 0x22, 0x51, 0x00, 0x2e, 0x27, 0x12, 0x84, 0x39, 0xaa, 0x24,
+	0x57, 0x8a, 0x92, 0x8f, 0x35, 0xab, 0x49, 0x24, 0xbc, 0x4c, 0xe2, 0x16, 0x6c, 0xd7, 0x32, 0xcd,
+	0xaf, 0x3f, 0x96, 0xf1, 0x25, 0x48, 0x34, 0x67, 0xa0, 0xa7, 0x26, 0xf0, 0x2e, 0x9a, 0x09, 0xa9,
+	0x10, 0xae, 0xc7, 0x92, 0x48, 0x02, 0x17, 0xe6, 0x98, 0x32, 0x59, 0x2c, 0x9a, 0x3c, 0xa3, 0x42,
+	0x3c, 0xca, 0x39, 0x7a, 0xe4, 0xe9, 0xb0, 0x5b, 0x12, 0xf8, 0x23, 0xaa, 0x93, 0x20, 0xe0, 0xd9,
+	0xf4, 0xe0, 0xf6, 0xcd, 0xed, 0xc6, 0x1c, 0x52, 0x96, 0xcd, 0x3f, 0xae, 0xa4, 0xef, 0x16, 0xa5,
+	0xb7, 0xda, 0x7d, 0xbd, 0xd3, 0xee, 0xe7, 0x4d, 0xda, 0x6b, 0x89, 0xfc, 0x85, 0x23, 0x30, 0x47,
+	0x4b, 0xc3, 0xcc, 0x73, 0xe7, 0xb2, 0x72, 0xbe, 0x7d, 0x2d, 0xe7, 0xd7, 0x5d, 0xdb, 0x1a, 0x19,
+	0x46, 0x10, 0xf8, 0x01, 0x9a, 0x08, 0xc1, 0xa7, 0x24, 0x12, 0xe6, 0x84, 0x52, 0x9f, 0xbf, 0x14,
+	0x16, 0x4e, 0xbd, 0xb6, 0x52, 0x9b, 0x8b, 0x77, 0xd0, 0x7f, 0x47, 0x54, 0x48, 0xc6, 0xa9, 0xe7,
+	0xc6, 0x19, 0x41, 0x98, 0x93, 0x57, 0xb7, 0x57, 0xdb, 0x3d, 0xaa, 0x28, 0xf0, 0x1e, 0x9a, 0xcd,
+	0x05, 0x77, 0x20, 0xa5, 0x3a, 0x70, 0x53, 0x57, 0xcb, 0x5c, 0x6a, 0xc2, 0x80, 0x4c, 0x1a, 0x11,
+	0xcf, 0x4b, 0x3a, 0x8f, 0xd5, 0x4d, 0x02, 0x52, 0x82, 0xab, 0x45, 0xc1, 0x27, 0x1d, 0x7e, 0xf6,
+	0x10, 0xfd, 0x99, 0x58, 0xa0, 0x83, 0x40, 0xd1, 0x38, 0x44, 0xb3, 0xc5, 0xe0, 0xe3, 0x55, 0x54,
+	0xd5, 0x6b, 0x43, 0x7c, 0x9f, 0x83, 0xc8, 0x97, 0x6e, 0xaa, 0x39, 0x93, 0x57, 0xb7, 0xf2, 0x22,
+	0xbe, 0x83, 0xe6, 0x52, 0xd2, 0xa2, 0x3e, 0x91, 0xac, 0xcb, 0x1c, 0x51, 0xcc, 0xd9, 0x0e, 0xa0,
+	0xc9, 0x8d, 0x77, 0xa8, 0xd2, 0x13, 0xd4, 0xc1, 0xbd, 0xc6, 0xe0, 0x5e, 0xbc, 0x82, 0xa6, 0x7b,
+	0x37, 0x41, 0x79, 0x8c, 0x35, 0x2b, 0x3d, 0x29, 0x6f, 0x7c, 0x42, 0xf3, 0x03, 0x7f, 0xfd, 0xbf,
+	0x19, 0x3d, 0x44, 0x37, 0x87, 0xbc, 0xb9, 0xf6, 0x9c, 0x1f, 0xf8, 0x8a, 0x8d, 0xcf, 0x68, 0x5c,
+	0xfd, 0x99, 0xf8, 0x0d, 0xfa, 0xbf, 0x3f, 0xe4, 0x32, 0x5b, 0x6d, 0x7d, 0xb3, 0xae, 0x71, 0x1e,
+	0xf4, 0xc9, 0x81, 0x22, 0x80, 0x17, 0xd1, 0xd4, 0x41, 0x8b, 0x79, 0xc7, 0x6e, 0x94, 0x84, 0x7a,
+	0x96, 0x49, 0x55, 0x78, 0x9e, 0x84, 0xdb, 0x4f, 0x4f, 0x7f, 0x59, 0xa5, 0xd3, 0x73, 0xcb, 0x38,
+	0x3b, 0xb7, 0x8c, 0x9f, 0xe7, 0x96, 0xf1, 0xe5, 0xc2, 0x2a, 0x9d, 0x5d, 0x58, 0xa5, 0x6f, 0x17,
+	0x56, 0xe9, 0xad, 0x1d, 0x50, 0x79, 0x94, 0x1c, 0xd8, 0x1e, 0x0b, 0x9d, 0x6c, 0x80, 0x7b, 0x11,
+	0xc8, 0xf7, 0x8c, 0x1f, 0xab, 0x2f, 0x4e, 0xba, 0xe9, 0x9c, 0xb4, 0xaf, 0xb0, 0xfc, 0x10, 0x83,
+	0x38, 0x28, 0xab, 0x13, 0xbc, 0xf9, 0x67, 0x00, 0x21, 0x60, 0x2b, 0x5e, 0xe5, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -259,6 +303,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InaccurateVoteCounters) > 0 {
+		for iNdEx := len(m.InaccurateVoteCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InaccurateVoteCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.MedianDeviations) > 0 {
 		for iNdEx := len(m.MedianDeviations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -456,6 +514,41 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InaccurateVoteCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InaccurateVoteCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InaccurateVoteCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InaccurateVoteCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InaccurateVoteCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Price) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -561,6 +654,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InaccurateVoteCounters) > 0 {
+		for _, e := range m.InaccurateVoteCounters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -597,6 +696,22 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *InaccurateVoteCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InaccurateVoteCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.InaccurateVoteCounter))
+	}
+	return n
+}
+
 func (m *Price) Size() (n int) {
 	if m == nil {
 		return 0
@@ -951,6 +1066,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InaccurateVoteCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InaccurateVoteCounters = append(m.InaccurateVoteCounters, InaccurateVoteCounter{})
+			if err := m.InaccurateVoteCounters[len(m.InaccurateVoteCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1187,6 +1336,107 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InaccurateVoteCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InaccurateVoteCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InaccurateVoteCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InaccurateVoteCounter", wireType)
+			}
+			m.InaccurateVoteCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InaccurateVoteCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Price) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,6 +57,34 @@ func TestGenesisValidation(t *testing.T) {
 	genState = DefaultGenesisState()
 	genState.Params.AcceptList = DenomList{Denom{}}
 	require.Error(t, ValidateGenesis(genState))
+
+	// Valid historic prices
+	genState = DefaultGenesisState()
+	genState.Params.MaximumPriceStamps = 2
+	genState.HistoricPrices = []Price{
+		{ExchangeRateTuple: ExchangeRateTuple{Denom: UmeeSymbol, ExchangeRate: sdk.OneDec()}, BlockNum: 1},
+		{ExchangeRateTuple: ExchangeRateTuple{Denom: UmeeSymbol, ExchangeRate: sdk.OneDec()}, BlockNum: 2},
+		{ExchangeRateTuple: ExchangeRateTuple{Denom: AtomSymbol, ExchangeRate: sdk.OneDec()}, BlockNum: 2},
+	}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Too many historic prices
+	genState.Params.MaximumPriceStamps = 1
+	require.ErrorContains(t, ValidateGenesis(genState), "umee has more than 1 stamps")
+
+	// Invalid medians
+	genState = DefaultGenesisState()
+	genState.Medians = []Price{
+		{ExchangeRateTuple: ExchangeRateTuple{Denom: "", ExchangeRate: sdk.OneDec()}, BlockNum: 1},
+	}
+	require.ErrorContains(t, ValidateGenesis(genState), "invalid medians: empty denom at block 1")
+
+	// Invalid median deviations
+	genState = DefaultGenesisState()
+	genState.MedianDeviations = []Price{
+		{ExchangeRateTuple: ExchangeRateTuple{Denom: UmeeSymbol, ExchangeRate: sdk.NewDec(-1)}, BlockNum: 1},
+	}
+	require.Error(t, ValidateGenesis(genState))
 }
 
 func TestGetGenesisStateFromAppState(t *testing.T) {
@@ -15,6 +15,9 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for oracle module
 	RouterKey = ModuleName
 
@@ -32,6 +35,14 @@ var (
 	KeyPrefixMedian                       = []byte{0x06} // prefix for each key to a price median
 	KeyPrefixMedianDeviation              = []byte{0x07} // prefix for each key to a price median standard deviation
 	KeyPrefixHistoricPrice                = []byte{0x08} // prefix for each key to a historic price
+	KeyPrefixInaccurateVoteCounter        = []byte{0x09} // prefix for each key to an inaccurate vote counter
+	KeyPrefixDerivedExchangeRate          = []byte{0x0A} // prefix for each key to a derived rate marker
+	KeyPrefixFeederDelegationHeight       = []byte{0x0B} // prefix for each key to a feeder delegation height
+)
+
+// Transient store key prefixes
+var (
+	KeyPrefixSubmittedMsg = []byte{0x01} // prefix for each key to a message submitted in the current block
 )
 
 // KeyExchangeRate - stored by *denom*
@@ -40,16 +51,37 @@ func KeyExchangeRate(denom string) []byte {
 	return util.ConcatBytes(1, KeyPrefixExchangeRate, []byte(denom))
 }
 
+// KeyDerivedExchangeRate - stored by *denom*
+func KeyDerivedExchangeRate(denom string) []byte {
+	// append 0 for null-termination
+	return util.ConcatBytes(1, KeyPrefixDerivedExchangeRate, []byte(denom))
+}
+
 // KeyFeederDelegation - stored by *Validator* address
 func KeyFeederDelegation(v sdk.ValAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixFeederDelegation, address.MustLengthPrefix(v))
 }
 
+// KeyFeederDelegationHeight - stored by *Validator* address
+func KeyFeederDelegationHeight(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixFeederDelegationHeight, address.MustLengthPrefix(v))
+}
+
+// KeySubmittedMsg - stored by *Validator* address and message type URL
+func KeySubmittedMsg(v sdk.ValAddress, typeURL string) []byte {
+	return util.ConcatBytes(0, KeyPrefixSubmittedMsg, address.MustLengthPrefix(v), []byte(typeURL))
+}
+
 // KeyMissCounter - stored by *Validator* address
 func KeyMissCounter(v sdk.ValAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixMissCounter, address.MustLengthPrefix(v))
 }
 
+// KeyInaccurateVoteCounter - stored by *Validator* address
+func KeyInaccurateVoteCounter(v sdk.ValAddress) []byte {
+	return util.ConcatBytes(0, KeyPrefixInaccurateVoteCounter, address.MustLengthPrefix(v))
+}
+
 // KeyAggregateExchangeRatePrevote - stored by *Validator* address
 func KeyAggregateExchangeRatePrevote(v sdk.ValAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixAggregateExchangeRatePrevote, address.MustLengthPrefix(v))
@@ -78,5 +110,5 @@ func KeyHistoricPrice(denom string, blockNum uint64) (key []byte) {
 // ParseDenomAndBlockFromKey returns the denom and block contained in the *key*
 // that has a uint64 at the end with a null prefix (length 9).
 func ParseDenomAndBlockFromKey(key []byte, prefix []byte) (string, uint64) {
-	return string(key[len(prefix) : len(key)-9]), binary.LittleEndian.Uint64(key[len(key)-8:])
+	return string(key[len(prefix) : len(key)-9]), binary.BigEndian.Uint64(key[len(key)-8:])
 }
@@ -47,6 +47,20 @@ type Params struct {
 	// Maximum Median Stamps represents the maximum amount of medians the
 	// oracle module will store before pruning via FIFO.
 	MaximumMedianStamps uint64 `protobuf:"varint,12,opt,name=maximum_median_stamps,json=maximumMedianStamps,proto3" json:"maximum_median_stamps,omitempty"`
+	// Max Inaccurate Per Window represents the maximum fraction of vote
+	// periods in a slash window during which a validator may vote outside
+	// of the reward band before being slashed.
+	MaxInaccuratePerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_inaccurate_per_window,json=maxInaccuratePerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_inaccurate_per_window" yaml:"max_inaccurate_per_window"`
+	// Inaccurate Slash Fraction represents the fraction of stake slashed
+	// from validators exceeding MaxInaccuratePerWindow.
+	InaccurateSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=inaccurate_slash_fraction,json=inaccurateSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inaccurate_slash_fraction" yaml:"inaccurate_slash_fraction"`
+	// Historic Pricing Enabled defines whether historic price stamps, medians
+	// and median deviations are recorded and pruned in the end blocker.
+	HistoricPricingEnabled bool `protobuf:"varint,15,opt,name=historic_pricing_enabled,json=historicPricingEnabled,proto3" json:"historic_pricing_enabled,omitempty" yaml:"historic_pricing_enabled"`
+	// Cross Rates defines the denoms whose exchange rates are derived from
+	// the exchange rates of voted denoms and a fixed ratio when they are not
+	// voted directly.
+	CrossRates []CrossRate `protobuf:"bytes,16,rep,name=cross_rates,json=crossRates,proto3" json:"cross_rates" yaml:"cross_rates"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -81,6 +95,92 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// CrossRate - the object to hold the configuration of a denom whose
+// exchange rate is derived from the exchange rates of other, voted denoms,
+// as the product of a fixed ratio and the exchange rates of the denoms in
+// path.
+type CrossRate struct {
+	Denom Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom" yaml:"denom"`
+	// path defines the symbol denoms whose exchange rates are multiplied
+	Path []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty" yaml:"path"`
+	// ratio defines the fixed ratio the exchange rates are multiplied by, set
+	// by governance
+	Ratio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio" yaml:"ratio"`
+}
+
+func (m *CrossRate) Reset()      { *m = CrossRate{} }
+func (*CrossRate) ProtoMessage() {}
+func (*CrossRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{1}
+}
+func (m *CrossRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossRate.Merge(m, src)
+}
+func (m *CrossRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossRate proto.InternalMessageInfo
+
+// ExchangeRateSource - struct to show whether the exchange rate of a denom
+// was voted by validators or derived from the exchange rates of other denoms
+type ExchangeRateSource struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	Derived      bool                                   `protobuf:"varint,3,opt,name=derived,proto3" json:"derived,omitempty" yaml:"derived"`
+}
+
+func (m *ExchangeRateSource) Reset()         { *m = ExchangeRateSource{} }
+func (m *ExchangeRateSource) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateSource) ProtoMessage()    {}
+func (*ExchangeRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8893c9e0e94ceb54, []int{2}
+}
+func (m *ExchangeRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateSource.Merge(m, src)
+}
+func (m *ExchangeRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateSource proto.InternalMessageInfo
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
@@ -91,7 +191,7 @@ type Denom struct {
 func (m *Denom) Reset()      { *m = Denom{} }
 func (*Denom) ProtoMessage() {}
 func (*Denom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8893c9e0e94ceb54, []int{1}
+	return fileDescriptor_8893c9e0e94ceb54, []int{3}
 }
 func (m *Denom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,7 +234,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8893c9e0e94ceb54, []int{2}
+	return fileDescriptor_8893c9e0e94ceb54, []int{4}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +273,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8893c9e0e94ceb54, []int{3}
+	return fileDescriptor_8893c9e0e94ceb54, []int{5}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +311,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8893c9e0e94ceb54, []int{4}
+	return fileDescriptor_8893c9e0e94ceb54, []int{6}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,6 +342,8 @@ var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Params)(nil), "umee.oracle.v1.Params")
+	proto.RegisterType((*CrossRate)(nil), "umee.oracle.v1.CrossRate")
+	proto.RegisterType((*ExchangeRateSource)(nil), "umee.oracle.v1.ExchangeRateSource")
 	proto.RegisterType((*Denom)(nil), "umee.oracle.v1.Denom")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "umee.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "umee.oracle.v1.AggregateExchangeRateVote")
@@ -251,61 +353,76 @@ func init() {
 func init() { proto.RegisterFile("umee/oracle/v1/oracle.proto", fileDescriptor_8893c9e0e94ceb54) }
 
 var fileDescriptor_8893c9e0e94ceb54 = []byte{
-	// 863 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xe4, 0x44,
-	0x14, 0x5e, 0x73, 0x49, 0x2e, 0x3b, 0xbb, 0xb9, 0x23, 0xce, 0x06, 0xcc, 0x05, 0xad, 0x73, 0x83,
-	0x38, 0xd2, 0xdc, 0x2e, 0x77, 0x87, 0x84, 0x48, 0x87, 0x15, 0x8e, 0xe6, 0x4e, 0x5a, 0x99, 0xd3,
-	0x21, 0xd1, 0x58, 0x63, 0x7b, 0x58, 0x8f, 0x62, 0x7b, 0xac, 0x99, 0xd9, 0x4d, 0xd2, 0x50, 0x53,
-	0x21, 0x4a, 0xca, 0xd4, 0xf4, 0x20, 0xfe, 0x84, 0x94, 0x57, 0x22, 0x0a, 0x03, 0x49, 0x43, 0xed,
-	0xbf, 0x00, 0xcd, 0x0f, 0x27, 0xb3, 0xc9, 0x16, 0x44, 0x54, 0xeb, 0x37, 0xdf, 0xfb, 0xde, 0xfb,
-	0xde, 0x7b, 0xf3, 0x66, 0xc1, 0xce, 0xac, 0xc0, 0x78, 0x4c, 0x19, 0x4a, 0x72, 0x3c, 0x9e, 0x3f,
-	0x31, 0x5f, 0xa3, 0x8a, 0x51, 0x41, 0xdd, 0x7b, 0x12, 0x1c, 0x99, 0xa3, 0xf9, 0x93, 0x07, 0x83,
-	0x29, 0x9d, 0x52, 0x05, 0x8d, 0xe5, 0x97, 0xf6, 0x82, 0xf5, 0x5d, 0xb0, 0x36, 0x41, 0x0c, 0x15,
-	0xdc, 0xfd, 0x14, 0xf4, 0xe6, 0x54, 0xe0, 0xa8, 0xc2, 0x8c, 0xd0, 0xd4, 0x73, 0x76, 0x9d, 0xbd,
-	0x95, 0xe0, 0x9d, 0xa6, 0xf6, 0xdd, 0x13, 0x54, 0xe4, 0xfb, 0xd0, 0x02, 0x61, 0x08, 0xa4, 0x35,
-	0x51, 0x86, 0x5b, 0x82, 0x7b, 0x0a, 0x13, 0x19, 0xc3, 0x3c, 0xa3, 0x79, 0xea, 0xbd, 0xb5, 0xeb,
-	0xec, 0x75, 0x83, 0x2f, 0xcf, 0x6a, 0xbf, 0xf3, 0x47, 0xed, 0x3f, 0x9a, 0x12, 0x91, 0xcd, 0xe2,
-	0x51, 0x42, 0x8b, 0x71, 0x42, 0x79, 0x41, 0xb9, 0xf9, 0x79, 0xcc, 0xd3, 0xc3, 0xb1, 0x38, 0xa9,
-	0x30, 0x1f, 0x1d, 0xe0, 0xa4, 0xa9, 0xfd, 0x6d, 0x2b, 0xd3, 0x65, 0x34, 0x18, 0x6e, 0xc8, 0x83,
-	0x57, 0xad, 0xed, 0x62, 0xd0, 0x63, 0xf8, 0x08, 0xb1, 0x34, 0x8a, 0x51, 0x99, 0x7a, 0x77, 0x54,
-	0xb2, 0x83, 0x5b, 0x27, 0x33, 0x65, 0x59, 0xa1, 0x60, 0x08, 0xb4, 0x15, 0xa0, 0x32, 0x75, 0x13,
-	0xf0, 0xc0, 0x60, 0x29, 0xe1, 0x82, 0x91, 0x78, 0x26, 0x08, 0x2d, 0xa3, 0x23, 0x52, 0xa6, 0xf4,
-	0xc8, 0x5b, 0x51, 0xed, 0xf9, 0xb0, 0xa9, 0xfd, 0x87, 0x0b, 0x71, 0x96, 0xf8, 0xc2, 0xd0, 0xd3,
-	0xe0, 0x81, 0x85, 0x7d, 0xad, 0x20, 0x37, 0x02, 0x3d, 0x94, 0x24, 0xb8, 0x12, 0x51, 0x4e, 0xb8,
-	0xf0, 0x56, 0x77, 0xef, 0xec, 0xf5, 0x9e, 0x6e, 0x8f, 0x16, 0x67, 0x37, 0x3a, 0xc0, 0x25, 0x2d,
-	0x82, 0x8f, 0x64, 0x89, 0x57, 0xc2, 0x2d, 0x1e, 0xfc, 0xf9, 0x4f, 0xbf, 0xab, 0x9c, 0x5e, 0x10,
-	0x2e, 0x42, 0xa0, 0x21, 0xf9, 0x2d, 0x87, 0xc3, 0x73, 0xc4, 0xb3, 0xe8, 0x5b, 0x86, 0x12, 0x99,
-	0xd8, 0x5b, 0xfb, 0x7f, 0xc3, 0x59, 0x8c, 0x06, 0xc3, 0x0d, 0x75, 0xf0, 0xdc, 0xd8, 0xee, 0x3e,
-	0xe8, 0x6b, 0x0f, 0xd3, 0xa7, 0xbb, 0xaa, 0x4f, 0xef, 0x36, 0xb5, 0xbf, 0x65, 0xf3, 0xdb, 0xce,
-	0xf4, 0x94, 0x69, 0x9a, 0xf1, 0x1d, 0x18, 0x14, 0xa4, 0x8c, 0xe6, 0x28, 0x27, 0xa9, 0xbc, 0x69,
-	0x6d, 0x8c, 0x75, 0xa5, 0xf8, 0xe5, 0xad, 0x15, 0xef, 0xe8, 0x8c, 0xcb, 0x62, 0xc2, 0x70, 0xb3,
-	0x20, 0xe5, 0x6b, 0x79, 0x3a, 0xc1, 0xcc, 0xe4, 0x7f, 0x0a, 0xb6, 0x33, 0xc2, 0x05, 0x65, 0x24,
-	0x89, 0xb8, 0x40, 0x45, 0xd5, 0xee, 0x42, 0x57, 0x16, 0x11, 0x6e, 0xb5, 0xe0, 0x57, 0x12, 0x33,
-	0x97, 0x7f, 0x04, 0xb6, 0x0a, 0x9c, 0x12, 0x54, 0x2e, 0x32, 0x80, 0x62, 0x6c, 0x6a, 0xc8, 0xf6,
-	0xff, 0x18, 0x0c, 0x0a, 0x74, 0x4c, 0x8a, 0x59, 0x11, 0x55, 0x8c, 0x24, 0x58, 0xd3, 0xb8, 0xd7,
-	0x53, 0x04, 0xd7, 0x60, 0x13, 0x09, 0x29, 0x1a, 0x97, 0xaa, 0x5a, 0x86, 0x9d, 0x89, 0x7b, 0x7d,
-	0xad, 0xca, 0x80, 0x2f, 0xaf, 0x52, 0xf1, 0xfd, 0xf5, 0x9f, 0x4e, 0xfd, 0xce, 0x3f, 0xa7, 0xbe,
-	0x03, 0x7f, 0x73, 0xc0, 0xaa, 0xba, 0x19, 0xee, 0x27, 0x00, 0xc4, 0x88, 0xe3, 0x28, 0x95, 0x96,
-	0x5a, 0xef, 0x6e, 0xb0, 0xdd, 0xd4, 0xfe, 0xa6, 0xee, 0xd2, 0x15, 0x06, 0xc3, 0xae, 0x34, 0x34,
-	0x4b, 0xce, 0xf3, 0xa4, 0x88, 0x69, 0x6e, 0x78, 0x7a, 0xb5, 0xed, 0x79, 0x5a, 0xa8, 0x9c, 0xa7,
-	0x32, 0x35, 0x77, 0x0c, 0xd6, 0xf1, 0x71, 0x45, 0x4b, 0x5c, 0x0a, 0xb5, 0xa5, 0x1b, 0xc1, 0x56,
-	0x53, 0xfb, 0xf7, 0x35, 0xaf, 0x45, 0x60, 0x78, 0xe9, 0xb4, 0xdf, 0xff, 0xfe, 0xd4, 0xef, 0x18,
-	0xe9, 0x1d, 0xf8, 0x8b, 0x03, 0xde, 0xff, 0x7c, 0x3a, 0x65, 0x78, 0x8a, 0x04, 0xfe, 0xe2, 0x38,
-	0xc9, 0x50, 0x39, 0xc5, 0x21, 0x12, 0x78, 0xc2, 0xb0, 0x7c, 0x11, 0xdc, 0x0f, 0xc0, 0x4a, 0x86,
-	0x78, 0x66, 0x6a, 0xb9, 0xdf, 0xd4, 0x7e, 0x4f, 0xc7, 0x96, 0xa7, 0x30, 0x54, 0xa0, 0xfb, 0x08,
-	0xac, 0x4a, 0x67, 0x66, 0x94, 0xbf, 0xdd, 0xd4, 0x7e, 0xff, 0xea, 0x99, 0x61, 0x30, 0xd4, 0xb0,
-	0x2a, 0x74, 0x16, 0x17, 0x44, 0x44, 0x71, 0x4e, 0x93, 0x43, 0x25, 0x78, 0xf1, 0xe2, 0x5a, 0xa8,
-	0x2c, 0x54, 0x99, 0x81, 0xb4, 0xae, 0xe9, 0x3e, 0x77, 0xc0, 0x7b, 0x4b, 0x75, 0xbf, 0x96, 0xa2,
-	0x7f, 0x70, 0xc0, 0x00, 0x9b, 0xc3, 0x88, 0x21, 0xf9, 0xd2, 0xcd, 0xaa, 0x1c, 0x73, 0xcf, 0x51,
-	0xbb, 0xff, 0xf0, 0xfa, 0xee, 0xdb, 0x01, 0x5e, 0x49, 0xcf, 0xe0, 0x33, 0xf3, 0x0e, 0xec, 0xb4,
-	0x8d, 0xbc, 0x19, 0x4c, 0x3e, 0x08, 0xee, 0x0d, 0x26, 0x0f, 0x5d, 0x7c, 0xe3, 0xec, 0xbf, 0x36,
-	0xe8, 0x5a, 0x91, 0xbf, 0x3a, 0x60, 0xf3, 0x46, 0x02, 0x19, 0xcb, 0xbe, 0x5e, 0x56, 0x2c, 0x73,
-	0x3f, 0x34, 0xec, 0x1e, 0x82, 0x8d, 0x05, 0xd9, 0x26, 0xf7, 0xf3, 0x5b, 0xaf, 0xf8, 0x60, 0x49,
-	0x0f, 0x60, 0xd8, 0xb7, 0xcb, 0x5c, 0x14, 0x1e, 0xbc, 0x38, 0xfb, 0x7b, 0xd8, 0x39, 0x3b, 0x1f,
-	0x3a, 0x6f, 0xce, 0x87, 0xce, 0x5f, 0xe7, 0x43, 0xe7, 0xc7, 0x8b, 0x61, 0xe7, 0xcd, 0xc5, 0xb0,
-	0xf3, 0xfb, 0xc5, 0xb0, 0xf3, 0xcd, 0xc8, 0xca, 0x2c, 0x07, 0xf1, 0xb8, 0xc4, 0xe2, 0x88, 0xb2,
-	0x43, 0x65, 0x8c, 0xe7, 0xcf, 0xc6, 0xc7, 0xed, 0xff, 0xad, 0x52, 0x11, 0xaf, 0xa9, 0xbf, 0xd1,
-	0x67, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xfa, 0xd8, 0xf3, 0x8b, 0x07, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x36, 0x1f, 0xb5, 0xc7, 0xf9, 0x68, 0x36, 0x4e, 0xba, 0x49, 0x90, 0xd7, 0x9d, 0x8a,
+	0x92, 0x03, 0xb5, 0x69, 0x8b, 0x84, 0xc8, 0x01, 0xa9, 0x4b, 0x5a, 0x84, 0xd4, 0x4a, 0xd6, 0x24,
+	0x0a, 0x12, 0x12, 0x5a, 0x8d, 0x77, 0x07, 0x7b, 0x15, 0xef, 0x8e, 0xb5, 0x33, 0x76, 0x9c, 0x0b,
+	0xe7, 0x5e, 0xa8, 0x38, 0x72, 0xcc, 0x99, 0x3b, 0x15, 0x7f, 0x42, 0x8e, 0xbd, 0x81, 0x38, 0x2c,
+	0x34, 0xb9, 0x70, 0xf6, 0x5f, 0x80, 0xe6, 0x63, 0x9d, 0xb1, 0x93, 0x48, 0x44, 0xbd, 0x70, 0xf2,
+	0xbe, 0xf7, 0x7b, 0xbf, 0xf7, 0x39, 0x6f, 0xc6, 0x60, 0xab, 0x1f, 0x13, 0xd2, 0xa0, 0x29, 0x0e,
+	0xba, 0xa4, 0x31, 0x78, 0xa4, 0xbf, 0xea, 0xbd, 0x94, 0x72, 0x6a, 0x2f, 0x09, 0xb0, 0xae, 0x55,
+	0x83, 0x47, 0x9b, 0x95, 0x36, 0x6d, 0x53, 0x09, 0x35, 0xc4, 0x97, 0xb2, 0x82, 0x6f, 0xca, 0x60,
+	0xbe, 0x89, 0x53, 0x1c, 0x33, 0xfb, 0x33, 0x50, 0x1e, 0x50, 0x4e, 0xfc, 0x1e, 0x49, 0x23, 0x1a,
+	0x3a, 0x56, 0xcd, 0xda, 0x9e, 0xf5, 0xd6, 0x47, 0x99, 0x6b, 0x1f, 0xe3, 0xb8, 0xbb, 0x03, 0x0d,
+	0x10, 0x22, 0x20, 0xa4, 0xa6, 0x14, 0xec, 0x04, 0x2c, 0x49, 0x8c, 0x77, 0x52, 0xc2, 0x3a, 0xb4,
+	0x1b, 0x3a, 0xb7, 0x6a, 0xd6, 0x76, 0xc9, 0xfb, 0xea, 0x34, 0x73, 0x0b, 0x7f, 0x66, 0xee, 0x83,
+	0x76, 0xc4, 0x3b, 0xfd, 0x56, 0x3d, 0xa0, 0x71, 0x23, 0xa0, 0x2c, 0xa6, 0x4c, 0xff, 0x3c, 0x64,
+	0xe1, 0x61, 0x83, 0x1f, 0xf7, 0x08, 0xab, 0xef, 0x92, 0x60, 0x94, 0xb9, 0x6b, 0x46, 0xa4, 0xb1,
+	0x37, 0x88, 0x16, 0x85, 0x62, 0x3f, 0x97, 0x6d, 0x02, 0xca, 0x29, 0x39, 0xc2, 0x69, 0xe8, 0xb7,
+	0x70, 0x12, 0x3a, 0x33, 0x32, 0xd8, 0xee, 0x8d, 0x83, 0xe9, 0xb2, 0x0c, 0x57, 0x10, 0x01, 0x25,
+	0x79, 0x38, 0x09, 0xed, 0x00, 0x6c, 0x6a, 0x2c, 0x8c, 0x18, 0x4f, 0xa3, 0x56, 0x9f, 0x47, 0x34,
+	0xf1, 0x8f, 0xa2, 0x24, 0xa4, 0x47, 0xce, 0xac, 0x6c, 0xcf, 0x87, 0xa3, 0xcc, 0xbd, 0x37, 0xe1,
+	0xe7, 0x0a, 0x5b, 0x88, 0x1c, 0x05, 0xee, 0x1a, 0xd8, 0x37, 0x12, 0xb2, 0x7d, 0x50, 0xc6, 0x41,
+	0x40, 0x7a, 0xdc, 0xef, 0x46, 0x8c, 0x3b, 0x73, 0xb5, 0x99, 0xed, 0xf2, 0xe3, 0xb5, 0xfa, 0xe4,
+	0xec, 0xea, 0xbb, 0x24, 0xa1, 0xb1, 0xf7, 0x91, 0x28, 0xf1, 0x22, 0x71, 0x83, 0x07, 0x7f, 0xf9,
+	0xcb, 0x2d, 0x49, 0xa3, 0x17, 0x11, 0xe3, 0x08, 0x28, 0x48, 0x7c, 0x8b, 0xe1, 0xb0, 0x2e, 0x66,
+	0x1d, 0xff, 0xfb, 0x14, 0x07, 0x22, 0xb0, 0x33, 0xff, 0x7e, 0xc3, 0x99, 0xf4, 0x06, 0xd1, 0xa2,
+	0x54, 0x3c, 0xd7, 0xb2, 0xbd, 0x03, 0x16, 0x94, 0x85, 0xee, 0xd3, 0x6d, 0xd9, 0xa7, 0xbb, 0xa3,
+	0xcc, 0x5d, 0x35, 0xf9, 0x79, 0x67, 0xca, 0x52, 0xd4, 0xcd, 0xf8, 0x01, 0x54, 0xe2, 0x28, 0xf1,
+	0x07, 0xb8, 0x1b, 0x85, 0xe2, 0xa4, 0xe5, 0x3e, 0x8a, 0x32, 0xe3, 0x97, 0x37, 0xce, 0x78, 0x4b,
+	0x45, 0xbc, 0xca, 0x27, 0x44, 0x2b, 0x71, 0x94, 0x1c, 0x08, 0x6d, 0x93, 0xa4, 0x3a, 0xfe, 0x63,
+	0xb0, 0xd6, 0x89, 0x18, 0xa7, 0x69, 0x14, 0xf8, 0x8c, 0xe3, 0xb8, 0x97, 0xef, 0x42, 0x49, 0x14,
+	0x81, 0x56, 0x73, 0x70, 0x4f, 0x60, 0xfa, 0xf0, 0xd7, 0xc1, 0x6a, 0x4c, 0xc2, 0x08, 0x27, 0x93,
+	0x0c, 0x20, 0x19, 0x2b, 0x0a, 0x32, 0xed, 0x3f, 0x01, 0x95, 0x18, 0x0f, 0xa3, 0xb8, 0x1f, 0xfb,
+	0xbd, 0x34, 0x0a, 0x88, 0xa2, 0x31, 0xa7, 0x2c, 0x09, 0xb6, 0xc6, 0x9a, 0x02, 0x92, 0x34, 0x26,
+	0xb2, 0xca, 0x19, 0x66, 0x24, 0xe6, 0x2c, 0xa8, 0xac, 0x34, 0xf8, 0xf2, 0x22, 0x14, 0xb3, 0x7f,
+	0xb4, 0xc0, 0x46, 0x8c, 0x87, 0x7e, 0x94, 0xe0, 0x20, 0xe8, 0xa7, 0x98, 0x13, 0xa3, 0x76, 0x67,
+	0x51, 0xf6, 0x13, 0xdd, 0xb8, 0x9f, 0x35, 0xdd, 0xcf, 0xeb, 0x1c, 0x43, 0xb4, 0x1e, 0xe3, 0xe1,
+	0xd7, 0x63, 0xe8, 0xa2, 0xb3, 0xaf, 0x2d, 0xb0, 0x61, 0x50, 0xa6, 0x4e, 0xe4, 0xd2, 0xfb, 0xe5,
+	0x73, 0xad, 0x63, 0x88, 0xee, 0x5e, 0x60, 0x7b, 0x13, 0xc7, 0xf4, 0x3b, 0xe0, 0x8c, 0x47, 0x2d,
+	0xe6, 0x10, 0x25, 0x6d, 0x9f, 0x24, 0xb8, 0xd5, 0x25, 0xa1, 0xb3, 0x5c, 0xb3, 0xb6, 0x8b, 0xde,
+	0xfd, 0x51, 0xe6, 0xba, 0x2a, 0xc0, 0x75, 0x96, 0x10, 0xad, 0xe7, 0x50, 0x53, 0x21, 0xcf, 0x14,
+	0x60, 0x1f, 0x80, 0x72, 0x90, 0x52, 0xc6, 0x7c, 0x11, 0x99, 0x39, 0x77, 0xe4, 0x5a, 0x6f, 0x4c,
+	0xaf, 0xf5, 0x97, 0xc2, 0x04, 0x61, 0x4e, 0xbc, 0xcd, 0xc9, 0xd5, 0x36, 0xb8, 0x10, 0x81, 0x20,
+	0x37, 0x63, 0x3b, 0xc5, 0x9f, 0x4f, 0xdc, 0xc2, 0x3f, 0x27, 0xae, 0x05, 0x7f, 0xb7, 0x40, 0x69,
+	0xcc, 0xb7, 0x9f, 0x82, 0xb9, 0x50, 0xac, 0xbf, 0xbc, 0xb5, 0xaf, 0xbd, 0x40, 0x2a, 0x3a, 0xca,
+	0x82, 0x8a, 0x22, 0x19, 0x10, 0x29, 0xa6, 0x7d, 0x1f, 0xcc, 0xf6, 0x30, 0xef, 0x38, 0xb7, 0x6a,
+	0x33, 0xdb, 0x25, 0x6f, 0x79, 0x94, 0xb9, 0x65, 0x65, 0x26, 0xb4, 0x10, 0x49, 0xd0, 0xde, 0x07,
+	0x73, 0x29, 0xe6, 0x11, 0xd5, 0x97, 0xee, 0x17, 0x37, 0x1e, 0x99, 0x0e, 0x2d, 0x9d, 0x40, 0xa4,
+	0x9c, 0xed, 0x2c, 0xbc, 0x3a, 0x71, 0x0b, 0xe3, 0xca, 0xde, 0x59, 0xc0, 0x7e, 0x36, 0x0c, 0x3a,
+	0x38, 0x69, 0x13, 0x51, 0xdc, 0x1e, 0xed, 0xa7, 0x01, 0xb1, 0x1f, 0x98, 0x25, 0x96, 0xbc, 0x3b,
+	0xd7, 0xd5, 0x71, 0x08, 0x16, 0x89, 0x66, 0xcb, 0x0e, 0xea, 0xc7, 0xe8, 0xf9, 0x8d, 0x53, 0xad,
+	0x28, 0xef, 0x13, 0xce, 0x20, 0x5a, 0x20, 0x46, 0x6a, 0xf6, 0xc7, 0xe0, 0x76, 0x48, 0xd2, 0x68,
+	0x40, 0xd4, 0x33, 0x54, 0xf4, 0xec, 0x51, 0xe6, 0x2e, 0xe5, 0x69, 0x49, 0x00, 0xa2, 0xdc, 0x64,
+	0xa7, 0xf8, 0x4a, 0xd5, 0x58, 0x80, 0xbf, 0x59, 0x60, 0x4e, 0xce, 0xc4, 0xfe, 0x14, 0x80, 0x16,
+	0x66, 0xc4, 0x37, 0x6b, 0x5b, 0x1b, 0x65, 0xee, 0x8a, 0x72, 0x72, 0x81, 0x41, 0x54, 0x12, 0x82,
+	0x62, 0x89, 0x5b, 0xf6, 0x38, 0x6e, 0xd1, 0xae, 0xe6, 0xa9, 0x1a, 0xcd, 0x5b, 0xd6, 0x40, 0xc5,
+	0x2d, 0x2b, 0x45, 0xc5, 0x6d, 0x80, 0x22, 0x19, 0xf6, 0x68, 0x42, 0x12, 0x2e, 0x93, 0x5e, 0xf4,
+	0x56, 0x47, 0x99, 0xbb, 0x9c, 0x57, 0xab, 0x10, 0x88, 0xc6, 0x46, 0x13, 0xe3, 0x29, 0xc0, 0x5f,
+	0x2d, 0xf0, 0xc1, 0xd3, 0x76, 0x3b, 0x25, 0x6d, 0xcc, 0x89, 0x39, 0xa7, 0x66, 0x4a, 0xc4, 0x3b,
+	0x2d, 0x0e, 0x52, 0x07, 0xb3, 0x8e, 0xae, 0xc5, 0x38, 0x48, 0x42, 0x0b, 0x91, 0x04, 0xc5, 0x34,
+	0x85, 0x71, 0xea, 0xdc, 0x9a, 0x9e, 0xa6, 0x54, 0x43, 0xa4, 0x60, 0x59, 0x68, 0xbf, 0x15, 0x47,
+	0xdc, 0x6f, 0x75, 0x69, 0x70, 0xe8, 0xcc, 0x5c, 0x7a, 0x4e, 0x0c, 0x54, 0x14, 0x2a, 0x45, 0x4f,
+	0x48, 0x53, 0x79, 0x9f, 0x59, 0x60, 0xe3, 0xca, 0xbc, 0x0f, 0x44, 0xd2, 0xaf, 0x2d, 0x50, 0x99,
+	0x98, 0xb4, 0xcf, 0xfb, 0xbd, 0x2e, 0x61, 0x8e, 0x25, 0x57, 0xf7, 0xde, 0xf4, 0x42, 0x99, 0x0e,
+	0xf6, 0x85, 0xa5, 0xf7, 0xb9, 0x5e, 0xae, 0xad, 0x2b, 0x8e, 0x8d, 0x76, 0x26, 0x9e, 0x69, 0xfb,
+	0x12, 0x93, 0x21, 0x9b, 0x5c, 0xd2, 0xfd, 0xd7, 0x06, 0x4d, 0x15, 0xf9, 0xc6, 0x02, 0x2b, 0x97,
+	0x02, 0xfc, 0x2f, 0x57, 0x67, 0x32, 0x71, 0xef, 0xc5, 0xe9, 0xbb, 0x6a, 0xe1, 0xf4, 0xac, 0x6a,
+	0xbd, 0x3d, 0xab, 0x5a, 0x7f, 0x9f, 0x55, 0xad, 0x9f, 0xce, 0xab, 0x85, 0xb7, 0xe7, 0xd5, 0xc2,
+	0x1f, 0xe7, 0xd5, 0xc2, 0xb7, 0x75, 0x23, 0xb2, 0x18, 0xc4, 0xc3, 0x84, 0xf0, 0x23, 0x9a, 0x1e,
+	0x4a, 0xa1, 0x31, 0x78, 0xd2, 0x18, 0xe6, 0xff, 0x82, 0x65, 0x16, 0xad, 0x79, 0xf9, 0xe7, 0xf6,
+	0xc9, 0xbf, 0x03, 0x00, 0xb7, 0xb8, 0xea, 0x26, 0x21, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -368,6 +485,58 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaximumMedianStamps != that1.MaximumMedianStamps {
 		return false
 	}
+	if !this.MaxInaccuratePerWindow.Equal(that1.MaxInaccuratePerWindow) {
+		return false
+	}
+	if !this.InaccurateSlashFraction.Equal(that1.InaccurateSlashFraction) {
+		return false
+	}
+	if this.HistoricPricingEnabled != that1.HistoricPricingEnabled {
+		return false
+	}
+	if len(this.CrossRates) != len(that1.CrossRates) {
+		return false
+	}
+	for i := range this.CrossRates {
+		if !this.CrossRates[i].Equal(&that1.CrossRates[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CrossRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CrossRate)
+	if !ok {
+		that2, ok := that.(CrossRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Denom.Equal(&that1.Denom) {
+		return false
+	}
+	if len(this.Path) != len(that1.Path) {
+		return false
+	}
+	for i := range this.Path {
+		if this.Path[i] != that1.Path[i] {
+			return false
+		}
+	}
+	if !this.Ratio.Equal(that1.Ratio) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -390,6 +559,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CrossRates) > 0 {
+		for iNdEx := len(m.CrossRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.HistoricPricingEnabled {
+		i--
+		if m.HistoricPricingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.InaccurateSlashFraction.Size()
+		i -= size
+		if _, err := m.InaccurateSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxInaccuratePerWindow.Size()
+		i -= size
+		if _, err := m.MaxInaccuratePerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	if m.MaximumMedianStamps != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.MaximumMedianStamps))
 		i--
@@ -482,6 +697,108 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CrossRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Path[iNdEx])
+			copy(dAtA[i:], m.Path[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Path[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Derived {
+		i--
+		if m.Derived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Denom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -702,6 +1019,56 @@ func (m *Params) Size() (n int) {
 	if m.MaximumMedianStamps != 0 {
 		n += 1 + sovOracle(uint64(m.MaximumMedianStamps))
 	}
+	l = m.MaxInaccuratePerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.InaccurateSlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.HistoricPricingEnabled {
+		n += 2
+	}
+	if len(m.CrossRates) > 0 {
+		for _, e := range m.CrossRates {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CrossRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Denom.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Path) > 0 {
+		for _, s := range m.Path {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Derived {
+		n += 2
+	}
 	return n
 }
 
@@ -1117,6 +1484,413 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInaccuratePerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInaccuratePerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InaccurateSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InaccurateSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricPricingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoricPricingEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossRates = append(m.CrossRates, CrossRate{})
+			if err := m.CrossRates[len(m.CrossRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Derived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
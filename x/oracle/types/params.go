@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -22,6 +23,10 @@ var (
 	KeyMedianStampPeriod        = []byte("MedianStampPeriod")
 	KeyMaximumPriceStamps       = []byte("MaximumPriceStamps")
 	KeyMaximumMedianStamps      = []byte("MedianStampAmount")
+	KeyMaxInaccuratePerWindow   = []byte("MaxInaccuratePerWindow")
+	KeyInaccurateSlashFraction  = []byte("InaccurateSlashFraction")
+	KeyHistoricPricingEnabled   = []byte("HistoricPricingEnabled")
+	KeyCrossRates             = []byte("CrossRates")
 )
 
 // Default parameter values
@@ -53,6 +58,11 @@ var (
 	}
 	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+
+	DefaultMaxInaccuratePerWindow  = sdk.NewDecWithPrec(50, 2) // 50%
+	DefaultInaccurateSlashFraction = sdk.NewDecWithPrec(1, 4)  // 0.01%
+
+	DefaultHistoricPricingEnabled = true
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -72,6 +82,9 @@ func DefaultParams() Params {
 		MedianStampPeriod:        DefaultMedianStampPeriod,
 		MaximumPriceStamps:       DefaultMaximumPriceStamps,
 		MaximumMedianStamps:      DefaultMaximumMedianStamps,
+		MaxInaccuratePerWindow:   DefaultMaxInaccuratePerWindow,
+		InaccurateSlashFraction:  DefaultInaccurateSlashFraction,
+		HistoricPricingEnabled:   DefaultHistoricPricingEnabled,
 	}
 }
 
@@ -144,6 +157,26 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 			&p.MaximumMedianStamps,
 			validateMaximumMedianStamps,
 		),
+		paramstypes.NewParamSetPair(
+			KeyMaxInaccuratePerWindow,
+			&p.MaxInaccuratePerWindow,
+			validateMaxInaccuratePerWindow,
+		),
+		paramstypes.NewParamSetPair(
+			KeyInaccurateSlashFraction,
+			&p.InaccurateSlashFraction,
+			validateInaccurateSlashFraction,
+		),
+		paramstypes.NewParamSetPair(
+			KeyHistoricPricingEnabled,
+			&p.HistoricPricingEnabled,
+			validateHistoricPricingEnabled,
+		),
+		paramstypes.NewParamSetPair(
+			KeyCrossRates,
+			&p.CrossRates,
+			validateCrossRates,
+		),
 	}
 }
 
@@ -182,6 +215,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.MaxInaccuratePerWindow.GT(sdk.OneDec()) || p.MaxInaccuratePerWindow.IsNegative() {
+		return fmt.Errorf("oracle parameter MaxInaccuratePerWindow must be between [0, 1]")
+	}
+
+	if p.InaccurateSlashFraction.GT(sdk.OneDec()) || p.InaccurateSlashFraction.IsNegative() {
+		return fmt.Errorf("oracle parameter InaccurateSlashFraction must be between [0, 1]")
+	}
+
 	if p.HistoricStampPeriod > p.MedianStampPeriod {
 		return fmt.Errorf("oracle parameter MedianStampPeriod must be greater than or equal with HistoricStampPeriod")
 	}
@@ -199,6 +240,17 @@ func (p Params) Validate() error {
 		}
 	}
 
+	if err := validateCrossRates(p.CrossRates); err != nil {
+		return fmt.Errorf("oracle parameter CrossRates is invalid: %w", err)
+	}
+	for _, crossRate := range p.CrossRates {
+		for _, denom := range crossRate.Path {
+			if !p.AcceptList.Contains(denom) {
+				return fmt.Errorf("oracle parameter CrossRates path denom %s must be in AcceptList", denom)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -378,3 +430,65 @@ func validateMaximumMedianStamps(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxInaccuratePerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max inaccurate per window must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max inaccurate per window is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInaccurateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("inaccurate slash fraction must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inaccurate slash fraction is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateHistoricPricingEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCrossRates(i interface{}) error {
+	v, ok := i.([]CrossRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, crossRate := range v {
+		if err := crossRate.Validate(); err != nil {
+			return err
+		}
+		for _, other := range v[:i] {
+			if strings.EqualFold(crossRate.Denom.SymbolDenom, other.Denom.SymbolDenom) {
+				return fmt.Errorf("duplicate cross rate of %s", crossRate.Denom.SymbolDenom)
+			}
+		}
+	}
+
+	return nil
+}
@@ -242,7 +242,93 @@ func TestParamsEqual(t *testing.T) {
 	err = p11.Validate()
 	require.Error(t, err)
 
+	// cross rate path denom not in accept list
+	p12 := DefaultParams()
+	p12.AcceptList = DenomList{{BaseDenom: AtomDenom, SymbolDenom: AtomSymbol, Exponent: AtomExponent}}
+	p12.CrossRates = []CrossRate{{
+		Denom: Denom{BaseDenom: "ibc/WATOM", SymbolDenom: "WATOM", Exponent: 6},
+		Path:  []string{"OSMO"},
+		Ratio: sdk.OneDec(),
+	}}
+	err = p12.Validate()
+	require.ErrorContains(t, err, "path denom OSMO must be in AcceptList")
+	p12.CrossRates[0].Path = []string{AtomSymbol}
+	err = p12.Validate()
+	require.NoError(t, err)
+
 	p13 := DefaultParams()
 	require.NotNil(t, p13.ParamSetPairs())
 	require.NotNil(t, p13.String())
 }
+
+func TestValidateMaxInaccuratePerWindow(t *testing.T) {
+	err := validateMaxInaccuratePerWindow("invalidSdkType")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateMaxInaccuratePerWindow(sdk.MustNewDecFromStr("-0.31"))
+	require.ErrorContains(t, err, "max inaccurate per window must be positive: -0.310000000000000000")
+
+	err = validateMaxInaccuratePerWindow(sdk.MustNewDecFromStr("40.0"))
+	require.ErrorContains(t, err, "max inaccurate per window is too large: 40.000000000000000000")
+
+	err = validateMaxInaccuratePerWindow(sdk.MustNewDecFromStr("0.5"))
+	require.Nil(t, err)
+}
+
+func TestValidateInaccurateSlashFraction(t *testing.T) {
+	err := validateInaccurateSlashFraction("invalidSdkType")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateInaccurateSlashFraction(sdk.MustNewDecFromStr("-0.31"))
+	require.ErrorContains(t, err, "inaccurate slash fraction must be positive: -0.310000000000000000")
+
+	err = validateInaccurateSlashFraction(sdk.MustNewDecFromStr("40.0"))
+	require.ErrorContains(t, err, "inaccurate slash fraction is too large: 40.000000000000000000")
+
+	err = validateInaccurateSlashFraction(sdk.OneDec())
+	require.Nil(t, err)
+}
+
+func TestValidateHistoricPricingEnabled(t *testing.T) {
+	err := validateHistoricPricingEnabled("invalidType")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	err = validateHistoricPricingEnabled(true)
+	require.Nil(t, err)
+}
+
+func TestValidateCrossRates(t *testing.T) {
+	err := validateCrossRates("invalidType")
+	require.ErrorContains(t, err, "invalid parameter type: string")
+
+	wAtom := CrossRate{
+		Denom: Denom{BaseDenom: "ibc/WATOM", SymbolDenom: "WATOM", Exponent: 6},
+		Path:  []string{AtomSymbol},
+		Ratio: sdk.MustNewDecFromStr("1.05"),
+	}
+	err = validateCrossRates([]CrossRate{wAtom})
+	require.Nil(t, err)
+
+	err = validateCrossRates([]CrossRate{wAtom, wAtom})
+	require.ErrorContains(t, err, "duplicate cross rate of WATOM")
+
+	invalid := wAtom
+	invalid.Denom.BaseDenom = ""
+	err = validateCrossRates([]CrossRate{invalid})
+	require.ErrorContains(t, err, "cross rate denom must have BaseDenom")
+
+	invalid = wAtom
+	invalid.Path = nil
+	err = validateCrossRates([]CrossRate{invalid})
+	require.ErrorContains(t, err, "cross rate of WATOM must have a path")
+
+	invalid = wAtom
+	invalid.Path = []string{"wAtom"}
+	err = validateCrossRates([]CrossRate{invalid})
+	require.ErrorContains(t, err, "cross rate path of WATOM must not contain itself")
+
+	invalid = wAtom
+	invalid.Ratio = sdk.ZeroDec()
+	err = validateCrossRates([]CrossRate{invalid})
+	require.ErrorContains(t, err, "cross rate ratio of WATOM must be positive")
+}
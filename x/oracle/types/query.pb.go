@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,6 +113,87 @@ func (m *QueryExchangeRatesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExchangeRatesResponse proto.InternalMessageInfo
 
+// QueryExchangeRateSources is the request type for the
+// Query/ExchangeRateSources RPC method.
+type QueryExchangeRateSources struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryExchangeRateSources) Reset()         { *m = QueryExchangeRateSources{} }
+func (m *QueryExchangeRateSources) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateSources) ProtoMessage()    {}
+func (*QueryExchangeRateSources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{2}
+}
+func (m *QueryExchangeRateSources) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateSources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateSources.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateSources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateSources.Merge(m, src)
+}
+func (m *QueryExchangeRateSources) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateSources) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateSources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateSources proto.InternalMessageInfo
+
+// QueryExchangeRateSourcesResponse is response type for the
+// Query/ExchangeRateSources RPC method.
+type QueryExchangeRateSourcesResponse struct {
+	// exchange_rate_sources defines the exchange rates of the denoms and
+	// whether they were voted or derived.
+	ExchangeRateSources []ExchangeRateSource `protobuf:"bytes,1,rep,name=exchange_rate_sources,json=exchangeRateSources,proto3" json:"exchange_rate_sources"`
+}
+
+func (m *QueryExchangeRateSourcesResponse) Reset()         { *m = QueryExchangeRateSourcesResponse{} }
+func (m *QueryExchangeRateSourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateSourcesResponse) ProtoMessage()    {}
+func (*QueryExchangeRateSourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{3}
+}
+func (m *QueryExchangeRateSourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateSourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateSourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateSourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateSourcesResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateSourcesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateSourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateSourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateSourcesResponse proto.InternalMessageInfo
+
 // QueryActiveExchangeRates is the request type for the
 // Query/ActiveExchangeRates RPC method.
 type QueryActiveExchangeRates struct {
@@ -121,7 +203,7 @@ func (m *QueryActiveExchangeRates) Reset()         { *m = QueryActiveExchangeRat
 func (m *QueryActiveExchangeRates) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveExchangeRates) ProtoMessage()    {}
 func (*QueryActiveExchangeRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{2}
+	return fileDescriptor_710e319bc1815d33, []int{4}
 }
 func (m *QueryActiveExchangeRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +244,7 @@ func (m *QueryActiveExchangeRatesResponse) Reset()         { *m = QueryActiveExc
 func (m *QueryActiveExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveExchangeRatesResponse) ProtoMessage()    {}
 func (*QueryActiveExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{3}
+	return fileDescriptor_710e319bc1815d33, []int{5}
 }
 func (m *QueryActiveExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +284,7 @@ func (m *QueryFeederDelegation) Reset()         { *m = QueryFeederDelegation{} }
 func (m *QueryFeederDelegation) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegation) ProtoMessage()    {}
 func (*QueryFeederDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{4}
+	return fileDescriptor_710e319bc1815d33, []int{6}
 }
 func (m *QueryFeederDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +324,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{5}
+	return fileDescriptor_710e319bc1815d33, []int{7}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +364,7 @@ func (m *QueryMissCounter) Reset()         { *m = QueryMissCounter{} }
 func (m *QueryMissCounter) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounter) ProtoMessage()    {}
 func (*QueryMissCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{6}
+	return fileDescriptor_710e319bc1815d33, []int{8}
 }
 func (m *QueryMissCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +404,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{7}
+	return fileDescriptor_710e319bc1815d33, []int{9}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,6 +433,88 @@ func (m *QueryMissCounterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMissCounterResponse proto.InternalMessageInfo
 
+// QueryInaccurateVoteCounter is the request type for the
+// Query/InaccurateVoteCounter RPC method.
+type QueryInaccurateVoteCounter struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryInaccurateVoteCounter) Reset()         { *m = QueryInaccurateVoteCounter{} }
+func (m *QueryInaccurateVoteCounter) String() string { return proto.CompactTextString(m) }
+func (*QueryInaccurateVoteCounter) ProtoMessage()    {}
+func (*QueryInaccurateVoteCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{10}
+}
+func (m *QueryInaccurateVoteCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInaccurateVoteCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInaccurateVoteCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInaccurateVoteCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInaccurateVoteCounter.Merge(m, src)
+}
+func (m *QueryInaccurateVoteCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInaccurateVoteCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInaccurateVoteCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInaccurateVoteCounter proto.InternalMessageInfo
+
+// QueryInaccurateVoteCounterResponse is response type for the
+// Query/InaccurateVoteCounter RPC method.
+type QueryInaccurateVoteCounterResponse struct {
+	// inaccurate_vote_counter defines the number of vote periods in the
+	// current slash window during which a validator voted outside of the
+	// reward band
+	InaccurateVoteCounter uint64 `protobuf:"varint,1,opt,name=inaccurate_vote_counter,json=inaccurateVoteCounter,proto3" json:"inaccurate_vote_counter,omitempty"`
+}
+
+func (m *QueryInaccurateVoteCounterResponse) Reset()         { *m = QueryInaccurateVoteCounterResponse{} }
+func (m *QueryInaccurateVoteCounterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInaccurateVoteCounterResponse) ProtoMessage()    {}
+func (*QueryInaccurateVoteCounterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{11}
+}
+func (m *QueryInaccurateVoteCounterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInaccurateVoteCounterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInaccurateVoteCounterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInaccurateVoteCounterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInaccurateVoteCounterResponse.Merge(m, src)
+}
+func (m *QueryInaccurateVoteCounterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInaccurateVoteCounterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInaccurateVoteCounterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInaccurateVoteCounterResponse proto.InternalMessageInfo
+
 // QuerySlashWindow is the request type for the
 // Query/SlashWindow RPC method.
 type QuerySlashWindow struct {
@@ -360,7 +524,7 @@ func (m *QuerySlashWindow) Reset()         { *m = QuerySlashWindow{} }
 func (m *QuerySlashWindow) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindow) ProtoMessage()    {}
 func (*QuerySlashWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{8}
+	return fileDescriptor_710e319bc1815d33, []int{12}
 }
 func (m *QuerySlashWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +565,7 @@ func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowRespon
 func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowResponse) ProtoMessage()    {}
 func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{9}
+	return fileDescriptor_710e319bc1815d33, []int{13}
 }
 func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +605,7 @@ func (m *QueryAggregatePrevote) Reset()         { *m = QueryAggregatePrevote{} }
 func (m *QueryAggregatePrevote) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevote) ProtoMessage()    {}
 func (*QueryAggregatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{10}
+	return fileDescriptor_710e319bc1815d33, []int{14}
 }
 func (m *QueryAggregatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,7 +646,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{11}
+	return fileDescriptor_710e319bc1815d33, []int{15}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +684,7 @@ func (m *QueryAggregatePrevotes) Reset()         { *m = QueryAggregatePrevotes{}
 func (m *QueryAggregatePrevotes) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotes) ProtoMessage()    {}
 func (*QueryAggregatePrevotes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{12}
+	return fileDescriptor_710e319bc1815d33, []int{16}
 }
 func (m *QueryAggregatePrevotes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +725,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{13}
+	return fileDescriptor_710e319bc1815d33, []int{17}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +765,7 @@ func (m *QueryAggregateVote) Reset()         { *m = QueryAggregateVote{} }
 func (m *QueryAggregateVote) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVote) ProtoMessage()    {}
 func (*QueryAggregateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{14}
+	return fileDescriptor_710e319bc1815d33, []int{18}
 }
 func (m *QueryAggregateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +806,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{15}
+	return fileDescriptor_710e319bc1815d33, []int{19}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +844,7 @@ func (m *QueryAggregateVotes) Reset()         { *m = QueryAggregateVotes{} }
 func (m *QueryAggregateVotes) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotes) ProtoMessage()    {}
 func (*QueryAggregateVotes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{16}
+	return fileDescriptor_710e319bc1815d33, []int{20}
 }
 func (m *QueryAggregateVotes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +885,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{17}
+	return fileDescriptor_710e319bc1815d33, []int{21}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +922,7 @@ func (m *QueryParams) Reset()         { *m = QueryParams{} }
 func (m *QueryParams) String() string { return proto.CompactTextString(m) }
 func (*QueryParams) ProtoMessage()    {}
 func (*QueryParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{18}
+	return fileDescriptor_710e319bc1815d33, []int{22}
 }
 func (m *QueryParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +961,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{19}
+	return fileDescriptor_710e319bc1815d33, []int{23}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1000,7 @@ func (m *QueryMedians) Reset()         { *m = QueryMedians{} }
 func (m *QueryMedians) String() string { return proto.CompactTextString(m) }
 func (*QueryMedians) ProtoMessage()    {}
 func (*QueryMedians) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{20}
+	return fileDescriptor_710e319bc1815d33, []int{24}
 }
 func (m *QueryMedians) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +1040,7 @@ func (m *QueryMediansResponse) Reset()         { *m = QueryMediansResponse{} }
 func (m *QueryMediansResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMediansResponse) ProtoMessage()    {}
 func (*QueryMediansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{21}
+	return fileDescriptor_710e319bc1815d33, []int{25}
 }
 func (m *QueryMediansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +1079,7 @@ func (m *QueryMedianDeviations) Reset()         { *m = QueryMedianDeviations{} }
 func (m *QueryMedianDeviations) String() string { return proto.CompactTextString(m) }
 func (*QueryMedianDeviations) ProtoMessage()    {}
 func (*QueryMedianDeviations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{22}
+	return fileDescriptor_710e319bc1815d33, []int{26}
 }
 func (m *QueryMedianDeviations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1119,7 @@ func (m *QueryMedianDeviationsResponse) Reset()         { *m = QueryMedianDeviat
 func (m *QueryMedianDeviationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMedianDeviationsResponse) ProtoMessage()    {}
 func (*QueryMedianDeviationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_710e319bc1815d33, []int{23}
+	return fileDescriptor_710e319bc1815d33, []int{27}
 }
 func (m *QueryMedianDeviationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -984,187 +1148,582 @@ func (m *QueryMedianDeviationsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMedianDeviationsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryExchangeRates)(nil), "umee.oracle.v1.QueryExchangeRates")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "umee.oracle.v1.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActiveExchangeRates)(nil), "umee.oracle.v1.QueryActiveExchangeRates")
-	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "umee.oracle.v1.QueryActiveExchangeRatesResponse")
-	proto.RegisterType((*QueryFeederDelegation)(nil), "umee.oracle.v1.QueryFeederDelegation")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "umee.oracle.v1.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounter)(nil), "umee.oracle.v1.QueryMissCounter")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "umee.oracle.v1.QueryMissCounterResponse")
-	proto.RegisterType((*QuerySlashWindow)(nil), "umee.oracle.v1.QuerySlashWindow")
-	proto.RegisterType((*QuerySlashWindowResponse)(nil), "umee.oracle.v1.QuerySlashWindowResponse")
-	proto.RegisterType((*QueryAggregatePrevote)(nil), "umee.oracle.v1.QueryAggregatePrevote")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "umee.oracle.v1.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotes)(nil), "umee.oracle.v1.QueryAggregatePrevotes")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "umee.oracle.v1.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVote)(nil), "umee.oracle.v1.QueryAggregateVote")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "umee.oracle.v1.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotes)(nil), "umee.oracle.v1.QueryAggregateVotes")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "umee.oracle.v1.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParams)(nil), "umee.oracle.v1.QueryParams")
-	proto.RegisterType((*QueryParamsResponse)(nil), "umee.oracle.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryMedians)(nil), "umee.oracle.v1.QueryMedians")
-	proto.RegisterType((*QueryMediansResponse)(nil), "umee.oracle.v1.QueryMediansResponse")
-	proto.RegisterType((*QueryMedianDeviations)(nil), "umee.oracle.v1.QueryMedianDeviations")
-	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "umee.oracle.v1.QueryMedianDeviationsResponse")
+// QueryMedianOfHistoricMedians is the request type for the
+// Query/MedianOfHistoricMedians RPC method.
+type QueryMedianOfHistoricMedians struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// num_stamps defines the number of most recent medians to use.
+	NumStamps uint64 `protobuf:"varint,2,opt,name=num_stamps,json=numStamps,proto3" json:"num_stamps,omitempty"`
 }
 
-func init() { proto.RegisterFile("umee/oracle/v1/query.proto", fileDescriptor_710e319bc1815d33) }
-
-var fileDescriptor_710e319bc1815d33 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0xd0, 0x1f, 0xf4, 0xb9, 0x76, 0xdd, 0x69, 0x1b, 0x45, 0x9b, 0xb0, 0x09, 0xd3,
-	0x14, 0x42, 0x9a, 0xec, 0x36, 0x4e, 0x10, 0x28, 0xa2, 0x82, 0xfc, 0x42, 0x48, 0x80, 0x14, 0x8c,
-	0x14, 0x10, 0x17, 0x6b, 0xe2, 0x1d, 0x9c, 0x25, 0xf1, 0x8e, 0xd9, 0xd9, 0x38, 0x89, 0xaa, 0x08,
-	0x44, 0x2f, 0x1c, 0x91, 0x2a, 0xe5, 0x58, 0x55, 0x80, 0x40, 0xe2, 0xc2, 0xbf, 0x91, 0x63, 0x25,
-	0x2e, 0x9c, 0xf8, 0x91, 0x70, 0xe0, 0xcf, 0x40, 0x3b, 0x33, 0x9e, 0xec, 0x2f, 0xc7, 0x4e, 0xa5,
-	0x9e, 0x92, 0x7d, 0xef, 0xcd, 0xfb, 0x7e, 0xe6, 0xed, 0xce, 0x7c, 0x65, 0x30, 0x77, 0x5a, 0x94,
-	0x3a, 0x2c, 0x20, 0x8d, 0x6d, 0xea, 0x74, 0x66, 0x9d, 0xaf, 0x76, 0x68, 0xb0, 0x6f, 0xb7, 0x03,
-	0x16, 0x32, 0x54, 0x8e, 0x72, 0xb6, 0xcc, 0xd9, 0x9d, 0x59, 0xf3, 0x66, 0x93, 0x35, 0x99, 0x48,
-	0x39, 0xd1, 0x7f, 0xb2, 0xca, 0x1c, 0x6d, 0x32, 0xd6, 0xdc, 0xa6, 0x0e, 0x69, 0x7b, 0x0e, 0xf1,
-	0x7d, 0x16, 0x92, 0xd0, 0x63, 0x3e, 0x57, 0xd9, 0x91, 0x54, 0x7f, 0xd5, 0x4d, 0x26, 0xad, 0x06,
-	0xe3, 0x2d, 0xc6, 0x9d, 0x0d, 0xc2, 0xa3, 0xe4, 0x06, 0x0d, 0xc9, 0xac, 0xd3, 0x60, 0x9e, 0x2f,
-	0xf3, 0x78, 0x1e, 0xd0, 0xc7, 0x11, 0xcf, 0xea, 0x5e, 0x63, 0x93, 0xf8, 0x4d, 0x5a, 0x23, 0x21,
-	0xe5, 0xe8, 0x26, 0x5c, 0x74, 0xa9, 0xcf, 0x5a, 0xc3, 0xc6, 0xb8, 0x31, 0x79, 0xa5, 0x26, 0x1f,
-	0x16, 0x5e, 0xfa, 0xee, 0xc9, 0x58, 0xe1, 0xbf, 0x27, 0x63, 0x05, 0x7c, 0x68, 0x80, 0x99, 0x5d,
-	0x56, 0xa3, 0xbc, 0xcd, 0x7c, 0x4e, 0xd1, 0x1e, 0x94, 0xa9, 0x4a, 0xd4, 0x83, 0x28, 0x33, 0x6c,
-	0x8c, 0xbf, 0x38, 0x59, 0xac, 0x8e, 0xda, 0x92, 0xc6, 0x8e, 0x68, 0x6c, 0x45, 0x63, 0xaf, 0xd0,
-	0xc6, 0x32, 0xf3, 0xfc, 0xa5, 0xb9, 0xa3, 0x3f, 0xc7, 0x0a, 0xbf, 0xfe, 0x35, 0x76, 0xb7, 0xe9,
-	0x85, 0x9b, 0x3b, 0x1b, 0x76, 0x83, 0xb5, 0x1c, 0x45, 0x2f, 0xff, 0xcc, 0x70, 0x77, 0xcb, 0x09,
-	0xf7, 0xdb, 0x94, 0x77, 0xd7, 0xf0, 0x5a, 0x89, 0xc6, 0x09, 0xb0, 0x09, 0xc3, 0x82, 0x6b, 0xb1,
-	0x11, 0x7a, 0x1d, 0x9a, 0xa0, 0xc3, 0xab, 0x30, 0xde, 0x2b, 0xa7, 0xc9, 0x5f, 0x81, 0xab, 0x44,
-	0xa4, 0x63, 0xdc, 0x57, 0x6a, 0x45, 0x19, 0x93, 0x6d, 0xde, 0x87, 0x5b, 0xa2, 0xcd, 0x7b, 0x94,
-	0xba, 0x34, 0x58, 0xa1, 0xdb, 0xb4, 0x29, 0x5e, 0x07, 0xba, 0x03, 0xe5, 0x0e, 0xd9, 0xf6, 0x5c,
-	0x12, 0xb2, 0xa0, 0x4e, 0x5c, 0x37, 0x50, 0xd3, 0x2b, 0xe9, 0xe8, 0xa2, 0xeb, 0x06, 0xb1, 0x29,
-	0xbe, 0x0b, 0x2f, 0xe7, 0x76, 0xd2, 0x34, 0x63, 0x50, 0xfc, 0x42, 0xe4, 0xe2, 0xed, 0x40, 0x86,
-	0xa2, 0x5e, 0x78, 0x19, 0x2a, 0xa2, 0xc3, 0x47, 0x1e, 0xe7, 0xcb, 0x6c, 0xc7, 0x0f, 0x69, 0x70,
-	0x7e, 0x8c, 0xfb, 0x6a, 0x66, 0xb1, 0x26, 0xf1, 0x79, 0xb4, 0x3c, 0xce, 0xeb, 0x0d, 0x19, 0x17,
-	0xad, 0x2e, 0xd4, 0x8a, 0xad, 0xd3, 0x52, 0x8c, 0x14, 0xc3, 0x27, 0xdb, 0x84, 0x6f, 0x7e, 0xea,
-	0xf9, 0x2e, 0xdb, 0xc5, 0xcb, 0xaa, 0x65, 0x2c, 0xa6, 0x5b, 0xbe, 0x06, 0xd7, 0x76, 0x45, 0xa4,
-	0xde, 0x0e, 0x58, 0x33, 0xa0, 0x9c, 0xab, 0xae, 0x65, 0x19, 0x5e, 0x53, 0x51, 0x3d, 0xe8, 0xc5,
-	0x66, 0x33, 0x88, 0x26, 0x43, 0xd7, 0x02, 0xda, 0x61, 0x21, 0x3d, 0xff, 0x0e, 0xbf, 0x31, 0xd4,
-	0xa4, 0xd3, 0xad, 0x34, 0x54, 0x1d, 0xae, 0x93, 0x6e, 0xae, 0xde, 0x96, 0x49, 0xd1, 0xb5, 0x58,
-	0x9d, 0xb6, 0x93, 0x67, 0xd4, 0xd6, 0x4d, 0xe2, 0x9f, 0x90, 0x6a, 0xb8, 0x74, 0x21, 0xfa, 0x88,
-	0x6b, 0x15, 0x92, 0x12, 0xc2, 0xc3, 0x30, 0x94, 0x4b, 0xc0, 0xf1, 0x43, 0x03, 0xac, 0xfc, 0x94,
-	0xa6, 0x23, 0x80, 0x32, 0x74, 0xdd, 0x33, 0xf5, 0x2c, 0x78, 0xd7, 0x49, 0x86, 0x62, 0x55, 0xdd,
-	0x03, 0x7a, 0xf5, 0xfa, 0x33, 0x4d, 0x3a, 0x54, 0xf7, 0x42, 0xa2, 0x8d, 0xde, 0xc7, 0x3a, 0x94,
-	0x4f, 0xf7, 0x11, 0x1b, 0xf1, 0xeb, 0x03, 0xed, 0x61, 0xfd, 0x74, 0x03, 0x25, 0x12, 0xef, 0x8f,
-	0x6f, 0xc1, 0x8d, 0xac, 0x2a, 0xc7, 0xbb, 0x30, 0x92, 0x13, 0xd6, 0x34, 0x9f, 0xc1, 0xb5, 0x24,
-	0x4d, 0x77, 0xa4, 0xe7, 0xc6, 0x29, 0x93, 0xa4, 0x70, 0x09, 0x8a, 0x42, 0x78, 0x8d, 0x04, 0xa4,
-	0xc5, 0xf1, 0x07, 0x0a, 0x4f, 0x3e, 0x6a, 0xfd, 0x79, 0xb8, 0xd4, 0x16, 0x11, 0x35, 0x85, 0xa1,
-	0xb4, 0xac, 0xac, 0x57, 0x1a, 0xaa, 0x16, 0xdb, 0x70, 0x55, 0x9e, 0x56, 0xea, 0x7a, 0xc4, 0xef,
-	0x7f, 0x55, 0x3f, 0x34, 0xe0, 0x66, 0x7c, 0x81, 0x96, 0xdf, 0x82, 0xcb, 0x2d, 0x19, 0x7a, 0x7e,
-	0xb7, 0x73, 0x57, 0x01, 0xbf, 0xa9, 0xce, 0xb2, 0x84, 0x58, 0xa1, 0x1d, 0x4f, 0x5a, 0x58, 0x5f,
-	0xfc, 0xc7, 0xdd, 0xa3, 0x9b, 0x5e, 0xa9, 0xf7, 0x71, 0x00, 0x95, 0x56, 0x2a, 0xf7, 0xfc, 0x36,
-	0x94, 0x91, 0xaa, 0xfe, 0x5c, 0x86, 0x8b, 0x02, 0x10, 0x1d, 0x1a, 0x50, 0x4a, 0xda, 0x28, 0x4e,
-	0xbf, 0xd1, 0xac, 0x67, 0x9a, 0x53, 0xfd, 0x6b, 0xba, 0x5b, 0xc5, 0x6f, 0x7c, 0xfb, 0xfb, 0xbf,
-	0x8f, 0x5e, 0x70, 0xd0, 0x8c, 0x93, 0xb2, 0x7c, 0x31, 0x35, 0xee, 0x24, 0x4d, 0xd7, 0x79, 0x20,
-	0xc2, 0x07, 0xe8, 0x17, 0x03, 0x6e, 0xe4, 0x98, 0x1e, 0x9a, 0xcc, 0x95, 0xce, 0xa9, 0x34, 0xef,
-	0x0d, 0x5a, 0xa9, 0x51, 0xe7, 0x05, 0xaa, 0x8d, 0xa6, 0x7b, 0xa0, 0x2a, 0x97, 0x4d, 0x12, 0xa3,
-	0x9f, 0x0c, 0xa8, 0x64, 0x7d, 0x35, 0x57, 0x3c, 0x5d, 0x66, 0xce, 0x0c, 0x54, 0xa6, 0x01, 0x17,
-	0x04, 0xe0, 0x3c, 0xaa, 0xa6, 0x01, 0xf5, 0xd5, 0xc6, 0x9d, 0x07, 0xc9, 0xcb, 0xef, 0xc0, 0x91,
-	0xd6, 0x8b, 0x1e, 0x19, 0x50, 0x8c, 0x5b, 0xee, 0x78, 0xae, 0x74, 0xac, 0xc2, 0x9c, 0xec, 0x57,
-	0xa1, 0xb9, 0xde, 0x12, 0x5c, 0x55, 0x74, 0xef, 0x3c, 0x5c, 0x91, 0x1f, 0xa3, 0xaf, 0xa1, 0x18,
-	0xf3, 0xdb, 0x1e, 0x50, 0xb1, 0x8a, 0x1e, 0x50, 0x39, 0x9e, 0x8d, 0x27, 0x04, 0x94, 0x85, 0x46,
-	0xd3, 0x50, 0x3c, 0x2a, 0xae, 0x4b, 0xe3, 0x46, 0xbf, 0x19, 0x50, 0xc9, 0x9a, 0x75, 0xfe, 0xa7,
-	0x93, 0x2a, 0xeb, 0xf1, 0xf6, 0x7a, 0xf9, 0x35, 0x5e, 0x15, 0x40, 0xef, 0xa0, 0xfb, 0xe7, 0x99,
-	0x52, 0xc6, 0x43, 0xd1, 0x0f, 0x06, 0x5c, 0xcf, 0xd8, 0x2e, 0x7a, 0x75, 0x20, 0x16, 0x6e, 0xda,
-	0x83, 0xd5, 0xf5, 0x3f, 0xbe, 0x31, 0xe8, 0xac, 0xcf, 0xa3, 0x1f, 0x0d, 0x28, 0x25, 0x6d, 0x19,
-	0x9f, 0x2d, 0x1c, 0xd5, 0xf4, 0xb8, 0x57, 0x72, 0x7d, 0x19, 0x2f, 0x09, 0xb0, 0xb7, 0xd1, 0x42,
-	0x0e, 0x98, 0xeb, 0xf5, 0x9d, 0xa6, 0x18, 0xe5, 0xa1, 0x01, 0xe5, 0xa4, 0xd1, 0xa2, 0xdb, 0xfd,
-	0x11, 0xb8, 0x79, 0x77, 0x80, 0x22, 0x0d, 0x5a, 0x15, 0xa0, 0xd3, 0x68, 0x6a, 0xa0, 0x09, 0xca,
-	0xf1, 0x7d, 0x09, 0x97, 0xa4, 0x91, 0xa2, 0x91, 0x5c, 0x29, 0x99, 0x34, 0x6f, 0x9f, 0x91, 0xd4,
-	0xfa, 0x96, 0xd0, 0x1f, 0x46, 0x43, 0x69, 0x7d, 0x69, 0xce, 0x68, 0x1f, 0x2e, 0x77, 0x7d, 0x79,
-	0x34, 0xff, 0xc4, 0xcb, 0xac, 0x39, 0x71, 0x56, 0x56, 0xcb, 0x4d, 0x09, 0xb9, 0x09, 0x84, 0xa5,
-	0xdc, 0xa6, 0xc7, 0xc3, 0xcc, 0x45, 0xaa, 0x1c, 0x16, 0x3d, 0x36, 0xa0, 0x92, 0x71, 0xd7, 0x3b,
-	0x67, 0xc8, 0x9c, 0x96, 0xf5, 0x38, 0x7c, 0xbd, 0x1c, 0x37, 0x7d, 0xb7, 0x9f, 0x81, 0x55, 0x77,
-	0xf5, 0xea, 0xa5, 0x0f, 0x8f, 0xfe, 0xb1, 0x0a, 0x47, 0xc7, 0x96, 0xf1, 0xf4, 0xd8, 0x32, 0xfe,
-	0x3e, 0xb6, 0x8c, 0xef, 0x4f, 0xac, 0xc2, 0xd3, 0x13, 0xab, 0xf0, 0xc7, 0x89, 0x55, 0xf8, 0xdc,
-	0x8e, 0xb9, 0x70, 0xd4, 0x75, 0xc6, 0xa7, 0xe1, 0x2e, 0x0b, 0xb6, 0xa4, 0x44, 0x67, 0xce, 0xd9,
-	0xeb, 0x4e, 0x5b, 0x38, 0xf2, 0xc6, 0x25, 0xf1, 0xf3, 0x75, 0xee, 0xff, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xd4, 0x9f, 0x06, 0xe5, 0x5d, 0x0f, 0x00, 0x00,
+func (m *QueryMedianOfHistoricMedians) Reset()         { *m = QueryMedianOfHistoricMedians{} }
+func (m *QueryMedianOfHistoricMedians) String() string { return proto.CompactTextString(m) }
+func (*QueryMedianOfHistoricMedians) ProtoMessage()    {}
+func (*QueryMedianOfHistoricMedians) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{28}
+}
+func (m *QueryMedianOfHistoricMedians) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMedianOfHistoricMedians) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMedianOfHistoricMedians.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMedianOfHistoricMedians) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMedianOfHistoricMedians.Merge(m, src)
+}
+func (m *QueryMedianOfHistoricMedians) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMedianOfHistoricMedians) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMedianOfHistoricMedians.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryMedianOfHistoricMedians proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRates returns exchange rates of all denoms,
-	// or, if specified, returns a single denom
-	ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// ActiveExchangeRates returns all active denoms
-	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// SlashWindow returns slash window information
-	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Medians returns medians of all denoms,
-	// or, if specified, returns a single median
-	Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error)
-	// MedianDeviations returns median deviations of all denoms,
-	// or, if specified, returns a single median deviation
-	MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error)
+// QueryMedianOfHistoricMediansResponse is response type for the
+// Query/MedianOfHistoricMedians RPC method.
+type QueryMedianOfHistoricMediansResponse struct {
+	// median defines the median of the requested historic medians.
+	Median github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryMedianOfHistoricMediansResponse) Reset()         { *m = QueryMedianOfHistoricMediansResponse{} }
+func (m *QueryMedianOfHistoricMediansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMedianOfHistoricMediansResponse) ProtoMessage()    {}
+func (*QueryMedianOfHistoricMediansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{29}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryMedianOfHistoricMediansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMedianOfHistoricMediansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMedianOfHistoricMediansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
-	out := new(QueryActiveExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ActiveExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryMedianOfHistoricMediansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMedianOfHistoricMediansResponse.Merge(m, src)
 }
-
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryMedianOfHistoricMediansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMedianOfHistoricMediansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMedianOfHistoricMediansResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+var xxx_messageInfo_QueryMedianOfHistoricMediansResponse proto.InternalMessageInfo
+
+// QueryAverageOfHistoricMedians is the request type for the
+// Query/AverageOfHistoricMedians RPC method.
+type QueryAverageOfHistoricMedians struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// num_stamps defines the number of most recent medians to use.
+	NumStamps uint64 `protobuf:"varint,2,opt,name=num_stamps,json=numStamps,proto3" json:"num_stamps,omitempty"`
+}
+
+func (m *QueryAverageOfHistoricMedians) Reset()         { *m = QueryAverageOfHistoricMedians{} }
+func (m *QueryAverageOfHistoricMedians) String() string { return proto.CompactTextString(m) }
+func (*QueryAverageOfHistoricMedians) ProtoMessage()    {}
+func (*QueryAverageOfHistoricMedians) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{30}
+}
+func (m *QueryAverageOfHistoricMedians) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAverageOfHistoricMedians) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAverageOfHistoricMedians.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAverageOfHistoricMedians) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAverageOfHistoricMedians.Merge(m, src)
+}
+func (m *QueryAverageOfHistoricMedians) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAverageOfHistoricMedians) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAverageOfHistoricMedians.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAverageOfHistoricMedians proto.InternalMessageInfo
+
+// QueryAverageOfHistoricMediansResponse is response type for the
+// Query/AverageOfHistoricMedians RPC method.
+type QueryAverageOfHistoricMediansResponse struct {
+	// average defines the average of the requested historic medians.
+	Average github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=average,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average"`
+}
+
+func (m *QueryAverageOfHistoricMediansResponse) Reset()         { *m = QueryAverageOfHistoricMediansResponse{} }
+func (m *QueryAverageOfHistoricMediansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAverageOfHistoricMediansResponse) ProtoMessage()    {}
+func (*QueryAverageOfHistoricMediansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{31}
+}
+func (m *QueryAverageOfHistoricMediansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAverageOfHistoricMediansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAverageOfHistoricMediansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAverageOfHistoricMediansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAverageOfHistoricMediansResponse.Merge(m, src)
+}
+func (m *QueryAverageOfHistoricMediansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAverageOfHistoricMediansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAverageOfHistoricMediansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAverageOfHistoricMediansResponse proto.InternalMessageInfo
+
+// QueryTWAP is the request type for the Query/TWAP RPC method.
+type QueryTWAP struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window defines the number of most recent blocks to average over.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryTWAP) Reset()         { *m = QueryTWAP{} }
+func (m *QueryTWAP) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAP) ProtoMessage()    {}
+func (*QueryTWAP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{32}
+}
+func (m *QueryTWAP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAP.Merge(m, src)
+}
+func (m *QueryTWAP) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAP) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAP proto.InternalMessageInfo
+
+// QueryTWAPResponse is response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	// twap defines the time weighted average price of the denom.
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+}
+
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{33}
+}
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
+// QueryHistoricPrices is the request type for the Query/HistoricPrices RPC
+// method.
+type QueryHistoricPrices struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricPrices) Reset()         { *m = QueryHistoricPrices{} }
+func (m *QueryHistoricPrices) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricPrices) ProtoMessage()    {}
+func (*QueryHistoricPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{34}
+}
+func (m *QueryHistoricPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricPrices.Merge(m, src)
+}
+func (m *QueryHistoricPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricPrices proto.InternalMessageInfo
+
+// QueryHistoricPricesResponse is response type for the
+// Query/HistoricPrices RPC method.
+type QueryHistoricPricesResponse struct {
+	// historic_prices defines the historic price stamps of the denom.
+	HistoricPrices []Price `protobuf:"bytes,1,rep,name=historic_prices,json=historicPrices,proto3" json:"historic_prices"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoricPricesResponse) Reset()         { *m = QueryHistoricPricesResponse{} }
+func (m *QueryHistoricPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricPricesResponse) ProtoMessage()    {}
+func (*QueryHistoricPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_710e319bc1815d33, []int{35}
+}
+func (m *QueryHistoricPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricPricesResponse.Merge(m, src)
+}
+func (m *QueryHistoricPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricPricesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryExchangeRates)(nil), "umee.oracle.v1.QueryExchangeRates")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "umee.oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryExchangeRateSources)(nil), "umee.oracle.v1.QueryExchangeRateSources")
+	proto.RegisterType((*QueryExchangeRateSourcesResponse)(nil), "umee.oracle.v1.QueryExchangeRateSourcesResponse")
+	proto.RegisterType((*QueryActiveExchangeRates)(nil), "umee.oracle.v1.QueryActiveExchangeRates")
+	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "umee.oracle.v1.QueryActiveExchangeRatesResponse")
+	proto.RegisterType((*QueryFeederDelegation)(nil), "umee.oracle.v1.QueryFeederDelegation")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "umee.oracle.v1.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryMissCounter)(nil), "umee.oracle.v1.QueryMissCounter")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "umee.oracle.v1.QueryMissCounterResponse")
+	proto.RegisterType((*QueryInaccurateVoteCounter)(nil), "umee.oracle.v1.QueryInaccurateVoteCounter")
+	proto.RegisterType((*QueryInaccurateVoteCounterResponse)(nil), "umee.oracle.v1.QueryInaccurateVoteCounterResponse")
+	proto.RegisterType((*QuerySlashWindow)(nil), "umee.oracle.v1.QuerySlashWindow")
+	proto.RegisterType((*QuerySlashWindowResponse)(nil), "umee.oracle.v1.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryAggregatePrevote)(nil), "umee.oracle.v1.QueryAggregatePrevote")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "umee.oracle.v1.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotes)(nil), "umee.oracle.v1.QueryAggregatePrevotes")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "umee.oracle.v1.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVote)(nil), "umee.oracle.v1.QueryAggregateVote")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "umee.oracle.v1.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotes)(nil), "umee.oracle.v1.QueryAggregateVotes")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "umee.oracle.v1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParams)(nil), "umee.oracle.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "umee.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMedians)(nil), "umee.oracle.v1.QueryMedians")
+	proto.RegisterType((*QueryMediansResponse)(nil), "umee.oracle.v1.QueryMediansResponse")
+	proto.RegisterType((*QueryMedianDeviations)(nil), "umee.oracle.v1.QueryMedianDeviations")
+	proto.RegisterType((*QueryMedianDeviationsResponse)(nil), "umee.oracle.v1.QueryMedianDeviationsResponse")
+	proto.RegisterType((*QueryMedianOfHistoricMedians)(nil), "umee.oracle.v1.QueryMedianOfHistoricMedians")
+	proto.RegisterType((*QueryMedianOfHistoricMediansResponse)(nil), "umee.oracle.v1.QueryMedianOfHistoricMediansResponse")
+	proto.RegisterType((*QueryAverageOfHistoricMedians)(nil), "umee.oracle.v1.QueryAverageOfHistoricMedians")
+	proto.RegisterType((*QueryAverageOfHistoricMediansResponse)(nil), "umee.oracle.v1.QueryAverageOfHistoricMediansResponse")
+	proto.RegisterType((*QueryTWAP)(nil), "umee.oracle.v1.QueryTWAP")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "umee.oracle.v1.QueryTWAPResponse")
+	proto.RegisterType((*QueryHistoricPrices)(nil), "umee.oracle.v1.QueryHistoricPrices")
+	proto.RegisterType((*QueryHistoricPricesResponse)(nil), "umee.oracle.v1.QueryHistoricPricesResponse")
+}
+
+func init() { proto.RegisterFile("umee/oracle/v1/query.proto", fileDescriptor_710e319bc1815d33) }
+
+var fileDescriptor_710e319bc1815d33 = []byte{
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xc7, 0x3b, 0x58, 0x8a, 0x3d, 0x4b, 0x4b, 0x7b, 0x4b, 0xcb, 0x3a, 0x2d, 0xdb, 0x32, 0x14,
+	0xa8, 0xa5, 0x9d, 0x29, 0x4b, 0x41, 0x42, 0x44, 0xed, 0x2f, 0xc4, 0x28, 0xb1, 0x2e, 0xa6, 0x18,
+	0x83, 0x6e, 0x6e, 0x77, 0x2f, 0xdb, 0x91, 0xee, 0xcc, 0x32, 0x77, 0x76, 0x0b, 0x21, 0x8d, 0x04,
+	0x5e, 0x7c, 0x34, 0x21, 0xe1, 0x91, 0x10, 0x35, 0x31, 0x31, 0x26, 0x3e, 0xf8, 0xc0, 0xbf, 0xc0,
+	0x23, 0x89, 0x2f, 0xc6, 0x07, 0x54, 0xf0, 0xc1, 0x3f, 0xc3, 0xcc, 0xbd, 0x77, 0x6e, 0x67, 0xe7,
+	0xc7, 0xee, 0x6c, 0x23, 0x4f, 0xb0, 0xf7, 0x7c, 0xef, 0xf9, 0x7e, 0xce, 0xd9, 0x99, 0xbb, 0xf7,
+	0x14, 0xd4, 0x7a, 0x95, 0x10, 0xc3, 0x76, 0x70, 0x69, 0x93, 0x18, 0x8d, 0x53, 0xc6, 0xcd, 0x3a,
+	0x71, 0x6e, 0xeb, 0x35, 0xc7, 0x76, 0x6d, 0xd4, 0xef, 0xc5, 0x74, 0x1e, 0xd3, 0x1b, 0xa7, 0xd4,
+	0x83, 0x15, 0xbb, 0x62, 0xb3, 0x90, 0xe1, 0xfd, 0x8f, 0xab, 0xd4, 0xb1, 0x8a, 0x6d, 0x57, 0x36,
+	0x89, 0x81, 0x6b, 0xa6, 0x81, 0x2d, 0xcb, 0x76, 0xb1, 0x6b, 0xda, 0x16, 0x15, 0xd1, 0xd1, 0x50,
+	0x7e, 0x91, 0x8d, 0x07, 0x73, 0x25, 0x9b, 0x56, 0x6d, 0x6a, 0xac, 0x63, 0xea, 0x05, 0xd7, 0x89,
+	0x8b, 0x4f, 0x19, 0x25, 0xdb, 0xb4, 0x44, 0x7c, 0x3a, 0x18, 0x67, 0x64, 0x52, 0x55, 0xc3, 0x15,
+	0xd3, 0x62, 0x4e, 0x3e, 0x46, 0xc8, 0xa8, 0x42, 0x2c, 0x42, 0x4d, 0x81, 0xa1, 0xcd, 0x03, 0xfa,
+	0xc4, 0xdb, 0xbf, 0x72, 0xab, 0xb4, 0x81, 0xad, 0x0a, 0x29, 0x60, 0x97, 0x50, 0x74, 0x10, 0xf6,
+	0x96, 0x89, 0x65, 0x57, 0xb3, 0xca, 0x84, 0x32, 0xd5, 0x5b, 0xe0, 0x1f, 0xce, 0xbf, 0xfe, 0xcd,
+	0xe3, 0xf1, 0xae, 0x7f, 0x1f, 0x8f, 0x77, 0x69, 0x0f, 0x15, 0x50, 0xa3, 0xdb, 0x0a, 0x84, 0xd6,
+	0x6c, 0x8b, 0x12, 0x74, 0x0b, 0xfa, 0x89, 0x08, 0x14, 0x1d, 0x2f, 0x92, 0x55, 0x26, 0x5e, 0x9b,
+	0xca, 0xe4, 0xc7, 0x74, 0xce, 0xad, 0x7b, 0xdc, 0xba, 0x20, 0xd6, 0x97, 0x49, 0x69, 0xc9, 0x36,
+	0xad, 0xc5, 0xd3, 0x4f, 0x9f, 0x8f, 0x77, 0xfd, 0xf4, 0xe7, 0xf8, 0xc9, 0x8a, 0xe9, 0x6e, 0xd4,
+	0xd7, 0xf5, 0x92, 0x5d, 0x35, 0x44, 0x9d, 0xfc, 0x9f, 0x59, 0x5a, 0xbe, 0x61, 0xb8, 0xb7, 0x6b,
+	0x84, 0xfa, 0x7b, 0x68, 0xa1, 0x8f, 0x04, 0x09, 0xb4, 0x39, 0xc8, 0x46, 0xb8, 0xae, 0xd8, 0x75,
+	0xa7, 0x94, 0x54, 0x94, 0x76, 0x57, 0x81, 0x89, 0xa4, 0x2d, 0xb2, 0xa0, 0x6b, 0x30, 0xdc, 0x54,
+	0x50, 0x91, 0x72, 0x81, 0xa8, 0x4b, 0xd3, 0x9b, 0x1f, 0x08, 0x3d, 0x9a, 0x6b, 0xb1, 0xdb, 0xab,
+	0xae, 0x30, 0x44, 0xa2, 0x2e, 0x9a, 0x2a, 0xa0, 0x17, 0x4a, 0xae, 0xd9, 0x20, 0x4d, 0x2d, 0xd5,
+	0x56, 0x60, 0x22, 0x29, 0x26, 0xe9, 0x8e, 0xc0, 0x7e, 0xcc, 0xc2, 0x81, 0x66, 0xf7, 0x16, 0x32,
+	0x7c, 0x8d, 0xa7, 0xb9, 0x04, 0xc3, 0x2c, 0xcd, 0x45, 0x42, 0xca, 0xc4, 0x59, 0x26, 0x9b, 0xa4,
+	0xc2, 0x9e, 0x11, 0x74, 0x0c, 0xfa, 0x1b, 0x78, 0xd3, 0x2c, 0x63, 0xd7, 0x76, 0x8a, 0xb8, 0x5c,
+	0x76, 0x44, 0x77, 0xfa, 0xe4, 0xea, 0x42, 0xb9, 0xec, 0x04, 0xbe, 0xfa, 0xf7, 0xe0, 0x70, 0x6c,
+	0x26, 0x49, 0x33, 0x0e, 0x99, 0xeb, 0x2c, 0x16, 0x4c, 0x07, 0x7c, 0xc9, 0xcb, 0xa5, 0x2d, 0xc1,
+	0x00, 0xcb, 0x70, 0xd9, 0xa4, 0x74, 0xc9, 0xae, 0x5b, 0x2e, 0x71, 0x3a, 0xc7, 0xb8, 0x00, 0xd9,
+	0x70, 0x92, 0x60, 0x3f, 0xaa, 0x26, 0xa5, 0xc5, 0x12, 0x5f, 0x67, 0xa9, 0xba, 0x0b, 0x99, 0xea,
+	0x8e, 0x54, 0xbb, 0x2c, 0x9e, 0xdf, 0x0f, 0x2c, 0x5c, 0x2a, 0xd5, 0xbd, 0xb6, 0xad, 0xd9, 0x2e,
+	0xd9, 0x35, 0xcd, 0x35, 0xd0, 0x92, 0xd3, 0x49, 0xae, 0xb3, 0x70, 0xc8, 0x94, 0x82, 0x62, 0xc3,
+	0x76, 0x49, 0x08, 0x71, 0xd8, 0x8c, 0xdb, 0xaf, 0x21, 0xd1, 0xb0, 0x2b, 0x9b, 0x98, 0x6e, 0x5c,
+	0x35, 0xad, 0xb2, 0xbd, 0xa5, 0x2d, 0x41, 0x36, 0xbc, 0x26, 0x7d, 0x4e, 0xc0, 0x81, 0x2d, 0xb6,
+	0x52, 0xac, 0x39, 0x76, 0xc5, 0x21, 0x94, 0x8a, 0xfc, 0xfd, 0x7c, 0x79, 0x55, 0xac, 0xca, 0xa7,
+	0x62, 0xa1, 0x52, 0x71, 0xbc, 0xaf, 0x91, 0xac, 0x3a, 0xc4, 0xc3, 0xea, 0xbc, 0x01, 0x77, 0x15,
+	0x38, 0x1c, 0x9b, 0x4a, 0x42, 0x15, 0x61, 0x10, 0xfb, 0xb1, 0x62, 0x8d, 0x07, 0x59, 0xd6, 0x4c,
+	0x7e, 0x26, 0xfc, 0xfa, 0xc8, 0x24, 0xc1, 0xe7, 0x5d, 0x24, 0x14, 0x2f, 0xd2, 0x00, 0x0e, 0x19,
+	0x69, 0x59, 0x18, 0x89, 0x25, 0xa0, 0xda, 0x7d, 0x05, 0x72, 0xf1, 0x21, 0x49, 0x87, 0x01, 0x45,
+	0xe8, 0xfc, 0xb7, 0x7b, 0x37, 0x78, 0x83, 0x38, 0x42, 0xb1, 0x22, 0x4e, 0x5a, 0xb9, 0x7b, 0x6d,
+	0x57, 0x9d, 0x76, 0x41, 0x8d, 0xa6, 0x91, 0x75, 0xac, 0x41, 0xff, 0x4e, 0x1d, 0x81, 0x16, 0xbf,
+	0x99, 0xaa, 0x86, 0xb5, 0x9d, 0x02, 0xfa, 0x70, 0x30, 0xbf, 0x36, 0x0c, 0x43, 0x51, 0x57, 0xaa,
+	0x6d, 0xc1, 0x68, 0xcc, 0xb2, 0xa4, 0xf9, 0x0c, 0x0e, 0x34, 0xd3, 0xf8, 0x2d, 0xed, 0x18, 0xa7,
+	0x1f, 0x37, 0x1b, 0xf7, 0x41, 0x86, 0x19, 0xaf, 0x62, 0x07, 0x57, 0xa9, 0xf6, 0x21, 0x0c, 0x05,
+	0x3e, 0x4a, 0xff, 0x79, 0xe8, 0xa9, 0xb1, 0x15, 0xd1, 0x85, 0x91, 0xb0, 0x2d, 0xd7, 0x0b, 0x0f,
+	0xa1, 0xd5, 0x74, 0xd8, 0xcf, 0x8f, 0x16, 0x52, 0x36, 0xb1, 0xd5, 0xfe, 0xc7, 0xf0, 0xbe, 0x02,
+	0x07, 0x83, 0x1b, 0xa4, 0xfd, 0x0d, 0xd8, 0x57, 0xe5, 0x4b, 0xaf, 0xee, 0xf7, 0xcf, 0x77, 0xd0,
+	0xde, 0x82, 0xe1, 0x00, 0xc4, 0x32, 0x69, 0x98, 0xfc, 0xba, 0xd1, 0x16, 0xff, 0x91, 0xff, 0xea,
+	0x86, 0x77, 0xca, 0x3a, 0xb6, 0x61, 0xa0, 0x1a, 0x8a, 0xbd, 0xba, 0x82, 0x22, 0x56, 0xda, 0x17,
+	0x30, 0x16, 0xe0, 0xfb, 0xf8, 0xfa, 0x25, 0x93, 0xba, 0xb6, 0x63, 0x96, 0x5a, 0x7e, 0x3f, 0xe8,
+	0x30, 0x80, 0x55, 0xaf, 0x16, 0xa9, 0x8b, 0xab, 0x35, 0x9a, 0xdd, 0xc3, 0xce, 0xbf, 0x5e, 0xab,
+	0x5e, 0xbd, 0xc2, 0x16, 0x02, 0xf5, 0x5b, 0x30, 0xd9, 0x2a, 0xbd, 0xec, 0xc2, 0x45, 0xe8, 0xe1,
+	0x68, 0xdc, 0x67, 0x51, 0xf7, 0xaa, 0xfb, 0xe3, 0xf9, 0xf8, 0xf1, 0x74, 0xd5, 0x15, 0xc4, 0x6e,
+	0xed, 0x4b, 0xff, 0xa4, 0x6c, 0x10, 0x07, 0x57, 0xc8, 0xff, 0x5e, 0xcf, 0x4d, 0x38, 0xd6, 0x32,
+	0xbf, 0x2c, 0xe8, 0x12, 0xec, 0xc3, 0x5c, 0xb3, 0xcb, 0x8a, 0xfc, 0xed, 0xda, 0x12, 0xf4, 0x32,
+	0xcb, 0x4f, 0xaf, 0x2e, 0xac, 0x26, 0xe0, 0x8f, 0x40, 0x0f, 0xff, 0xf1, 0x11, 0xe8, 0xe2, 0x53,
+	0x80, 0xfb, 0x2a, 0x0c, 0xca, 0x24, 0x92, 0x71, 0x11, 0xba, 0xdd, 0x2d, 0x5c, 0xdb, 0x25, 0x20,
+	0xdb, 0xab, 0x6d, 0x8b, 0xc3, 0xc1, 0xef, 0xc3, 0xaa, 0x63, 0x26, 0x5e, 0x07, 0xd1, 0x45, 0x80,
+	0x9d, 0x1b, 0x34, 0x63, 0xcd, 0xe4, 0x8f, 0x37, 0x3d, 0xe5, 0x7c, 0x10, 0xf0, 0x9f, 0xf5, 0x55,
+	0x5c, 0x21, 0x05, 0x72, 0xb3, 0x4e, 0xa8, 0x5b, 0x08, 0xec, 0x0c, 0xd4, 0xf5, 0xb3, 0x02, 0xa3,
+	0x31, 0xfe, 0xb2, 0xc4, 0x65, 0x38, 0xb0, 0x21, 0x22, 0xc5, 0x1a, 0x0b, 0x89, 0x97, 0x6b, 0x38,
+	0x72, 0x5a, 0x79, 0x51, 0xff, 0x40, 0xdc, 0x68, 0xae, 0xe6, 0xfd, 0x18, 0xee, 0x13, 0x6d, 0xb9,
+	0x39, 0x42, 0x10, 0x3c, 0x7f, 0x6f, 0x04, 0xf6, 0x32, 0x5c, 0xf4, 0x50, 0x81, 0xbe, 0xe6, 0xb1,
+	0x20, 0x72, 0xcf, 0x8d, 0xce, 0x00, 0xea, 0x74, 0x7b, 0x8d, 0xef, 0xab, 0x9d, 0xb9, 0xf7, 0xdb,
+	0x3f, 0x0f, 0xf6, 0x18, 0x68, 0xd6, 0x08, 0xcd, 0x28, 0xec, 0xbb, 0xa0, 0x46, 0xf3, 0x10, 0x61,
+	0xdc, 0x61, 0xcb, 0xdb, 0xe8, 0x47, 0x05, 0x86, 0xe2, 0x2e, 0xf8, 0x53, 0x6d, 0xad, 0x85, 0x52,
+	0x9d, 0x4b, 0xab, 0x94, 0xa8, 0xf3, 0x0c, 0x55, 0x47, 0x33, 0x69, 0x50, 0xfd, 0xf1, 0x80, 0x91,
+	0xc6, 0xdc, 0xdc, 0x13, 0x48, 0x63, 0x94, 0xea, 0x5c, 0x5a, 0x65, 0x6a, 0x52, 0x31, 0x2a, 0x34,
+	0xf7, 0x16, 0xfd, 0xa0, 0xc0, 0x40, 0x74, 0x38, 0x88, 0x35, 0x0f, 0xcb, 0xd4, 0xd9, 0x54, 0x32,
+	0x09, 0x78, 0x9e, 0x01, 0xce, 0xa3, 0x7c, 0x18, 0x50, 0x5e, 0x79, 0xa8, 0x71, 0xa7, 0xf9, 0x52,
+	0xb4, 0x6d, 0xf0, 0xf9, 0x01, 0x3d, 0x50, 0x20, 0x13, 0x9c, 0x1b, 0x26, 0x62, 0xad, 0x03, 0x0a,
+	0x75, 0xaa, 0x9d, 0x42, 0x72, 0x9d, 0x63, 0x5c, 0x79, 0x34, 0xd7, 0x09, 0x97, 0x37, 0x54, 0xa0,
+	0x27, 0x0a, 0x0c, 0xc7, 0x4f, 0x12, 0xf1, 0x6f, 0x43, 0xac, 0x56, 0xcd, 0xa7, 0xd7, 0x4a, 0xe6,
+	0x77, 0x18, 0xf3, 0x39, 0x74, 0xb6, 0x13, 0xe6, 0x9d, 0x29, 0x03, 0x7d, 0x0d, 0x99, 0xc0, 0x04,
+	0x91, 0xd0, 0xce, 0x80, 0x42, 0x9d, 0x6a, 0xa7, 0x90, 0x68, 0x93, 0x0c, 0x2d, 0x87, 0xc6, 0xc2,
+	0x68, 0xd4, 0x13, 0x17, 0xf9, 0xf9, 0x8f, 0x7e, 0x51, 0x60, 0x20, 0x3a, 0x7e, 0xc4, 0x3f, 0xf4,
+	0x21, 0x99, 0x3a, 0x9b, 0x4a, 0x26, 0x81, 0x56, 0x18, 0xd0, 0xbb, 0xe8, 0x42, 0x27, 0xbd, 0x8a,
+	0x4c, 0x05, 0xe8, 0x3b, 0x05, 0x06, 0xc3, 0x1e, 0x14, 0x1d, 0x4f, 0xc5, 0x42, 0x55, 0x3d, 0x9d,
+	0xae, 0xfd, 0x11, 0x19, 0x80, 0x8e, 0x30, 0x52, 0xf4, 0xbd, 0x02, 0x7d, 0xcd, 0x83, 0x86, 0xd6,
+	0xda, 0xd8, 0xd3, 0xa8, 0xd3, 0xed, 0x35, 0x12, 0x6c, 0x91, 0x81, 0xbd, 0x8d, 0xce, 0xc7, 0x80,
+	0x95, 0xcd, 0xb6, 0xdd, 0x64, 0xad, 0x7c, 0xa8, 0x40, 0x7f, 0x53, 0x76, 0x8a, 0x8e, 0xb6, 0x47,
+	0xa0, 0xea, 0xc9, 0x14, 0x22, 0x09, 0x9a, 0x67, 0xa0, 0x33, 0x68, 0x3a, 0x55, 0x07, 0x79, 0xfb,
+	0xbe, 0x82, 0x1e, 0x3e, 0x1a, 0xa0, 0xd1, 0x58, 0x2b, 0x1e, 0x54, 0x8f, 0xb6, 0x08, 0x4a, 0xff,
+	0x1c, 0xf3, 0xcf, 0xa2, 0x91, 0xb0, 0x3f, 0x1f, 0x37, 0xd0, 0x6d, 0xd8, 0xe7, 0xdf, 0xfc, 0xc6,
+	0xe2, 0xcf, 0x2a, 0x1e, 0x55, 0x27, 0x5b, 0x45, 0xa5, 0xdd, 0x34, 0xb3, 0x9b, 0x44, 0x1a, 0xb7,
+	0xe3, 0xd7, 0x84, 0xd0, 0x4f, 0x80, 0x98, 0x19, 0xd0, 0x23, 0x05, 0x06, 0x22, 0xf3, 0xc2, 0xb1,
+	0x16, 0x36, 0x3b, 0x32, 0x75, 0x36, 0x95, 0x2c, 0xe9, 0x57, 0xa9, 0x05, 0x56, 0xb1, 0xbc, 0xc3,
+	0xf2, 0xab, 0x02, 0x87, 0x92, 0xae, 0xfd, 0x33, 0x2d, 0x00, 0x22, 0x6a, 0x75, 0xbe, 0x13, 0xf5,
+	0x6e, 0xa8, 0xed, 0xeb, 0x45, 0xbf, 0xad, 0x4f, 0x14, 0xc8, 0x26, 0xde, 0xee, 0x13, 0x0e, 0xad,
+	0x04, 0xb9, 0x7a, 0xa6, 0x23, 0x79, 0xd2, 0xb1, 0x11, 0x0b, 0x2e, 0x6e, 0xef, 0x41, 0xf2, 0x2a,
+	0x74, 0xb3, 0x3b, 0xfc, 0x1b, 0xb1, 0xae, 0x5e, 0x48, 0x3d, 0x92, 0x18, 0x92, 0xe6, 0x27, 0x98,
+	0xf9, 0x11, 0x34, 0xde, 0xc2, 0xdc, 0xbb, 0x99, 0xb3, 0xf7, 0x3f, 0x74, 0x2b, 0x8f, 0x7f, 0xa5,
+	0x9a, 0x45, 0xea, 0xc9, 0x14, 0xa2, 0xa4, 0xf7, 0x3f, 0x96, 0x26, 0x74, 0x01, 0x5f, 0xfc, 0xe8,
+	0xe9, 0xdf, 0xb9, 0xae, 0xa7, 0x2f, 0x72, 0xca, 0xb3, 0x17, 0x39, 0xe5, 0xaf, 0x17, 0x39, 0xe5,
+	0xdb, 0x97, 0xb9, 0xae, 0x67, 0x2f, 0x73, 0x5d, 0xbf, 0xbf, 0xcc, 0x75, 0x7d, 0xae, 0x07, 0xc6,
+	0x0f, 0x2f, 0xe7, 0xac, 0x45, 0xdc, 0x2d, 0xdb, 0xb9, 0xc1, 0x0d, 0x1a, 0xa7, 0x8d, 0x5b, 0xfe,
+	0x5b, 0xce, 0x46, 0x91, 0xf5, 0x1e, 0xf6, 0xa7, 0xf6, 0xd3, 0xff, 0x0d, 0x00, 0xc5, 0x33, 0x7c,
+	0x9e, 0x53, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRates returns exchange rates of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// ExchangeRateSources returns whether the exchange rates of all denoms,
+	// or, if specified, of a single denom, were voted or derived
+	ExchangeRateSources(ctx context.Context, in *QueryExchangeRateSources, opts ...grpc.CallOption) (*QueryExchangeRateSourcesResponse, error)
+	// ActiveExchangeRates returns all active denoms
+	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// InaccurateVoteCounter returns oracle inaccurate vote counter of a
+	// validator
+	InaccurateVoteCounter(ctx context.Context, in *QueryInaccurateVoteCounter, opts ...grpc.CallOption) (*QueryInaccurateVoteCounterResponse, error)
+	// SlashWindow returns slash window information
+	SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevote, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotes, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(ctx context.Context, in *QueryAggregateVote, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotes, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Medians returns medians of all denoms,
+	// or, if specified, returns a single median
+	Medians(ctx context.Context, in *QueryMedians, opts ...grpc.CallOption) (*QueryMediansResponse, error)
+	// MedianDeviations returns median deviations of all denoms,
+	// or, if specified, returns a single median deviation
+	MedianDeviations(ctx context.Context, in *QueryMedianDeviations, opts ...grpc.CallOption) (*QueryMedianDeviationsResponse, error)
+	// MedianOfHistoricMedians returns the median of the last num_stamps
+	// historic medians of a denom
+	MedianOfHistoricMedians(ctx context.Context, in *QueryMedianOfHistoricMedians, opts ...grpc.CallOption) (*QueryMedianOfHistoricMediansResponse, error)
+	// AverageOfHistoricMedians returns the average of the last num_stamps
+	// historic medians of a denom
+	AverageOfHistoricMedians(ctx context.Context, in *QueryAverageOfHistoricMedians, opts ...grpc.CallOption) (*QueryAverageOfHistoricMediansResponse, error)
+	// TWAP returns the time weighted average price of a denom over the
+	// last window blocks, computed from the historic price stamps
+	TWAP(ctx context.Context, in *QueryTWAP, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
+	// HistoricPrices returns the raw historic price stamps of a denom
+	HistoricPrices(ctx context.Context, in *QueryHistoricPrices, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRates, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRateSources(ctx context.Context, in *QueryExchangeRateSources, opts ...grpc.CallOption) (*QueryExchangeRateSourcesResponse, error) {
+	out := new(QueryExchangeRateSourcesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ExchangeRateSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRates, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error) {
+	out := new(QueryActiveExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/ActiveExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegation, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
 	out := new(QueryMissCounterResponse)
 	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MissCounter", in, out, opts...)
 	if err != nil {
@@ -1173,6 +1732,15 @@ func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounter, opt
 	return out, nil
 }
 
+func (c *queryClient) InaccurateVoteCounter(ctx context.Context, in *QueryInaccurateVoteCounter, opts ...grpc.CallOption) (*QueryInaccurateVoteCounterResponse, error) {
+	out := new(QueryInaccurateVoteCounterResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/InaccurateVoteCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindow, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
 	out := new(QuerySlashWindowResponse)
 	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/SlashWindow", in, out, opts...)
@@ -1245,17 +1813,59 @@ func (c *queryClient) MedianDeviations(ctx context.Context, in *QueryMedianDevia
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
+func (c *queryClient) MedianOfHistoricMedians(ctx context.Context, in *QueryMedianOfHistoricMedians, opts ...grpc.CallOption) (*QueryMedianOfHistoricMediansResponse, error) {
+	out := new(QueryMedianOfHistoricMediansResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/MedianOfHistoricMedians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AverageOfHistoricMedians(ctx context.Context, in *QueryAverageOfHistoricMedians, opts ...grpc.CallOption) (*QueryAverageOfHistoricMediansResponse, error) {
+	out := new(QueryAverageOfHistoricMediansResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/AverageOfHistoricMedians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAP, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricPrices(ctx context.Context, in *QueryHistoricPrices, opts ...grpc.CallOption) (*QueryHistoricPricesResponse, error) {
+	out := new(QueryHistoricPricesResponse)
+	err := c.cc.Invoke(ctx, "/umee.oracle.v1.Query/HistoricPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ExchangeRates returns exchange rates of all denoms,
 	// or, if specified, returns a single denom
 	ExchangeRates(context.Context, *QueryExchangeRates) (*QueryExchangeRatesResponse, error)
+	// ExchangeRateSources returns whether the exchange rates of all denoms,
+	// or, if specified, of a single denom, were voted or derived
+	ExchangeRateSources(context.Context, *QueryExchangeRateSources) (*QueryExchangeRateSourcesResponse, error)
 	// ActiveExchangeRates returns all active denoms
 	ActiveExchangeRates(context.Context, *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(context.Context, *QueryFeederDelegation) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	MissCounter(context.Context, *QueryMissCounter) (*QueryMissCounterResponse, error)
+	// InaccurateVoteCounter returns oracle inaccurate vote counter of a
+	// validator
+	InaccurateVoteCounter(context.Context, *QueryInaccurateVoteCounter) (*QueryInaccurateVoteCounterResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(context.Context, *QuerySlashWindow) (*QuerySlashWindowResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
@@ -1274,6 +1884,17 @@ type QueryServer interface {
 	// MedianDeviations returns median deviations of all denoms,
 	// or, if specified, returns a single median deviation
 	MedianDeviations(context.Context, *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error)
+	// MedianOfHistoricMedians returns the median of the last num_stamps
+	// historic medians of a denom
+	MedianOfHistoricMedians(context.Context, *QueryMedianOfHistoricMedians) (*QueryMedianOfHistoricMediansResponse, error)
+	// AverageOfHistoricMedians returns the average of the last num_stamps
+	// historic medians of a denom
+	AverageOfHistoricMedians(context.Context, *QueryAverageOfHistoricMedians) (*QueryAverageOfHistoricMediansResponse, error)
+	// TWAP returns the time weighted average price of a denom over the
+	// last window blocks, computed from the historic price stamps
+	TWAP(context.Context, *QueryTWAP) (*QueryTWAPResponse, error)
+	// HistoricPrices returns the raw historic price stamps of a denom
+	HistoricPrices(context.Context, *QueryHistoricPrices) (*QueryHistoricPricesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1283,6 +1904,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRates) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateSources(ctx context.Context, req *QueryExchangeRateSources) (*QueryExchangeRateSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateSources not implemented")
+}
 func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRates) (*QueryActiveExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
 }
@@ -1292,6 +1916,9 @@ func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounter) (*QueryMissCounterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
 }
+func (*UnimplementedQueryServer) InaccurateVoteCounter(ctx context.Context, req *QueryInaccurateVoteCounter) (*QueryInaccurateVoteCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InaccurateVoteCounter not implemented")
+}
 func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindow) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
@@ -1316,6 +1943,18 @@ func (*UnimplementedQueryServer) Medians(ctx context.Context, req *QueryMedians)
 func (*UnimplementedQueryServer) MedianDeviations(ctx context.Context, req *QueryMedianDeviations) (*QueryMedianDeviationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MedianDeviations not implemented")
 }
+func (*UnimplementedQueryServer) MedianOfHistoricMedians(ctx context.Context, req *QueryMedianOfHistoricMedians) (*QueryMedianOfHistoricMediansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MedianOfHistoricMedians not implemented")
+}
+func (*UnimplementedQueryServer) AverageOfHistoricMedians(ctx context.Context, req *QueryAverageOfHistoricMedians) (*QueryAverageOfHistoricMediansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AverageOfHistoricMedians not implemented")
+}
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAP) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
+func (*UnimplementedQueryServer) HistoricPrices(ctx context.Context, req *QueryHistoricPrices) (*QueryHistoricPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricPrices not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1339,6 +1978,24 @@ func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateSources)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/ExchangeRateSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateSources(ctx, req.(*QueryExchangeRateSources))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActiveExchangeRates)
 	if err := dec(in); err != nil {
@@ -1393,6 +2050,24 @@ func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InaccurateVoteCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInaccurateVoteCounter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InaccurateVoteCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/InaccurateVoteCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InaccurateVoteCounter(ctx, req.(*QueryInaccurateVoteCounter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySlashWindow)
 	if err := dec(in); err != nil {
@@ -1537,6 +2212,78 @@ func _Query_MedianDeviations_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MedianOfHistoricMedians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMedianOfHistoricMedians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MedianOfHistoricMedians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/MedianOfHistoricMedians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MedianOfHistoricMedians(ctx, req.(*QueryMedianOfHistoricMedians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AverageOfHistoricMedians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAverageOfHistoricMedians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AverageOfHistoricMedians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/AverageOfHistoricMedians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AverageOfHistoricMedians(ctx, req.(*QueryAverageOfHistoricMedians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umee.oracle.v1.Query/HistoricPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricPrices(ctx, req.(*QueryHistoricPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "umee.oracle.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1545,6 +2292,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
 		},
+		{
+			MethodName: "ExchangeRateSources",
+			Handler:    _Query_ExchangeRateSources_Handler,
+		},
 		{
 			MethodName: "ActiveExchangeRates",
 			Handler:    _Query_ActiveExchangeRates_Handler,
@@ -1557,6 +2308,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MissCounter",
 			Handler:    _Query_MissCounter_Handler,
 		},
+		{
+			MethodName: "InaccurateVoteCounter",
+			Handler:    _Query_InaccurateVoteCounter_Handler,
+		},
 		{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
@@ -1589,6 +2344,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MedianDeviations",
 			Handler:    _Query_MedianDeviations_Handler,
 		},
+		{
+			MethodName: "MedianOfHistoricMedians",
+			Handler:    _Query_MedianOfHistoricMedians_Handler,
+		},
+		{
+			MethodName: "AverageOfHistoricMedians",
+			Handler:    _Query_AverageOfHistoricMedians_Handler,
+		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
+		{
+			MethodName: "HistoricPrices",
+			Handler:    _Query_HistoricPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "umee/oracle/v1/query.proto",
@@ -1661,6 +2432,73 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExchangeRateSources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateSources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateSourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateSourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateSourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRateSources) > 0 {
+		for iNdEx := len(m.ExchangeRateSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryActiveExchangeRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1834,6 +2672,64 @@ func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInaccurateVoteCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInaccurateVoteCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInaccurateVoteCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInaccurateVoteCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInaccurateVoteCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInaccurateVoteCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InaccurateVoteCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InaccurateVoteCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySlashWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2321,148 +3217,496 @@ func (m *QueryMedianDeviationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMedianOfHistoricMedians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryMedianOfHistoricMedians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianOfHistoricMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumStamps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMedianOfHistoricMediansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedianOfHistoricMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianOfHistoricMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveExchangeRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAverageOfHistoricMedians) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAverageOfHistoricMedians) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAverageOfHistoricMedians) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.NumStamps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumStamps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAverageOfHistoricMediansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAverageOfHistoricMediansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAverageOfHistoricMediansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ActiveRates) > 0 {
-		for _, s := range m.ActiveRates {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Average.Size()
+		i -= size
+		if _, err := m.Average.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTWAP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissCounter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHistoricPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHistoricPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.HistoricPrices) > 0 {
+		for iNdEx := len(m.HistoricPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashWindow) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QuerySlashWindowResponse) Size() (n int) {
+func (m *QueryExchangeRatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.WindowProgress != 0 {
-		n += 1 + sovQuery(uint64(m.WindowProgress))
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryAggregatePrevote) Size() (n int) {
+func (m *QueryExchangeRateSources) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateSourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateSources) > 0 {
+		for _, e := range m.ExchangeRateSources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveRates) > 0 {
+		for _, s := range m.ActiveRates {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeederDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	return n
+}
+
+func (m *QueryInaccurateVoteCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInaccurateVoteCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InaccurateVoteCounter != 0 {
+		n += 1 + sovQuery(uint64(m.InaccurateVoteCounter))
+	}
+	return n
+}
+
+func (m *QuerySlashWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowProgress != 0 {
+		n += 1 + sovQuery(uint64(m.WindowProgress))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2610,31 +3854,927 @@ func (m *QueryMedianDeviations) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryMedianDeviationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MedianDeviations) > 0 {
+		for _, e := range m.MedianDeviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMedianOfHistoricMedians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	return n
+}
+
+func (m *QueryMedianOfHistoricMediansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Median.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAverageOfHistoricMedians) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumStamps != 0 {
+		n += 1 + sovQuery(uint64(m.NumStamps))
+	}
+	return n
+}
+
+func (m *QueryAverageOfHistoricMediansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Average.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTWAP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoricPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricPrices) > 0 {
+		for _, e := range m.HistoricPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateSources) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateSources: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateSources: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateSourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateSourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateSourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateSources = append(m.ExchangeRateSources, ExchangeRateSource{})
+			if err := m.ExchangeRateSources[len(m.ExchangeRateSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryMedianDeviationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.MedianDeviations) > 0 {
-		for _, e := range m.MedianDeviations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryInaccurateVoteCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2657,15 +4797,15 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInaccurateVoteCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInaccurateVoteCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2693,7 +4833,7 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2716,7 +4856,7 @@ func (m *QueryExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInaccurateVoteCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,17 +4879,17 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInaccurateVoteCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInaccurateVoteCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InaccurateVoteCounter", wireType)
 			}
-			var msglen int
+			m.InaccurateVoteCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2759,26 +4899,130 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InaccurateVoteCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			}
+			m.WindowProgress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProgress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2800,7 +5044,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,12 +5067,44 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2850,7 +5126,7 @@ func (m *QueryActiveExchangeRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2873,17 +5149,17 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2893,23 +5169,24 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveRates = append(m.ActiveRates, string(dAtA[iNdEx:postIndex]))
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2932,7 +5209,7 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2955,44 +5232,12 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3014,7 +5259,7 @@ func (m *QueryFeederDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,17 +5282,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3057,23 +5302,25 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3096,7 +5343,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3119,10 +5366,10 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3178,7 +5425,7 @@ func (m *QueryMissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3201,17 +5448,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3221,11 +5468,25 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3247,7 +5508,7 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3270,10 +5531,10 @@ func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3297,7 +5558,7 @@ func (m *QuerySlashWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3320,17 +5581,17 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProgress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
 			}
-			m.WindowProgress = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3340,11 +5601,26 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WindowProgress |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3366,7 +5642,7 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3389,44 +5665,12 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3448,7 +5692,7 @@ func (m *QueryAggregatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3471,15 +5715,15 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3506,7 +5750,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3531,7 +5775,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
+func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3554,12 +5798,44 @@ func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3581,7 +5857,7 @@ func (m *QueryAggregatePrevotes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3604,15 +5880,15 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3639,8 +5915,8 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Medians = append(m.Medians, types.DecCoin{})
+			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3665,7 +5941,7 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3688,15 +5964,15 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3724,7 +6000,7 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3747,7 +6023,7 @@ func (m *QueryAggregateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3770,15 +6046,15 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3805,7 +6081,8 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MedianDeviations = append(m.MedianDeviations, types.DecCoin{})
+			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3830,7 +6107,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianOfHistoricMedians) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3853,12 +6130,63 @@ func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotes: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianOfHistoricMedians: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianOfHistoricMedians: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3880,7 +6208,7 @@ func (m *QueryAggregateVotes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMedianOfHistoricMediansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3903,17 +6231,17 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMedianOfHistoricMediansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMedianOfHistoricMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3923,23 +6251,23 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3964,7 +6292,7 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParams) Unmarshal(dAtA []byte) error {
+func (m *QueryAverageOfHistoricMedians) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3987,12 +6315,63 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAverageOfHistoricMedians: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAverageOfHistoricMedians: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStamps", wireType)
+			}
+			m.NumStamps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStamps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4014,7 +6393,7 @@ func (m *QueryParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAverageOfHistoricMediansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4037,17 +6416,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAverageOfHistoricMediansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAverageOfHistoricMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4057,22 +6436,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4097,7 +6477,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedians) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4120,10 +6500,10 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedians: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedians: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4158,6 +6538,25 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4179,7 +6578,7 @@ func (m *QueryMedians) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,17 +6601,17 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMediansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMediansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Medians", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4222,23 +6621,23 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Medians = append(m.Medians, types.DecCoin{})
-			if err := m.Medians[len(m.Medians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4263,7 +6662,7 @@ func (m *QueryMediansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4286,10 +6685,10 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviations: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4324,6 +6723,42 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4345,7 +6780,7 @@ func (m *QueryMedianDeviations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4368,15 +6803,15 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMedianDeviationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianDeviations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4403,8 +6838,44 @@ func (m *QueryMedianDeviationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MedianDeviations = append(m.MedianDeviations, types.DecCoin{})
-			if err := m.MedianDeviations[len(m.MedianDeviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HistoricPrices = append(m.HistoricPrices, Price{})
+			if err := m.HistoricPrices[len(m.HistoricPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,18 @@
+package pricerelay
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/keeper"
+)
+
+// EndBlocker relays the exchange rates tallied by the oracle to every
+// subscribed channel at the last block of each vote period. It must run after
+// the oracle EndBlocker.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if (uint64(ctx.BlockHeight())+1)%k.VotePeriod(ctx) != 0 {
+		return
+	}
+
+	k.RelayExchangeRates(ctx)
+}
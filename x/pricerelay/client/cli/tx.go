@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+// GetTxCmd returns the CLI transaction commands for the x/pricerelay module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transaction commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdSendQuery(),
+	)
+
+	return cmd
+}
+
+// GetCmdSendQuery creates a Cobra command to generate or broadcast a
+// transaction with a MsgSendQuery message.
+func GetCmdSendQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-query [channel-id] [denoms]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Query the exchange rates of the counterparty of a price relay channel",
+		Long: `Query the exchange rates of the counterparty of a price relay channel.
+All the exchange rates are requested when no denom is given. The exchange
+rates are returned in the packet acknowledgement.`,
+		Example: fmt.Sprintf("umeed tx %s send-query channel-0 ATOM UMEE --from mykey", types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendQuery(clientCtx.GetFromAddress(), args[0], args[1:])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package pricerelay
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/keeper"
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+// InitGenesis initializes the x/pricerelay module's state from a provided
+// genesis state and binds the module to its port.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisState) {
+	keeper.SetParams(ctx, genState.Params)
+	keeper.SetPort(ctx, genState.PortId)
+
+	// Only try to bind to port if it is not already bound, since we may already
+	// own the port capability from capability InitGenesis.
+	if !keeper.IsBound(ctx, genState.PortId) {
+		if err := keeper.BindPort(ctx, genState.PortId); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, channelID := range genState.Subscriptions {
+		keeper.SetSubscription(ctx, channelID)
+	}
+}
+
+// ExportGenesis returns the x/pricerelay module's exported genesis state.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetPort(ctx),
+		keeper.GetSubscriptions(ctx),
+		keeper.GetParams(ctx),
+	)
+}
@@ -0,0 +1,269 @@
+package pricerelay
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/keeper"
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 callbacks of the price relay. Every channel
+// opened on the price relay port is subscribed to the exchange rates tallied
+// at the end of each vote period, and it can query the current exchange rates
+// at any time by sending a query packet. Channels can only be opened on the
+// connections allowed by the module parameters, up to their maximum number of
+// subscriptions.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// validateChannelParams checks that a price relay channel is unordered and
+// bound to the price relay port.
+func (im IBCModule) validateChannelParams(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	portID string,
+) error {
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s",
+			channeltypes.UNORDERED, order)
+	}
+
+	boundPort := im.keeper.GetPort(ctx)
+	if boundPort != portID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = types.Version
+	}
+	if version != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+	if err := im.keeper.ValidateConnection(ctx, connectionHops); err != nil {
+		return "", err
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
+			counterpartyVersion, types.Version)
+	}
+	if err := im.keeper.ValidateConnection(ctx, connectionHops); err != nil {
+		return "", err
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface. The channel is subscribed
+// to the price relay once the handshake completes.
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	_ string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s",
+			counterpartyVersion, types.Version)
+	}
+
+	return im.keeper.Subscribe(ctx, portID, channelID)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface. The channel is
+// subscribed to the price relay once the handshake completes.
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.keeper.Subscribe(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface. Closing a channel
+// cancels its subscription.
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	_,
+	channelID string,
+) error {
+	im.keeper.DeleteSubscription(ctx, channelID)
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface. Closing a channel
+// cancels its subscription.
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	_,
+	channelID string,
+) error {
+	im.keeper.DeleteSubscription(ctx, channelID)
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Query packets are
+// acknowledged with the requested exchange rates, while exchange rates
+// relayed by a counterparty price relay are emitted as events.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data types.PriceRelayPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			sdkerrors.Wrapf(types.ErrInvalidPacket, "cannot unmarshal packet data: %s", err),
+		)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	switch p := data.Packet.(type) {
+	case *types.PriceRelayPacketData_Query:
+		rates, err := im.keeper.ExchangeRates(ctx, p.Query.Denoms)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		return channeltypes.NewResultAcknowledgement(rates.GetBytes())
+
+	case *types.PriceRelayPacketData_ExchangeRates:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExchangeRatesPacket,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(p.ExchangeRates.BlockHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyRates, p.ExchangeRates.RatesString()),
+			),
+		)
+		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	}
+
+	return channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacket)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAcknowledgement,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	default:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAcknowledgement,
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(ack.GetResult())),
+			),
+		)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Timed out exchange rates
+// are stale by definition, so they are not resent. Instead, the channel is
+// unsubscribed, as its counterparty doesn't receive the exchange rates in time.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	var data types.PriceRelayPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidPacket, "cannot unmarshal packet data: %s", err)
+	}
+
+	unsubscribed := false
+	if _, ok := data.Packet.(*types.PriceRelayPacketData_ExchangeRates); ok &&
+		im.keeper.IsSubscribed(ctx, packet.GetSourceChannel()) {
+		im.keeper.DeleteSubscription(ctx, packet.GetSourceChannel())
+		unsubscribed = true
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyUnsubscribed, strconv.FormatBool(unsubscribed)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,365 @@
+package pricerelay_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/tests/util"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+	"github.com/umee-network/umee/v3/x/pricerelay"
+	"github.com/umee-network/umee/v3/x/pricerelay/keeper"
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+// testingApp wraps UmeeApp to prepare the first block after genesis for the
+// ibctesting validators:
+//   - ibctesting starts it with a zero header time, which x/leverage refuses
+//     as its last interest time, so it begins at the coordinator start time.
+//   - x/gravity requires an Ethereum address for every validator, which the
+//     genesis state cannot know in advance.
+type testingApp struct {
+	*umeeapp.UmeeApp
+}
+
+func (app testingApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if !req.Header.Time.IsZero() {
+		return app.UmeeApp.BeginBlock(req)
+	}
+
+	req.Header.Time = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	res := app.UmeeApp.BeginBlock(req)
+
+	ctx := app.NewContext(false, req.Header)
+	for _, val := range app.StakingKeeper.GetAllValidators(ctx) {
+		_, _, ethAddr, err := util.GenerateRandomEthKey()
+		if err != nil {
+			panic(err)
+		}
+		gravityEthAddr, err := gravitytypes.NewEthAddress(ethAddr.Hex())
+		if err != nil {
+			panic(err)
+		}
+
+		app.GravityKeeper.SetOrchestratorValidator(ctx, val.GetOperator(), sdk.AccAddress(val.GetOperator()))
+		app.GravityKeeper.SetEthAddressForValidator(ctx, val.GetOperator(), *gravityEthAddr)
+	}
+
+	return res
+}
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encConfig := umeeapp.MakeEncodingConfig()
+	app := umeeapp.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		umeeapp.DefaultNodeHome,
+		5,
+		encConfig,
+		umeeapp.EmptyAppOptions{},
+		umeeapp.GetWasmEnabledProposals(),
+		umeeapp.EmptyWasmOpts,
+	)
+
+	return testingApp{app}, umeeapp.NewDefaultGenesisState(encConfig.Codec)
+}
+
+type IBCModuleTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
+
+func (s *IBCModuleTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{s.path.EndpointA, s.path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	s.coordinator.Setup(s.path)
+}
+
+func umeeApp(chain *ibctesting.TestChain) *umeeapp.UmeeApp {
+	return chain.App.(testingApp).UmeeApp
+}
+
+// newPacket rebuilds the packet sent by the price relay of the source endpoint
+// in the current block.
+func (s *IBCModuleTestSuite) newPacket(
+	src, dst *ibctesting.Endpoint,
+	sequence uint64,
+	data types.PriceRelayPacketData,
+) channeltypes.Packet {
+	ctx := src.Chain.GetContext()
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		src.ChannelConfig.PortID,
+		src.ChannelID,
+		dst.ChannelConfig.PortID,
+		dst.ChannelID,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(types.PacketTimeout).UnixNano()),
+	)
+}
+
+func (s *IBCModuleTestSuite) TestSubscriptions() {
+	appA, appB := umeeApp(s.chainA), umeeApp(s.chainB)
+
+	s.Require().Equal(
+		[]string{s.path.EndpointA.ChannelID},
+		appA.PriceRelayKeeper.GetSubscriptions(s.chainA.GetContext()),
+	)
+	s.Require().Equal(
+		[]string{s.path.EndpointB.ChannelID},
+		appB.PriceRelayKeeper.GetSubscriptions(s.chainB.GetContext()),
+	)
+
+	// the subscriptions are queried
+	resp, err := keeper.NewQuerier(appA.PriceRelayKeeper).Subscriptions(
+		sdk.WrapSDKContext(s.chainA.GetContext()), &types.QuerySubscriptions{},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.path.EndpointA.ChannelID}, resp.ChannelIds)
+
+	// closing the channel cancels the subscription
+	s.Require().NoError(s.path.EndpointA.ChanCloseInit())
+	s.Require().Empty(appA.PriceRelayKeeper.GetSubscriptions(s.chainA.GetContext()))
+}
+
+// newChannelPath returns a path opening a new price relay channel on the
+// connection of the suite path.
+func (s *IBCModuleTestSuite) newChannelPath() *ibctesting.Path {
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	for i, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		existing := []*ibctesting.Endpoint{s.path.EndpointA, s.path.EndpointB}[i]
+		endpoint.ClientID = existing.ClientID
+		endpoint.ConnectionID = existing.ConnectionID
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	return path
+}
+
+func (s *IBCModuleTestSuite) TestSubscriptionParams() {
+	app := umeeApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ibcModule := pricerelay.NewIBCModule(app.PriceRelayKeeper)
+	portID, channelID := s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID
+	connectionHops := []string{s.path.EndpointA.ConnectionID}
+	counterparty := channeltypes.NewCounterparty(types.PortID, "")
+
+	// the connections are restricted by governance
+	params := types.DefaultParams()
+	params.AllowedConnections = []string{"connection-9"}
+	app.PriceRelayKeeper.SetParams(ctx, params)
+	_, err := ibcModule.OnChanOpenInit(
+		ctx, channeltypes.UNORDERED, connectionHops, portID, "channel-9", nil, counterparty, types.Version,
+	)
+	s.Require().ErrorIs(err, types.ErrConnectionNotAllowed)
+	_, err = ibcModule.OnChanOpenTry(
+		ctx, channeltypes.UNORDERED, connectionHops, portID, "channel-9", nil, counterparty, types.Version,
+	)
+	s.Require().ErrorIs(err, types.ErrConnectionNotAllowed)
+
+	// the number of subscriptions is limited, and checked again when the
+	// handshake completes
+	params = types.DefaultParams()
+	params.MaxSubscriptions = 1
+	app.PriceRelayKeeper.SetParams(ctx, params)
+	_, err = ibcModule.OnChanOpenInit(
+		ctx, channeltypes.UNORDERED, connectionHops, portID, "channel-9", nil, counterparty, types.Version,
+	)
+	s.Require().ErrorIs(err, types.ErrMaxSubscriptions)
+	s.Require().ErrorIs(ibcModule.OnChanOpenAck(ctx, portID, channelID, "", types.Version), types.ErrMaxSubscriptions)
+	s.Require().ErrorIs(ibcModule.OnChanOpenConfirm(ctx, portID, channelID), types.ErrMaxSubscriptions)
+
+	// the params are queried
+	resp, err := keeper.NewQuerier(app.PriceRelayKeeper).Params(sdk.WrapSDKContext(ctx), &types.QueryParams{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, resp.Params)
+
+	// channels are subscribed below the limit
+	params.MaxSubscriptions = 2
+	app.PriceRelayKeeper.SetParams(ctx, params)
+	s.coordinator.CommitBlock(s.chainA)
+	path := s.newChannelPath()
+	s.coordinator.CreateChannels(path)
+	s.Require().Equal(
+		[]string{s.path.EndpointA.ChannelID, path.EndpointA.ChannelID},
+		app.PriceRelayKeeper.GetSubscriptions(s.chainA.GetContext()),
+	)
+}
+
+func (s *IBCModuleTestSuite) TestTimeoutUnsubscribes() {
+	app := umeeApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	app.OracleKeeper.SetExchangeRate(ctx, "umee", sdk.MustNewDecFromStr("0.5"))
+	sequence, found := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(
+		ctx, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+	)
+	s.Require().True(found)
+	app.PriceRelayKeeper.RelayExchangeRates(ctx)
+
+	data := types.NewExchangeRatesPacket(types.NewExchangeRatesPacketData(
+		ctx.BlockHeight(),
+		[]oracletypes.ExchangeRateTuple{oracletypes.NewExchangeRateTuple("UMEE", sdk.MustNewDecFromStr("0.5"))},
+	))
+	packet := s.newPacket(s.path.EndpointA, s.path.EndpointB, sequence, data)
+	s.coordinator.CommitBlock(s.chainA)
+
+	// the counterparty doesn't receive the packet in time
+	s.coordinator.IncrementTimeBy(types.PacketTimeout + time.Minute)
+	s.coordinator.CommitBlock(s.chainB)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+
+	s.Require().False(app.PriceRelayKeeper.IsSubscribed(s.chainA.GetContext(), s.path.EndpointA.ChannelID))
+}
+
+func (s *IBCModuleTestSuite) TestRelayExchangeRates() {
+	app := umeeApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	app.OracleKeeper.SetExchangeRate(ctx, "umee", sdk.MustNewDecFromStr("0.5"))
+	app.OracleKeeper.SetExchangeRate(ctx, "atom", sdk.MustNewDecFromStr("12.25"))
+
+	sequence, found := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(
+		ctx, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+	)
+	s.Require().True(found)
+	app.PriceRelayKeeper.RelayExchangeRates(ctx)
+
+	data := types.NewExchangeRatesPacket(types.NewExchangeRatesPacketData(
+		ctx.BlockHeight(),
+		[]oracletypes.ExchangeRateTuple{
+			oracletypes.NewExchangeRateTuple("ATOM", sdk.MustNewDecFromStr("12.25")),
+			oracletypes.NewExchangeRateTuple("UMEE", sdk.MustNewDecFromStr("0.5")),
+		},
+	))
+	packet := s.newPacket(s.path.EndpointA, s.path.EndpointB, sequence, data)
+	s.coordinator.CommitBlock(s.chainA)
+
+	// the packet is received by the counterparty and acknowledged
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Nil(app.IBCKeeper.ChannelKeeper.GetPacketCommitment(
+		s.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+	))
+}
+
+func (s *IBCModuleTestSuite) TestRelayNoExchangeRates() {
+	app := umeeApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	portID, channelID := s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID
+
+	sequence, _ := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	app.PriceRelayKeeper.RelayExchangeRates(ctx)
+
+	next, _ := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	s.Require().Equal(sequence, next, "no packet is sent without exchange rates")
+}
+
+func (s *IBCModuleTestSuite) TestQueryExchangeRates() {
+	appA, appB := umeeApp(s.chainA), umeeApp(s.chainB)
+
+	testCases := []struct {
+		name     string
+		denoms   []string
+		expRates []oracletypes.ExchangeRateTuple
+		expErr   bool
+	}{
+		{
+			"all exchange rates",
+			nil,
+			[]oracletypes.ExchangeRateTuple{
+				oracletypes.NewExchangeRateTuple("ATOM", sdk.MustNewDecFromStr("12.25")),
+				oracletypes.NewExchangeRateTuple("UMEE", sdk.MustNewDecFromStr("0.5")),
+			},
+			false,
+		},
+		{
+			"single exchange rate",
+			[]string{"umee"},
+			[]oracletypes.ExchangeRateTuple{
+				oracletypes.NewExchangeRateTuple("UMEE", sdk.MustNewDecFromStr("0.5")),
+			},
+			false,
+		},
+		{
+			"unknown denom",
+			[]string{"umee", "foo"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctxB := s.chainB.GetContext()
+		msgServer := keeper.NewMsgServerImpl(appB.PriceRelayKeeper)
+		msg := types.NewMsgSendQuery(s.chainB.SenderAccount.GetAddress(), s.path.EndpointB.ChannelID, tc.denoms)
+		s.Require().NoError(msg.ValidateBasic(), tc.name)
+		resp, err := msgServer.SendQuery(sdk.WrapSDKContext(ctxB), msg)
+		s.Require().NoError(err, tc.name)
+		packet := s.newPacket(
+			s.path.EndpointB, s.path.EndpointA, resp.Sequence,
+			types.NewQueryPacket(types.NewQueryExchangeRatesPacketData(tc.denoms)),
+		)
+		s.coordinator.CommitBlock(s.chainB)
+		s.Require().NoError(s.path.EndpointA.UpdateClient(), tc.name)
+
+		// the exchange rates are only set during the block receiving the query,
+		// as the oracle clears them at the end of every vote period
+		ctxA := s.chainA.GetContext()
+		appA.OracleKeeper.SetExchangeRate(ctxA, "umee", sdk.MustNewDecFromStr("0.5"))
+		appA.OracleKeeper.SetExchangeRate(ctxA, "atom", sdk.MustNewDecFromStr("12.25"))
+
+		res, err := s.path.EndpointA.RecvPacketWithResult(packet)
+		s.Require().NoError(err, tc.name)
+		ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+		s.Require().NoError(err, tc.name)
+
+		var ack channeltypes.Acknowledgement
+		s.Require().NoError(types.ModuleCdc.UnmarshalJSON(ackBz, &ack), tc.name)
+		if tc.expErr {
+			s.Require().False(ack.Success(), tc.name)
+		} else {
+			s.Require().True(ack.Success(), tc.name)
+
+			var rates types.ExchangeRatesPacketData
+			s.Require().NoError(types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &rates), tc.name)
+			s.Require().Equal(tc.expRates, rates.ExchangeRates, tc.name)
+		}
+
+		s.Require().NoError(s.path.EndpointB.AcknowledgePacket(packet, ackBz), tc.name)
+	}
+}
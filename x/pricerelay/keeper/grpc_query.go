@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements a QueryServer for the x/pricerelay module.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) Params(
+	goCtx context.Context,
+	req *types.QueryParams,
+) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.Keeper.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) Subscriptions(
+	goCtx context.Context,
+	req *types.QuerySubscriptions,
+) (*types.QuerySubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channelIDs := q.Keeper.GetSubscriptions(ctx)
+
+	return &types.QuerySubscriptionsResponse{ChannelIds: channelIDs}, nil
+}
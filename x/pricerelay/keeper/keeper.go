@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+// Keeper of the pricerelay store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	paramSpace    paramstypes.Subspace
+	oracleKeeper  types.OracleKeeper
+	ics4Wrapper   types.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+}
+
+// NewKeeper constructs a new keeper for pricerelay module.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	paramSpace paramstypes.Subspace,
+	oracleKeeper types.OracleKeeper,
+	ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		oracleKeeper:  oracleKeeper,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of pricerelay parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of pricerelay parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsBound checks if the price relay module is already bound to the desired
+// port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the price relay module to the given port and claims the
+// returned capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the port ID the price relay module is bound to.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.KeyPort))
+}
+
+// SetPort sets the port ID the price relay module is bound to.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPort, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability
+// function.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability allows the price relay module to claim a capability that the
+// IBC module passes to it.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// IsSubscribed returns true if the given channel receives the tallied exchange
+// rates at the end of every vote period.
+func (k Keeper) IsSubscribed(ctx sdk.Context, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeySubscription(channelID))
+}
+
+// ValidateConnection returns an error if price relay channels can't be opened
+// on the given connection hops, or if no channel can be subscribed anymore.
+func (k Keeper) ValidateConnection(ctx sdk.Context, connectionHops []string) error {
+	params := k.GetParams(ctx)
+	for _, connectionID := range connectionHops {
+		if !params.IsConnectionAllowed(connectionID) {
+			return sdkerrors.Wrap(types.ErrConnectionNotAllowed, connectionID)
+		}
+	}
+
+	if uint32(len(k.GetSubscriptions(ctx))) >= params.MaxSubscriptions {
+		return sdkerrors.Wrapf(types.ErrMaxSubscriptions, "max subscriptions: %d", params.MaxSubscriptions)
+	}
+
+	return nil
+}
+
+// Subscribe subscribes a price relay channel once its handshake completes. It
+// checks the channel connection and the number of subscriptions again, as the
+// parameters or the subscriptions may have changed during the handshake.
+func (k Keeper) Subscribe(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	if err := k.ValidateConnection(ctx, channel.ConnectionHops); err != nil {
+		return err
+	}
+
+	k.SetSubscription(ctx, channelID)
+	return nil
+}
+
+// SetSubscription subscribes a channel to the price relay.
+func (k Keeper) SetSubscription(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeySubscription(channelID), []byte{})
+}
+
+// DeleteSubscription unsubscribes a channel from the price relay.
+func (k Keeper) DeleteSubscription(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeySubscription(channelID))
+}
+
+// IterateSubscriptions iterates over the channels subscribed to the price
+// relay. Iteration stops when the handler returns true.
+func (k Keeper) IterateSubscriptions(ctx sdk.Context, handler func(channelID string) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixSubscription)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		channelID := string(iter.Key()[len(types.KeyPrefixSubscription):])
+		if handler(channelID) {
+			break
+		}
+	}
+}
+
+// GetSubscriptions returns all the channels subscribed to the price relay.
+func (k Keeper) GetSubscriptions(ctx sdk.Context) []string {
+	var channels []string
+	k.IterateSubscriptions(ctx, func(channelID string) bool {
+		channels = append(channels, channelID)
+		return false
+	})
+	return channels
+}
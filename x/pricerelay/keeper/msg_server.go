@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of MsgServer for the
+// x/pricerelay module.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+func (s msgServer) SendQuery(
+	goCtx context.Context,
+	msg *types.MsgSendQuery,
+) (*types.MsgSendQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := s.keeper.SendQuery(ctx, msg.ChannelId, msg.Denoms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendQueryResponse{Sequence: sequence}, nil
+}
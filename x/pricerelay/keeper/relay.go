@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+// VotePeriod returns the oracle vote period, at the end of which the exchange
+// rates are relayed.
+func (k Keeper) VotePeriod(ctx sdk.Context) uint64 {
+	return k.oracleKeeper.VotePeriod(ctx)
+}
+
+// ExchangeRates returns the current exchange rates of the given symbol denoms,
+// or all the exchange rates when no denom is given.
+func (k Keeper) ExchangeRates(ctx sdk.Context, denoms []string) (types.ExchangeRatesPacketData, error) {
+	var rates []oracletypes.ExchangeRateTuple
+	if len(denoms) == 0 {
+		k.oracleKeeper.IterateExchangeRates(ctx, func(denom string, rate sdk.Dec) bool {
+			rates = append(rates, oracletypes.NewExchangeRateTuple(denom, rate))
+			return false
+		})
+	} else {
+		for _, denom := range denoms {
+			rate, err := k.oracleKeeper.GetExchangeRate(ctx, denom)
+			if err != nil {
+				return types.ExchangeRatesPacketData{}, err
+			}
+			rates = append(rates, oracletypes.NewExchangeRateTuple(strings.ToUpper(denom), rate))
+		}
+	}
+
+	return types.NewExchangeRatesPacketData(ctx.BlockHeight(), rates), nil
+}
+
+// RelayExchangeRates sends the current exchange rates to every subscribed
+// channel. Channels which fail to send are logged and skipped, so a single
+// misbehaving channel cannot halt the chain.
+func (k Keeper) RelayExchangeRates(ctx sdk.Context) {
+	rates, err := k.ExchangeRates(ctx, nil)
+	if err != nil || len(rates.ExchangeRates) == 0 {
+		return
+	}
+	data := types.NewExchangeRatesPacket(rates)
+
+	for _, channelID := range k.GetSubscriptions(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.sendPacket(cacheCtx, channelID, data); err != nil {
+			k.Logger(ctx).Error("failed to relay exchange rates", "channel", channelID, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExchangeRatesPacket,
+				sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(rates.BlockHeight, 10)),
+			),
+		)
+	}
+}
+
+// SendQuery requests the exchange rates of the given denoms from the
+// counterparty of a price relay channel. The rates are returned in the
+// packet acknowledgement. It returns the sequence of the sent packet.
+func (k Keeper) SendQuery(ctx sdk.Context, channelID string, denoms []string) (uint64, error) {
+	data := types.NewQueryPacket(types.NewQueryExchangeRatesPacketData(denoms))
+	if err := data.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.sendPacket(ctx, channelID, data)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryPacket,
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenoms, strings.Join(denoms, ",")),
+		),
+	)
+
+	return sequence, nil
+}
+
+// sendPacket sends the packet data over a channel bound to the price relay
+// port, with a timeout of types.PacketTimeout.
+func (k Keeper) sendPacket(ctx sdk.Context, channelID string, data types.PriceRelayPacketData) (uint64, error) {
+	portID := k.GetPort(ctx)
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, channelID,
+		)
+	}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		portID,
+		channelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(types.PacketTimeout).UnixNano()),
+	)
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Price relay sentinel errors
+var (
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1, "invalid price relay version")
+	ErrInvalidPacket        = sdkerrors.Register(ModuleName, 2, "invalid price relay packet")
+	ErrNoSubscription       = sdkerrors.Register(ModuleName, 3, "channel is not subscribed to the price relay")
+	ErrMaxSubscriptions     = sdkerrors.Register(ModuleName, 4, "maximum number of subscriptions reached")
+	ErrConnectionNotAllowed = sdkerrors.Register(ModuleName, 5, "connection not allowed by the price relay")
+)
@@ -0,0 +1,17 @@
+package types
+
+// Price relay module event types
+const (
+	EventTypeExchangeRatesPacket = "exchange_rates_packet"
+	EventTypeQueryPacket         = "query_exchange_rates_packet"
+	EventTypeAcknowledgement     = "pricerelay_acknowledgement"
+	EventTypeTimeout             = "pricerelay_timeout"
+
+	AttributeKeyChannel      = "channel"
+	AttributeKeyBlockHeight  = "block_height"
+	AttributeKeyRates        = "exchange_rates"
+	AttributeKeyDenoms       = "denoms"
+	AttributeKeyAckSuccess   = "success"
+	AttributeKeyAckError     = "error"
+	AttributeKeyUnsubscribed = "unsubscribed"
+)
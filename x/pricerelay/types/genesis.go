@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(portID string, subscriptions []string, params Params) *GenesisState {
+	return &GenesisState{
+		PortId:        portID,
+		Subscriptions: subscriptions,
+		Params:        params,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the x/pricerelay
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, nil, DefaultParams())
+}
+
+// ValidateGenesis validates the x/pricerelay genesis state.
+func ValidateGenesis(gs *GenesisState) error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Subscriptions))
+	for _, channelID := range gs.Subscriptions {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return err
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicate subscription of channel %s", channelID)
+		}
+		seen[channelID] = true
+	}
+	if len(gs.Subscriptions) > int(gs.Params.MaxSubscriptions) {
+		return fmt.Errorf("%d subscriptions exceed the maximum of %d", len(gs.Subscriptions), gs.Params.MaxSubscriptions)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	gs := DefaultGenesisState()
+	gs.Subscriptions = []string{"channel-0", "channel-0"}
+	require.ErrorContains(t, ValidateGenesis(gs), "duplicate subscription")
+
+	gs = DefaultGenesisState()
+	gs.Params.MaxSubscriptions = 1
+	gs.Subscriptions = []string{"channel-0", "channel-1"}
+	require.ErrorContains(t, ValidateGenesis(gs), "exceed the maximum")
+
+	gs = DefaultGenesisState()
+	gs.Params.AllowedConnections = []string{"c"}
+	require.Error(t, ValidateGenesis(gs))
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	"github.com/umee-network/umee/v3/util"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "pricerelay"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// PortID is the default port id the price relay module binds to
+	PortID = ModuleName
+
+	// Version defines the current version of the price relay channels
+	Version = "pricerelay-1"
+
+	// PacketTimeout is the relative timeout of the packets sent by the price
+	// relay. Exchange rates are only meaningful for a vote period, so stale
+	// packets are better timed out than delivered.
+	PacketTimeout = 10 * time.Minute
+)
+
+// KVStore key prefixes
+var (
+	KeyPort               = []byte{0x01}
+	KeyPrefixSubscription = []byte{0x02}
+)
+
+// KeySubscription returns the store key of a channel subscribed to the price
+// relay.
+func KeySubscription(channelID string) []byte {
+	return util.ConcatBytes(0, KeyPrefixSubscription, []byte(channelID))
+}
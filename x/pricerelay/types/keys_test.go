@@ -0,0 +1,16 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySubscription(t *testing.T) {
+	key := KeySubscription("channel-0")
+	require.Equal(t, append([]byte{0x02}, []byte("channel-0")...), key)
+	// building a key must not write into the prefix
+	KeySubscription("channel-1")
+	require.Equal(t, []byte{0x02}, KeyPrefixSubscription)
+	require.Equal(t, append([]byte{0x02}, []byte("channel-0")...), key)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/umee-network/umee/v3/util/checkers"
+)
+
+var _ sdk.Msg = &MsgSendQuery{}
+
+func NewMsgSendQuery(sender sdk.AccAddress, channelID string, denoms []string) *MsgSendQuery {
+	return &MsgSendQuery{
+		Sender:    sender.String(),
+		ChannelId: channelID,
+		Denoms:    denoms,
+	}
+}
+
+func (msg MsgSendQuery) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgSendQuery) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgSendQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+	return NewQueryExchangeRatesPacketData(msg.Denoms).ValidateBasic()
+}
+
+func (msg *MsgSendQuery) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Sender)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgSendQuery) GetSignBytes() []byte {
+	bz := AminoCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/v3/x/pricerelay/types"
+)
+
+func TestMsgSendQueryValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSendQuery
+		expErr string
+	}{
+		{"valid", types.NewMsgSendQuery(sender, "channel-0", []string{"ATOM"}), ""},
+		{"all denoms", types.NewMsgSendQuery(sender, "channel-0", nil), ""},
+		{"invalid sender", &types.MsgSendQuery{Sender: "xyz", ChannelId: "channel-0"}, "invalid address"},
+		{"invalid channel", types.NewMsgSendQuery(sender, "", nil), "identifier cannot be blank"},
+		{"empty denom", types.NewMsgSendQuery(sender, "channel-0", []string{""}), "empty query denom"},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == "" {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorContains(t, err, tc.expErr, tc.name)
+		}
+	}
+}
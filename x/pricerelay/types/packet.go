@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// NewExchangeRatesPacketData creates a new ExchangeRatesPacketData instance.
+func NewExchangeRatesPacketData(
+	blockHeight int64,
+	exchangeRates []oracletypes.ExchangeRateTuple,
+) ExchangeRatesPacketData {
+	return ExchangeRatesPacketData{
+		BlockHeight:   blockHeight,
+		ExchangeRates: exchangeRates,
+	}
+}
+
+// ValidateBasic performs a stateless validation of the exchange rates.
+func (d ExchangeRatesPacketData) ValidateBasic() error {
+	if d.BlockHeight <= 0 {
+		return ErrInvalidPacket.Wrapf("block height must be positive, got %d", d.BlockHeight)
+	}
+	for _, rate := range d.ExchangeRates {
+		if len(rate.Denom) == 0 {
+			return ErrInvalidPacket.Wrap("empty exchange rate denom")
+		}
+		if rate.ExchangeRate.IsNil() || !rate.ExchangeRate.IsPositive() {
+			return ErrInvalidPacket.Wrapf("invalid exchange rate of %s", rate.Denom)
+		}
+	}
+	return nil
+}
+
+// RatesString returns the exchange rates in the comma separated
+// "DENOM:rate" format accepted by oracle votes.
+func (d ExchangeRatesPacketData) RatesString() string {
+	rates := make([]string, len(d.ExchangeRates))
+	for i, rate := range d.ExchangeRates {
+		rates[i] = fmt.Sprintf("%s:%s", rate.Denom, rate.ExchangeRate)
+	}
+	return strings.Join(rates, ",")
+}
+
+// GetBytes returns the JSON encoding of the exchange rates, used as the
+// acknowledgement result of a query packet.
+func (d ExchangeRatesPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&d))
+}
+
+// NewQueryExchangeRatesPacketData creates a new QueryExchangeRatesPacketData
+// instance.
+func NewQueryExchangeRatesPacketData(denoms []string) QueryExchangeRatesPacketData {
+	return QueryExchangeRatesPacketData{Denoms: denoms}
+}
+
+// ValidateBasic performs a stateless validation of the requested denoms.
+func (d QueryExchangeRatesPacketData) ValidateBasic() error {
+	for _, denom := range d.Denoms {
+		if len(denom) == 0 {
+			return ErrInvalidPacket.Wrap("empty query denom")
+		}
+	}
+	return nil
+}
+
+// NewExchangeRatesPacket wraps the exchange rates into a price relay packet.
+func NewExchangeRatesPacket(d ExchangeRatesPacketData) PriceRelayPacketData {
+	return PriceRelayPacketData{
+		Packet: &PriceRelayPacketData_ExchangeRates{ExchangeRates: &d},
+	}
+}
+
+// NewQueryPacket wraps the exchange rates query into a price relay packet.
+func NewQueryPacket(d QueryExchangeRatesPacketData) PriceRelayPacketData {
+	return PriceRelayPacketData{
+		Packet: &PriceRelayPacketData_Query{Query: &d},
+	}
+}
+
+// ValidateBasic performs a stateless validation of the packet data.
+func (p PriceRelayPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *PriceRelayPacketData_ExchangeRates:
+		return packet.ExchangeRates.ValidateBasic()
+	case *PriceRelayPacketData_Query:
+		return packet.Query.ValidateBasic()
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPacket, "unknown packet type %T", packet)
+	}
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data, which is what
+// is sent over the wire.
+func (p PriceRelayPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	"gopkg.in/yaml.v3"
+)
+
+// Parameter keys
+var (
+	KeyMaxSubscriptions   = []byte("MaxSubscriptions")
+	KeyAllowedConnections = []byte("AllowedConnections")
+)
+
+// DefaultMaxSubscriptions defines the default maximum number of channels
+// subscribed to the price relay.
+const DefaultMaxSubscriptions = uint32(10)
+
+var _ paramstypes.ParamSet = &Params{}
+
+// DefaultParams creates default pricerelay module parameters: at most
+// DefaultMaxSubscriptions channels, on any connection.
+func DefaultParams() Params {
+	return Params{
+		MaxSubscriptions: DefaultMaxSubscriptions,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of pricerelay module's parameters.
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(
+			KeyMaxSubscriptions,
+			&p.MaxSubscriptions,
+			validateMaxSubscriptions,
+		),
+		paramstypes.NewParamSetPair(
+			KeyAllowedConnections,
+			&p.AllowedConnections,
+			validateAllowedConnections,
+		),
+	}
+}
+
+// String implements fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs basic validation on pricerelay parameters.
+func (p Params) Validate() error {
+	if err := validateMaxSubscriptions(p.MaxSubscriptions); err != nil {
+		return err
+	}
+	return validateAllowedConnections(p.AllowedConnections)
+}
+
+// IsConnectionAllowed returns true if price relay channels can be opened on
+// the given connection.
+func (p Params) IsConnectionAllowed(connectionID string) bool {
+	if len(p.AllowedConnections) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedConnections {
+		if allowed == connectionID {
+			return true
+		}
+	}
+	return false
+}
+
+func validateMaxSubscriptions(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAllowedConnections(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, connectionID := range v {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return err
+		}
+		if _, ok := seen[connectionID]; ok {
+			return fmt.Errorf("duplicate allowed connection: %s", connectionID)
+		}
+		seen[connectionID] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := Params{AllowedConnections: []string{"connection-0", "connection-1"}}
+	require.NoError(t, params.Validate())
+
+	params.AllowedConnections = []string{"c"}
+	require.Error(t, params.Validate())
+
+	params.AllowedConnections = []string{"connection-0", "connection-0"}
+	require.ErrorContains(t, params.Validate(), "duplicate allowed connection")
+}
+
+func TestParamsIsConnectionAllowed(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.IsConnectionAllowed("connection-0"))
+
+	params.AllowedConnections = []string{"connection-1"}
+	require.False(t, params.IsConnectionAllowed("connection-0"))
+	require.True(t, params.IsConnectionAllowed("connection-1"))
+}
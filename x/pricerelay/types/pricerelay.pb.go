@@ -0,0 +1,1091 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: umee/pricerelay/v1/pricerelay.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/umee-network/umee/v3/x/oracle/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the pricerelay module.
+type Params struct {
+	// max_subscriptions defines the maximum number of channels subscribed to
+	// the price relay. Opening a channel fails once it is reached.
+	MaxSubscriptions uint32 `protobuf:"varint,1,opt,name=max_subscriptions,json=maxSubscriptions,proto3" json:"max_subscriptions,omitempty" yaml:"max_subscriptions"`
+	// allowed_connections defines the connections price relay channels can be
+	// opened on. Channels can be opened on any connection when it is empty.
+	AllowedConnections []string `protobuf:"bytes,2,rep,name=allowed_connections,json=allowedConnections,proto3" json:"allowed_connections,omitempty" yaml:"allowed_connections"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f0006011ebfd64, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// PriceRelayPacketData defines the data carried by every packet sent over a
+// price relay channel.
+type PriceRelayPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*PriceRelayPacketData_ExchangeRates
+	//	*PriceRelayPacketData_Query
+	Packet isPriceRelayPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *PriceRelayPacketData) Reset()         { *m = PriceRelayPacketData{} }
+func (m *PriceRelayPacketData) String() string { return proto.CompactTextString(m) }
+func (*PriceRelayPacketData) ProtoMessage()    {}
+func (*PriceRelayPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f0006011ebfd64, []int{1}
+}
+func (m *PriceRelayPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceRelayPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceRelayPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceRelayPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRelayPacketData.Merge(m, src)
+}
+func (m *PriceRelayPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceRelayPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRelayPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRelayPacketData proto.InternalMessageInfo
+
+type isPriceRelayPacketData_Packet interface {
+	isPriceRelayPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type PriceRelayPacketData_ExchangeRates struct {
+	ExchangeRates *ExchangeRatesPacketData `protobuf:"bytes,1,opt,name=exchange_rates,json=exchangeRates,proto3,oneof" json:"exchange_rates,omitempty"`
+}
+type PriceRelayPacketData_Query struct {
+	Query *QueryExchangeRatesPacketData `protobuf:"bytes,2,opt,name=query,proto3,oneof" json:"query,omitempty"`
+}
+
+func (*PriceRelayPacketData_ExchangeRates) isPriceRelayPacketData_Packet() {}
+func (*PriceRelayPacketData_Query) isPriceRelayPacketData_Packet()         {}
+
+func (m *PriceRelayPacketData) GetPacket() isPriceRelayPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *PriceRelayPacketData) GetExchangeRates() *ExchangeRatesPacketData {
+	if x, ok := m.GetPacket().(*PriceRelayPacketData_ExchangeRates); ok {
+		return x.ExchangeRates
+	}
+	return nil
+}
+
+func (m *PriceRelayPacketData) GetQuery() *QueryExchangeRatesPacketData {
+	if x, ok := m.GetPacket().(*PriceRelayPacketData_Query); ok {
+		return x.Query
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PriceRelayPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PriceRelayPacketData_ExchangeRates)(nil),
+		(*PriceRelayPacketData_Query)(nil),
+	}
+}
+
+// ExchangeRatesPacketData carries the exchange rates tallied by the oracle
+// module at the given block height. It is pushed to every subscribed channel
+// at the end of each vote period, and it is returned in the acknowledgement of
+// a QueryExchangeRatesPacketData.
+type ExchangeRatesPacketData struct {
+	BlockHeight   int64                     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ExchangeRates []types.ExchangeRateTuple `protobuf:"bytes,2,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
+}
+
+func (m *ExchangeRatesPacketData) Reset()         { *m = ExchangeRatesPacketData{} }
+func (m *ExchangeRatesPacketData) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRatesPacketData) ProtoMessage()    {}
+func (*ExchangeRatesPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f0006011ebfd64, []int{2}
+}
+func (m *ExchangeRatesPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRatesPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRatesPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRatesPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRatesPacketData.Merge(m, src)
+}
+func (m *ExchangeRatesPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRatesPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRatesPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRatesPacketData proto.InternalMessageInfo
+
+// QueryExchangeRatesPacketData requests the current exchange rates of the
+// given symbol denoms. All exchange rates are returned when denoms is empty.
+type QueryExchangeRatesPacketData struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryExchangeRatesPacketData) Reset()         { *m = QueryExchangeRatesPacketData{} }
+func (m *QueryExchangeRatesPacketData) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRatesPacketData) ProtoMessage()    {}
+func (*QueryExchangeRatesPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f0006011ebfd64, []int{3}
+}
+func (m *QueryExchangeRatesPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRatesPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRatesPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRatesPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRatesPacketData.Merge(m, src)
+}
+func (m *QueryExchangeRatesPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRatesPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRatesPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRatesPacketData proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "umee.pricerelay.v1.Params")
+	proto.RegisterType((*PriceRelayPacketData)(nil), "umee.pricerelay.v1.PriceRelayPacketData")
+	proto.RegisterType((*ExchangeRatesPacketData)(nil), "umee.pricerelay.v1.ExchangeRatesPacketData")
+	proto.RegisterType((*QueryExchangeRatesPacketData)(nil), "umee.pricerelay.v1.QueryExchangeRatesPacketData")
+}
+
+func init() {
+	proto.RegisterFile("umee/pricerelay/v1/pricerelay.proto", fileDescriptor_30f0006011ebfd64)
+}
+
+var fileDescriptor_30f0006011ebfd64 = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x0d, 0x44, 0xed, 0x85, 0x22, 0x38, 0x2a, 0x88, 0x42, 0x65, 0xb7, 0x66, 0x89,
+	0x84, 0xb0, 0x69, 0x2a, 0x31, 0x74, 0x34, 0x20, 0x85, 0x05, 0x82, 0xe9, 0xc4, 0x12, 0x5d, 0xae,
+	0x4f, 0x8e, 0x15, 0xdb, 0x67, 0xce, 0xe7, 0x34, 0xf9, 0x0e, 0x0c, 0x8c, 0x8c, 0xfd, 0x04, 0x7c,
+	0x06, 0xc6, 0x8c, 0x1d, 0x99, 0x22, 0x48, 0x16, 0xe6, 0x7e, 0x02, 0x74, 0x77, 0x91, 0x6a, 0x35,
+	0x0d, 0xdb, 0xbd, 0xff, 0xfb, 0xeb, 0xa7, 0xf7, 0x7f, 0xf7, 0xf0, 0xb3, 0x32, 0x05, 0xf0, 0x73,
+	0x11, 0x33, 0x10, 0x90, 0xd0, 0xa9, 0x3f, 0x3e, 0xaa, 0x54, 0x5e, 0x2e, 0xb8, 0xe4, 0x84, 0x28,
+	0x93, 0x57, 0x91, 0xc7, 0x47, 0xad, 0xbd, 0x88, 0x47, 0x5c, 0xb7, 0x7d, 0xf5, 0x32, 0xce, 0xd6,
+	0x53, 0x8d, 0xe3, 0x82, 0xb2, 0x04, 0x14, 0xca, 0xbc, 0x4c, 0xd3, 0xfd, 0x81, 0x70, 0xbd, 0x47,
+	0x05, 0x4d, 0x0b, 0xf2, 0x0e, 0x3f, 0x4c, 0xe9, 0xa4, 0x5f, 0x94, 0x83, 0x82, 0x89, 0x38, 0x97,
+	0x31, 0xcf, 0x8a, 0x26, 0x3a, 0x40, 0xed, 0xdd, 0x60, 0xff, 0x6a, 0xee, 0x34, 0xa7, 0x34, 0x4d,
+	0x4e, 0xdc, 0x35, 0x8b, 0x1b, 0x3e, 0x48, 0xe9, 0xe4, 0x53, 0x55, 0x22, 0x1f, 0xf0, 0x23, 0x9a,
+	0x24, 0xfc, 0x1c, 0xce, 0xfa, 0x8c, 0x67, 0x19, 0x30, 0x03, 0xdb, 0x3a, 0xa8, 0xb5, 0x77, 0x02,
+	0xfb, 0x6a, 0xee, 0xb4, 0x0c, 0xec, 0x16, 0x93, 0x1b, 0x92, 0x95, 0xfa, 0xfa, 0x5a, 0x3c, 0xd9,
+	0xfe, 0x7e, 0xe1, 0x58, 0x7f, 0x2f, 0x1c, 0xe4, 0xfe, 0x44, 0x78, 0xaf, 0xa7, 0x52, 0x87, 0x2a,
+	0x75, 0x8f, 0xb2, 0x11, 0xc8, 0x37, 0x54, 0x52, 0x72, 0x8a, 0xef, 0xc3, 0x84, 0x0d, 0x69, 0x16,
+	0x41, 0x5f, 0x50, 0x09, 0x66, 0xf6, 0x46, 0xe7, 0xb9, 0xb7, 0xbe, 0x29, 0xef, 0xed, 0xca, 0x19,
+	0x2a, 0xe3, 0x35, 0xa4, 0x6b, 0x85, 0xbb, 0x50, 0x6d, 0x91, 0x2e, 0xbe, 0xfb, 0xa5, 0x04, 0x31,
+	0x6d, 0x6e, 0x69, 0xd8, 0xcb, 0xdb, 0x60, 0x1f, 0x95, 0x61, 0x33, 0xd1, 0x00, 0x82, 0x6d, 0x5c,
+	0xcf, 0xb5, 0xec, 0x7e, 0x45, 0xf8, 0xc9, 0x06, 0x3b, 0x39, 0xc4, 0xf7, 0x06, 0x09, 0x67, 0xa3,
+	0xfe, 0x10, 0xe2, 0x68, 0x28, 0x75, 0x86, 0x5a, 0xd8, 0xd0, 0x5a, 0x57, 0x4b, 0xe4, 0xfd, 0x5a,
+	0x50, 0xb5, 0xd7, 0x46, 0xe7, 0xd0, 0xcc, 0xb6, 0xfa, 0xde, 0x1b, 0x21, 0x4f, 0xcb, 0x3c, 0x81,
+	0xe0, 0xce, 0x6c, 0xee, 0xdc, 0x8c, 0xe8, 0xbe, 0xc2, 0xfb, 0xff, 0x4b, 0x40, 0x1e, 0xe3, 0xfa,
+	0x19, 0x64, 0x3c, 0x55, 0x0b, 0xad, 0xb5, 0x77, 0xc2, 0x55, 0x15, 0xf4, 0x66, 0x7f, 0x6c, 0x6b,
+	0xb6, 0xb0, 0xd1, 0xe5, 0xc2, 0x46, 0xbf, 0x17, 0x36, 0xfa, 0xb6, 0xb4, 0xad, 0xcb, 0xa5, 0x6d,
+	0xfd, 0x5a, 0xda, 0xd6, 0xe7, 0x4e, 0x14, 0xcb, 0x61, 0x39, 0xf0, 0x18, 0x4f, 0x7d, 0x35, 0xd7,
+	0x8b, 0x0c, 0xe4, 0x39, 0x17, 0x23, 0x5d, 0xf8, 0xe3, 0x63, 0x7f, 0x52, 0xbd, 0x70, 0x39, 0xcd,
+	0xa1, 0x18, 0xd4, 0xf5, 0x4d, 0x1e, 0xff, 0x1b, 0x00, 0x6c, 0x50, 0x00, 0x84, 0x01, 0x03, 0x00,
+	0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxSubscriptions != that1.MaxSubscriptions {
+		return false
+	}
+	if len(this.AllowedConnections) != len(that1.AllowedConnections) {
+		return false
+	}
+	for i := range this.AllowedConnections {
+		if this.AllowedConnections[i] != that1.AllowedConnections[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedConnections) > 0 {
+		for iNdEx := len(m.AllowedConnections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedConnections[iNdEx])
+			copy(dAtA[i:], m.AllowedConnections[iNdEx])
+			i = encodeVarintPricerelay(dAtA, i, uint64(len(m.AllowedConnections[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxSubscriptions != 0 {
+		i = encodeVarintPricerelay(dAtA, i, uint64(m.MaxSubscriptions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRelayPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRelayPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRelayPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceRelayPacketData_ExchangeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRelayPacketData_ExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExchangeRates != nil {
+		{
+			size, err := m.ExchangeRates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPricerelay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PriceRelayPacketData_Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRelayPacketData_Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPricerelay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ExchangeRatesPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRatesPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRatesPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPricerelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintPricerelay(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintPricerelay(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPricerelay(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPricerelay(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSubscriptions != 0 {
+		n += 1 + sovPricerelay(uint64(m.MaxSubscriptions))
+	}
+	if len(m.AllowedConnections) > 0 {
+		for _, s := range m.AllowedConnections {
+			l = len(s)
+			n += 1 + l + sovPricerelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRelayPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *PriceRelayPacketData_ExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExchangeRates != nil {
+		l = m.ExchangeRates.Size()
+		n += 1 + l + sovPricerelay(uint64(l))
+	}
+	return n
+}
+func (m *PriceRelayPacketData_Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovPricerelay(uint64(l))
+	}
+	return n
+}
+func (m *ExchangeRatesPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovPricerelay(uint64(m.BlockHeight))
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovPricerelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovPricerelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPricerelay(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPricerelay(x uint64) (n int) {
+	return sovPricerelay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPricerelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptions", wireType)
+			}
+			m.MaxSubscriptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscriptions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedConnections = append(m.AllowedConnections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPricerelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceRelayPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPricerelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceRelayPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceRelayPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExchangeRatesPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &PriceRelayPacketData_ExchangeRates{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryExchangeRatesPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &PriceRelayPacketData_Query{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPricerelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRatesPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPricerelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRatesPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRatesPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPricerelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPricerelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPricerelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPricerelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPricerelay(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPricerelay
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPricerelay
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPricerelay
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPricerelay
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPricerelay
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPricerelay        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPricerelay          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPricerelay = fmt.Errorf("proto: unexpected end of group")
+)
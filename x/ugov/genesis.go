@@ -0,0 +1,19 @@
+package ugov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v3/x/ugov/keeper"
+	"github.com/umee-network/umee/v3/x/ugov/types"
+)
+
+// InitGenesis initializes the x/ugov module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisState) {
+	keeper.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the x/ugov module's exported genesis state.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx))
+}
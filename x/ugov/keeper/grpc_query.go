@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umee-network/umee/v3/x/ugov/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements a QueryServer for the x/ugov module.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) Params(
+	goCtx context.Context,
+	req *types.QueryParams,
+) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.Keeper.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) MsgPolicy(
+	goCtx context.Context,
+	req *types.QueryMsgPolicy,
+) (*types.QueryMsgPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.TypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty type url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, ok := q.Keeper.MsgPolicies(ctx)[req.TypeUrl]
+	if !ok {
+		policy = types.MsgPolicy{TypeUrl: req.TypeUrl}
+	}
+
+	return &types.QueryMsgPolicyResponse{Policy: policy}, nil
+}
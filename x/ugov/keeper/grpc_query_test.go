@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/v3/app"
+	"github.com/umee-network/umee/v3/x/ugov/keeper"
+	"github.com/umee-network/umee/v3/x/ugov/types"
+)
+
+func TestQuerier(t *testing.T) {
+	app := umeeapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	querier := keeper.NewQuerier(app.UGovKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	params, err := querier.Params(goCtx, &types.QueryParams{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params.Params)
+
+	vote := "/umee.oracle.v1.MsgAggregateExchangeRateVote"
+	policy, err := querier.MsgPolicy(goCtx, &types.QueryMsgPolicy{TypeUrl: vote})
+	require.NoError(t, err)
+	require.Equal(t, types.MsgPolicy{
+		TypeUrl:  vote,
+		FeeFree:  true,
+		Priority: types.DefaultFeeFreePriority,
+		MaxGas:   types.DefaultMaxFeeFreeMsgGas,
+	}, policy.Policy)
+
+	// the messages without a policy have an empty one
+	send := "/cosmos.bank.v1beta1.MsgSend"
+	policy, err = querier.MsgPolicy(goCtx, &types.QueryMsgPolicy{TypeUrl: send})
+	require.NoError(t, err)
+	require.Equal(t, types.MsgPolicy{TypeUrl: send}, policy.Policy)
+
+	_, err = querier.MsgPolicy(goCtx, &types.QueryMsgPolicy{})
+	require.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/umee-network/umee/v3/x/ugov/types"
+)
+
+// Keeper of the ugov params
+type Keeper struct {
+	paramSpace paramstypes.Subspace
+}
+
+// NewKeeper constructs a new keeper for ugov module.
+func NewKeeper(paramSpace paramstypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+	}
+}
+
+// GetParams returns the total set of ugov parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of ugov parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// MsgPolicies returns the fee and priority policies of the messages, indexed
+// by type URL.
+func (k Keeper) MsgPolicies(ctx sdk.Context) map[string]types.MsgPolicy {
+	var policies []types.MsgPolicy
+	k.paramSpace.Get(ctx, types.KeyMsgPolicies, &policies)
+	return types.Params{MsgPolicies: policies}.MsgPoliciesByType()
+}
+
+// FeeTokenPremium returns the premium paid on the fees paid in leverage tokens
+// and uTokens, and false if they can't pay fees.
+func (k Keeper) FeeTokenPremium(ctx sdk.Context) (sdk.Dec, bool) {
+	var enabled bool
+	k.paramSpace.Get(ctx, types.KeyLeverageFeeTokens, &enabled)
+	if !enabled {
+		return sdk.ZeroDec(), false
+	}
+
+	var premium sdk.Dec
+	k.paramSpace.Get(ctx, types.KeyFeeTokenPremium, &premium)
+	return premium, true
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	gbtypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v3"
+
+	leveragetypes "github.com/umee-network/umee/v3/x/leverage/types"
+	oracletypes "github.com/umee-network/umee/v3/x/oracle/types"
+)
+
+// Parameter keys
+var (
+	KeyMsgPolicies       = []byte("MsgPolicies")
+	KeyLeverageFeeTokens = []byte("LeverageFeeTokens")
+	KeyFeeTokenPremium   = []byte("FeeTokenPremium")
+)
+
+// Default parameter values
+const (
+	// DefaultMaxFeeFreeMsgGas defines the gas a fee free message can use by
+	// default, enough for an oracle vote.
+	DefaultMaxFeeFreeMsgGas = uint64(140_000)
+	// DefaultFeeFreePriority defines the priority of the fee free messages.
+	DefaultFeeFreePriority = 100
+)
+
+// DefaultFeeTokenPremium defines the default premium paid on fees in leverage
+// tokens and uTokens.
+var DefaultFeeTokenPremium = sdk.MustNewDecFromStr("0.1")
+
+var _ paramstypes.ParamSet = &Params{}
+
+// DefaultParams creates default ugov module parameters: the oracle and Gravity
+// Bridge orchestrator messages are fee free and have the highest priority,
+// followed by evidence and liquidations. Fees can be paid in leverage tokens
+// and uTokens with a 10% premium.
+func DefaultParams() Params {
+	feeFree := []sdk.Msg{
+		&oracletypes.MsgAggregateExchangeRatePrevote{},
+		&oracletypes.MsgAggregateExchangeRateVote{},
+		&gbtypes.MsgValsetConfirm{},
+		&gbtypes.MsgConfirmBatch{},
+		&gbtypes.MsgERC20DeployedClaim{},
+		&gbtypes.MsgConfirmLogicCall{},
+		&gbtypes.MsgLogicCallExecutedClaim{},
+		&gbtypes.MsgSendToCosmosClaim{},
+		&gbtypes.MsgExecuteIbcAutoForwards{},
+		&gbtypes.MsgBatchSendToEthClaim{},
+		&gbtypes.MsgValsetUpdatedClaim{},
+		&gbtypes.MsgSubmitBadSignatureEvidence{},
+	}
+
+	policies := make([]MsgPolicy, 0, len(feeFree)+2)
+	for _, msg := range feeFree {
+		policies = append(policies, MsgPolicy{
+			TypeUrl:  sdk.MsgTypeURL(msg),
+			FeeFree:  true,
+			Priority: DefaultFeeFreePriority,
+			MaxGas:   DefaultMaxFeeFreeMsgGas,
+		})
+	}
+	policies = append(policies,
+		MsgPolicy{TypeUrl: sdk.MsgTypeURL(&evidencetypes.MsgSubmitEvidence{}), Priority: 90},
+		MsgPolicy{TypeUrl: sdk.MsgTypeURL(&leveragetypes.MsgLiquidate{}), Priority: 80},
+	)
+
+	return Params{
+		MsgPolicies:       policies,
+		LeverageFeeTokens: false,
+		FeeTokenPremium:   DefaultFeeTokenPremium,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of ugov module's parameters.
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(
+			KeyMsgPolicies,
+			&p.MsgPolicies,
+			validateMsgPolicies,
+		),
+		paramstypes.NewParamSetPair(
+			KeyLeverageFeeTokens,
+			&p.LeverageFeeTokens,
+			validateBool,
+		),
+		paramstypes.NewParamSetPair(
+			KeyFeeTokenPremium,
+			&p.FeeTokenPremium,
+			validateFeeTokenPremium,
+		),
+	}
+}
+
+// String implements fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs basic validation on ugov parameters.
+func (p Params) Validate() error {
+	if err := validateMsgPolicies(p.MsgPolicies); err != nil {
+		return err
+	}
+	return validateFeeTokenPremium(p.FeeTokenPremium)
+}
+
+// MsgPoliciesByType returns the message policies indexed by type URL.
+func (p Params) MsgPoliciesByType() map[string]MsgPolicy {
+	policies := make(map[string]MsgPolicy, len(p.MsgPolicies))
+	for _, policy := range p.MsgPolicies {
+		policies[policy.TypeUrl] = policy
+	}
+	return policies
+}
+
+func validateMsgPolicies(i interface{}) error {
+	v, ok := i.([]MsgPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	typeURLs := make(map[string]struct{}, len(v))
+	for _, policy := range v {
+		if !strings.HasPrefix(policy.TypeUrl, "/") || len(policy.TypeUrl) == 1 {
+			return fmt.Errorf("msg policy type url must start with /: %q", policy.TypeUrl)
+		}
+		if _, ok := typeURLs[policy.TypeUrl]; ok {
+			return fmt.Errorf("duplicate msg policy: %s", policy.TypeUrl)
+		}
+		typeURLs[policy.TypeUrl] = struct{}{}
+
+		if policy.Priority < 0 {
+			return fmt.Errorf("msg policy priority must not be negative: %s", policy.TypeUrl)
+		}
+		if policy.FeeFree && policy.MaxGas == 0 {
+			return fmt.Errorf("fee free msg policy must have a positive max gas: %s", policy.TypeUrl)
+		}
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeTokenPremium(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("fee token premium must not be negative: %s", v)
+	}
+
+	return nil
+}
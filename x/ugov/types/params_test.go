@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	tcs := []struct {
+		name   string
+		policy MsgPolicy
+		errMsg string
+	}{
+		{"empty type url", MsgPolicy{}, "must start with /"},
+		{"no slash", MsgPolicy{TypeUrl: "umee.oracle.v1.MsgAggregateExchangeRateVote"}, "must start with /"},
+		{"negative priority", MsgPolicy{TypeUrl: "/umee.Msg", Priority: -1}, "must not be negative"},
+		{"fee free without gas", MsgPolicy{TypeUrl: "/umee.Msg", FeeFree: true}, "positive max gas"},
+	}
+	for _, tc := range tcs {
+		params := Params{MsgPolicies: []MsgPolicy{tc.policy}}
+		require.ErrorContains(t, params.Validate(), tc.errMsg, tc.name)
+	}
+
+	params := DefaultParams()
+	params.MsgPolicies = append(params.MsgPolicies, params.MsgPolicies[0])
+	require.ErrorContains(t, params.Validate(), "duplicate msg policy")
+
+	params = DefaultParams()
+	params.FeeTokenPremium = sdk.MustNewDecFromStr("-0.1")
+	require.ErrorContains(t, params.Validate(), "fee token premium must not be negative")
+}
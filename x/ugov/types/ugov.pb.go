@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: umee/ugov/v1/ugov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the ugov module.
+type Params struct {
+	// msg_policies defines the fee and priority policies of messages. The
+	// messages without a policy pay fees and have no priority.
+	MsgPolicies []MsgPolicy `protobuf:"bytes,1,rep,name=msg_policies,json=msgPolicies,proto3" json:"msg_policies" yaml:"msg_policies"`
+	// leverage_fee_tokens allows paying transaction fees in any x/leverage
+	// registered token or uToken, instead of the denoms of the minimum gas
+	// prices only.
+	LeverageFeeTokens bool `protobuf:"varint,2,opt,name=leverage_fee_tokens,json=leverageFeeTokens,proto3" json:"leverage_fee_tokens,omitempty" yaml:"leverage_fee_tokens"`
+	// fee_token_premium defines the premium paid on the x/oracle value of the
+	// required fees when they are paid in a leverage token or uToken, e.g. 0.1
+	// requires 10% more value than the native fees.
+	FeeTokenPremium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_token_premium,json=feeTokenPremium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_token_premium" yaml:"fee_token_premium"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75ef21394c8e122, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// MsgPolicy defines how the ante handler treats a message type.
+type MsgPolicy struct {
+	// type_url defines the message type, e.g.
+	// "/umee.oracle.v1.MsgAggregateExchangeRateVote".
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// fee_free exempts the transactions containing only fee free messages from
+	// fees, as long as their gas limit doesn't exceed the sum of the max_gas of
+	// their messages.
+	FeeFree bool `protobuf:"varint,2,opt,name=fee_free,json=feeFree,proto3" json:"fee_free,omitempty" yaml:"fee_free"`
+	// priority defines the priority of the transactions containing the message.
+	// The priority of a transaction is the lowest priority of its messages.
+	Priority int64 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty" yaml:"priority"`
+	// max_gas defines the gas a fee free message can use.
+	MaxGas uint64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *MsgPolicy) Reset()         { *m = MsgPolicy{} }
+func (m *MsgPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgPolicy) ProtoMessage()    {}
+func (*MsgPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75ef21394c8e122, []int{1}
+}
+func (m *MsgPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPolicy.Merge(m, src)
+}
+func (m *MsgPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPolicy proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "umee.ugov.v1.Params")
+	proto.RegisterType((*MsgPolicy)(nil), "umee.ugov.v1.MsgPolicy")
+}
+
+func init() { proto.RegisterFile("umee/ugov/v1/ugov.proto", fileDescriptor_b75ef21394c8e122) }
+
+var fileDescriptor_b75ef21394c8e122 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6a, 0xdb, 0x30,
+	0x18, 0xc7, 0xad, 0x26, 0x24, 0xa9, 0x5a, 0x56, 0xea, 0x0c, 0x6a, 0x3a, 0xb0, 0x82, 0x0e, 0x23,
+	0xb0, 0xd5, 0xa6, 0xeb, 0x2d, 0x47, 0x33, 0x3a, 0x28, 0x6c, 0x04, 0xb3, 0x31, 0xd8, 0xc5, 0xb8,
+	0xd9, 0x17, 0xcf, 0xc4, 0xaa, 0x8c, 0x64, 0x7b, 0xc9, 0x5b, 0xec, 0xb8, 0x63, 0xdf, 0x61, 0x2f,
+	0x91, 0xdb, 0x7a, 0x1c, 0x3b, 0x98, 0x2d, 0xb9, 0xec, 0xec, 0x27, 0x18, 0x92, 0x9d, 0x60, 0xd8,
+	0x4e, 0x92, 0xbf, 0xff, 0xcf, 0xff, 0x4f, 0xfa, 0xeb, 0xc3, 0x67, 0x39, 0x03, 0x70, 0xf3, 0x88,
+	0x17, 0x6e, 0x71, 0xa9, 0x57, 0x27, 0x15, 0x3c, 0xe3, 0xe6, 0xb1, 0x12, 0x1c, 0x5d, 0x28, 0x2e,
+	0xcf, 0x1f, 0x47, 0x3c, 0xe2, 0x5a, 0x70, 0xd5, 0xae, 0x66, 0xe8, 0xb7, 0x03, 0xdc, 0x9b, 0x86,
+	0x22, 0x64, 0xd2, 0x7c, 0x8f, 0x8f, 0x99, 0x8c, 0x82, 0x94, 0x27, 0xf1, 0x2c, 0x06, 0x69, 0xa1,
+	0x51, 0x67, 0x7c, 0xf4, 0xe2, 0xcc, 0x69, 0xbb, 0x38, 0xaf, 0x65, 0x34, 0x55, 0xc0, 0xca, 0x7b,
+	0xb2, 0x2e, 0x89, 0x51, 0x95, 0x64, 0xb8, 0x0a, 0x59, 0x32, 0xa1, 0xed, 0x5f, 0xa9, 0x7f, 0xc4,
+	0x1a, 0x2e, 0x06, 0x69, 0xbe, 0xc1, 0xc3, 0x04, 0x0a, 0x10, 0x61, 0x04, 0xc1, 0x1c, 0x20, 0xc8,
+	0xf8, 0x02, 0xee, 0xa4, 0x75, 0x30, 0x42, 0xe3, 0x81, 0x67, 0x57, 0x25, 0x39, 0xaf, 0x2d, 0xfe,
+	0x03, 0x51, 0xff, 0x74, 0x57, 0xbd, 0x06, 0x78, 0xab, 0x6b, 0x66, 0x81, 0x4f, 0xf7, 0x44, 0x90,
+	0x0a, 0x60, 0x71, 0xce, 0xac, 0xce, 0x08, 0x8d, 0x0f, 0xbd, 0x1b, 0x75, 0xa8, 0x9f, 0x25, 0x79,
+	0x1a, 0xc5, 0xd9, 0xa7, 0xfc, 0xd6, 0x99, 0x71, 0xe6, 0xce, 0xb8, 0x64, 0x5c, 0x36, 0xcb, 0x85,
+	0xfc, 0xb8, 0x70, 0xb3, 0x55, 0x0a, 0xd2, 0x79, 0x09, 0xb3, 0xaa, 0x24, 0x56, 0xdd, 0xfb, 0x1f,
+	0x43, 0xea, 0x9f, 0xcc, 0x9b, 0x8e, 0xd3, 0xba, 0x32, 0x19, 0x7c, 0xbd, 0x27, 0xc6, 0x9f, 0x7b,
+	0x82, 0xe8, 0x77, 0x84, 0x0f, 0xf7, 0x49, 0x98, 0x0e, 0x1e, 0x28, 0xc7, 0x20, 0x17, 0x89, 0x85,
+	0xf4, 0x31, 0x86, 0x55, 0x49, 0x4e, 0x6a, 0xe3, 0x9d, 0x42, 0xfd, 0xbe, 0xda, 0xbe, 0x13, 0x89,
+	0xe2, 0x55, 0xbb, 0xb9, 0x00, 0x68, 0x42, 0x68, 0xf1, 0x3b, 0x85, 0xfa, 0xfd, 0x39, 0xc0, 0xb5,
+	0x00, 0x30, 0x5d, 0x3c, 0x48, 0x45, 0xcc, 0x45, 0x9c, 0xad, 0xf4, 0x35, 0x3b, 0x6d, 0x7e, 0xa7,
+	0x50, 0x7f, 0x0f, 0x99, 0xcf, 0x70, 0x9f, 0x85, 0xcb, 0x20, 0x0a, 0xa5, 0xd5, 0x1d, 0xa1, 0x71,
+	0xd7, 0x33, 0xab, 0x92, 0x3c, 0x6a, 0xde, 0xa9, 0x16, 0xa8, 0xdf, 0x63, 0xe1, 0xf2, 0x55, 0x28,
+	0x27, 0x5d, 0x75, 0x23, 0xef, 0x66, 0xfd, 0xdb, 0x36, 0xd6, 0x1b, 0x1b, 0x3d, 0x6c, 0x6c, 0xf4,
+	0x6b, 0x63, 0xa3, 0x2f, 0x5b, 0xdb, 0x78, 0xd8, 0xda, 0xc6, 0x8f, 0xad, 0x6d, 0x7c, 0x78, 0xde,
+	0x8a, 0x53, 0x8d, 0xc3, 0xc5, 0x1d, 0x64, 0x9f, 0xb9, 0x58, 0xe8, 0x0f, 0xb7, 0xb8, 0x72, 0x97,
+	0xf5, 0xfc, 0xe9, 0x60, 0x6f, 0x7b, 0x7a, 0xb4, 0xae, 0xfe, 0x0e, 0x00, 0x80, 0xd9, 0x2f, 0x3a,
+	0x99, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MsgPolicies) != len(that1.MsgPolicies) {
+		return false
+	}
+	for i := range this.MsgPolicies {
+		if !this.MsgPolicies[i].Equal(&that1.MsgPolicies[i]) {
+			return false
+		}
+	}
+	if this.LeverageFeeTokens != that1.LeverageFeeTokens {
+		return false
+	}
+	if !this.FeeTokenPremium.Equal(that1.FeeTokenPremium) {
+		return false
+	}
+	return true
+}
+func (this *MsgPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPolicy)
+	if !ok {
+		that2, ok := that.(MsgPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TypeUrl != that1.TypeUrl {
+		return false
+	}
+	if this.FeeFree != that1.FeeFree {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.MaxGas != that1.MaxGas {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeTokenPremium.Size()
+		i -= size
+		if _, err := m.FeeTokenPremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUgov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LeverageFeeTokens {
+		i--
+		if m.LeverageFeeTokens {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgPolicies) > 0 {
+		for iNdEx := len(m.MsgPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUgov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintUgov(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Priority != 0 {
+		i = encodeVarintUgov(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FeeFree {
+		i--
+		if m.FeeFree {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintUgov(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUgov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUgov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgPolicies) > 0 {
+		for _, e := range m.MsgPolicies {
+			l = e.Size()
+			n += 1 + l + sovUgov(uint64(l))
+		}
+	}
+	if m.LeverageFeeTokens {
+		n += 2
+	}
+	l = m.FeeTokenPremium.Size()
+	n += 1 + l + sovUgov(uint64(l))
+	return n
+}
+
+func (m *MsgPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovUgov(uint64(l))
+	}
+	if m.FeeFree {
+		n += 2
+	}
+	if m.Priority != 0 {
+		n += 1 + sovUgov(uint64(m.Priority))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovUgov(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func sovUgov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUgov(x uint64) (n int) {
+	return sovUgov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUgov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUgov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUgov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPolicies = append(m.MsgPolicies, MsgPolicy{})
+			if err := m.MsgPolicies[len(m.MsgPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeverageFeeTokens", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeverageFeeTokens = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenPremium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUgov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUgov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTokenPremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUgov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUgov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUgov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUgov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUgov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeFree", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeFree = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUgov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUgov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUgov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUgov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUgov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUgov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUgov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUgov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUgov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUgov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUgov = fmt.Errorf("proto: unexpected end of group")
+)